@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stashsphere/backend/operations"
+)
+
+var createInviteCommand = &cobra.Command{
+	Use:   "create-invite",
+	Short: "Create an invite code",
+	Long: `Create an invite code for registrations and print it.
+
+Invites created by administrators do not belong to a user, so
+registrations with them do not send a friend request.
+
+Examples:
+  # Invite a single user
+  stashsphere create-invite
+
+  # Invite up to 10 users within a week
+  stashsphere create-invite --max-uses 10 --expires-in 168h
+
+  # Invite a specific email address
+  stashsphere create-invite --email user@example.com`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configPaths, _ := cmd.Flags().GetStringSlice("conf")
+		maxUses, _ := cmd.Flags().GetInt("max-uses")
+		expiresIn, _ := cmd.Flags().GetDuration("expires-in")
+		email, _ := cmd.Flags().GetString("email")
+
+		if maxUses < 1 {
+			return fmt.Errorf("max uses must be at least 1")
+		}
+		if expiresIn < 0 {
+			return fmt.Errorf("expiry must not be negative")
+		}
+
+		db, err := openDatabase(configPaths)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		params := operations.CreateInviteParams{
+			MaxUses: maxUses,
+			Email:   email,
+		}
+		if expiresIn > 0 {
+			expiresAt := time.Now().Add(expiresIn)
+			params.ExpiresAt = &expiresAt
+		}
+		invite, err := operations.CreateInvite(context.Background(), db, params)
+		if err != nil {
+			return fmt.Errorf("error creating invite: %w", err)
+		}
+
+		fmt.Println(invite.Code)
+		return nil
+	},
+}
+
+func init() {
+	createInviteCommand.Flags().StringSlice("conf", []string{"stashsphere.yaml"}, "path to one or more .yaml config files")
+	createInviteCommand.Flags().Int("max-uses", 1, "number of registrations the invite can be used for")
+	createInviteCommand.Flags().Duration("expires-in", 0, "time until the invite expires, it does not expire if 0")
+	createInviteCommand.Flags().String("email", "", "only allow this email address to register with the invite")
+	rootCmd.AddCommand(createInviteCommand)
+}
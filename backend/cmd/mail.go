@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/knadh/koanf/parsers/yaml"
+	"github.com/knadh/koanf/providers/confmap"
+	"github.com/knadh/koanf/providers/file"
+	"github.com/knadh/koanf/v2"
+	"github.com/spf13/cobra"
+	"github.com/stashsphere/backend/config"
+	"github.com/stashsphere/backend/services"
+)
+
+var mailCommand = &cobra.Command{
+	Use:   "mail",
+	Short: "Check the email setup",
+}
+
+var mailTestCommand = &cobra.Command{
+	Use:   "test <recipient>",
+	Short: "Send a test email",
+	Long: `Sends a test email to the recipient with the email settings of the config,
+bypassing the outbox. Connection, TLS and authentication errors are printed
+so the SMTP setup can be verified before starting the server.
+
+Example:
+  stashsphere mail test --conf stashsphere.yaml admin@example.com`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configPaths, _ := cmd.Flags().GetStringSlice("conf")
+
+		var conf struct {
+			InstanceName string                       `koanf:"instanceName"`
+			Email        config.StashSphereMailConfig `koanf:"email"`
+		}
+		k := koanf.New(".")
+		k.Load(confmap.Provider(map[string]interface{}{
+			"instanceName": "stashsphereDev",
+			"email": map[string]interface{}{
+				"backend": "stdout",
+			},
+		}, "."), nil)
+		for _, configPath := range configPaths {
+			if err := k.Load(file.Provider(configPath), yaml.Parser()); err != nil {
+				return fmt.Errorf("error loading config: %w", err)
+			}
+		}
+		if err := k.UnmarshalWithConf("", &conf, koanf.UnmarshalConf{Tag: "koanf", FlatPaths: false}); err != nil {
+			return fmt.Errorf("error loading config: %w", err)
+		}
+
+		emailService, err := services.NewEmailService(conf.Email)
+		if err != nil {
+			return err
+		}
+		sentAt := time.Now().Format(time.RFC1123Z)
+		err = emailService.Deliver(services.EmailMessage{
+			To:       args[0],
+			Subject:  fmt.Sprintf("[%s] Test email", conf.InstanceName),
+			TextBody: fmt.Sprintf("This is a test email from %s, sent at %s.\n\nYour email setup works.\n", conf.InstanceName, sentAt),
+			HTMLBody: fmt.Sprintf("<p>This is a test email from %s, sent at %s.</p><p>Your email setup works.</p>", html.EscapeString(conf.InstanceName), sentAt),
+		})
+		if err != nil {
+			return fmt.Errorf("error sending test email: %w", err)
+		}
+		if conf.Email.Backend == "stdout" {
+			fmt.Println("The email backend is stdout, the email was printed instead of sent")
+			return nil
+		}
+		fmt.Printf("Sent test email to %s via %s:%d\n", args[0], conf.Email.Host, conf.Email.Port)
+		return nil
+	},
+}
+
+func init() {
+	mailTestCommand.Flags().StringSlice("conf", []string{"stashsphere.yaml"}, "path to one or more .yaml config files")
+	mailCommand.AddCommand(mailTestCommand)
+	rootCmd.AddCommand(mailCommand)
+}
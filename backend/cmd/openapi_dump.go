@@ -73,7 +73,7 @@ var openApiDumpCommand = &cobra.Command{
 			outputPath = "doc/openapi.json"
 		}
 
-		_, engine, db, err := setup(config, false, true, outputPath)
+		_, engine, db, _, err := setup(config, false, true, outputPath)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+)
+
+var outboxCommand = &cobra.Command{
+	Use:   "outbox",
+	Short: "Inspect and requeue emails in the outbox",
+}
+
+var outboxListCommand = &cobra.Command{
+	Use:   "list",
+	Short: "List emails in the outbox",
+	Long:  `Lists the emails in the given state, by default the dead ones which ran out of delivery attempts.`,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configPaths, _ := cmd.Flags().GetStringSlice("conf")
+		state, _ := cmd.Flags().GetString("state")
+		if err := models.OutboxEmailState(state).IsValid(); err != nil {
+			return fmt.Errorf("unknown state %q, expected pending, sent or dead", state)
+		}
+
+		db, err := openDatabase(configPaths)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		emails, err := operations.ListOutboxEmails(context.Background(), db, models.OutboxEmailState(state))
+		if err != nil {
+			return fmt.Errorf("error listing outbox: %w", err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tRECIPIENT\tSUBJECT\tATTEMPTS\tCREATED\tNEXT ATTEMPT\tLAST ERROR")
+		for _, email := range emails {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+				email.ID,
+				email.Recipient,
+				email.Subject,
+				email.Attempts,
+				email.CreatedAt.Format(time.RFC3339),
+				email.NextAttemptAt.Format(time.RFC3339),
+				email.LastError.String,
+			)
+		}
+		return w.Flush()
+	},
+}
+
+var outboxRequeueCommand = &cobra.Command{
+	Use:   "requeue [email-id...]",
+	Short: "Requeue failed emails",
+	Long:  `Resets the delivery attempts of the given emails, or of all dead emails with --all-dead, and schedules them for immediate delivery.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configPaths, _ := cmd.Flags().GetStringSlice("conf")
+		allDead, _ := cmd.Flags().GetBool("all-dead")
+		if allDead == (len(args) > 0) {
+			return errors.New("pass either email IDs or --all-dead")
+		}
+
+		db, err := openDatabase(configPaths)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		ctx := context.Background()
+		var emails models.OutboxEmailSlice
+		if allDead {
+			emails, err = operations.ListOutboxEmails(ctx, db, models.OutboxEmailStateDead)
+			if err != nil {
+				return fmt.Errorf("error listing outbox: %w", err)
+			}
+		} else {
+			for _, id := range args {
+				email, err := models.FindOutboxEmail(ctx, db, id)
+				if err != nil {
+					if errors.Is(err, sql.ErrNoRows) {
+						return fmt.Errorf("email with ID %q not found", id)
+					}
+					return err
+				}
+				if email.State == models.OutboxEmailStateSent {
+					return fmt.Errorf("email with ID %q was already sent", id)
+				}
+				emails = append(emails, email)
+			}
+		}
+
+		for _, email := range emails {
+			err := operations.RequeueOutboxEmail(ctx, db, email)
+			if err != nil {
+				return fmt.Errorf("error requeueing email %q: %w", email.ID, err)
+			}
+		}
+		fmt.Printf("Requeued %d email(s)\n", len(emails))
+		return nil
+	},
+}
+
+func init() {
+	outboxListCommand.Flags().StringSlice("conf", []string{"stashsphere.yaml"}, "path to one or more .yaml config files")
+	outboxListCommand.Flags().String("state", string(models.OutboxEmailStateDead), "state of the listed emails: pending, sent or dead")
+	outboxRequeueCommand.Flags().StringSlice("conf", []string{"stashsphere.yaml"}, "path to one or more .yaml config files")
+	outboxRequeueCommand.Flags().Bool("all-dead", false, "requeue all dead emails")
+	outboxCommand.AddCommand(outboxListCommand)
+	outboxCommand.AddCommand(outboxRequeueCommand)
+	rootCmd.AddCommand(outboxCommand)
+}
@@ -121,18 +121,7 @@ func openDatabase(configPaths []string) (*sql.DB, error) {
 		k.UnmarshalWithConf("", &conf, koanf.UnmarshalConf{Tag: "koanf", FlatPaths: false})
 	}
 
-	dbOptions := fmt.Sprintf("user=%s dbname=%s host=%s", conf.Database.User, conf.Database.Name, conf.Database.Host)
-	if conf.Database.Password != nil {
-		dbOptions = fmt.Sprintf("%s password=%s", dbOptions, *conf.Database.Password)
-	}
-	if conf.Database.Port != nil {
-		dbOptions = fmt.Sprintf("%s port=%d", dbOptions, *conf.Database.Port)
-	}
-	if conf.Database.SslMode != nil {
-		dbOptions = fmt.Sprintf("%s sslmode=%s", dbOptions, *conf.Database.SslMode)
-	}
-
-	return sql.Open("postgres", dbOptions)
+	return sql.Open("postgres", databaseOptions(conf.Database))
 }
 
 func readPasswordFromStdin() (string, error) {
@@ -13,14 +13,41 @@ type StashSphereUserDeletionConfig struct {
 	GracePeriodMinutes int `koanf:"gracePeriodMinutes"`
 }
 
+type StashSphereWebAuthnConfig struct {
+	// domain passkeys are bound to, defaults to the host of the frontend url.
+	// Changing it invalidates all registered passkeys.
+	RPID string `koanf:"rpId"`
+	// origins allowed to use passkeys, defaults to the frontend url
+	Origins []string `koanf:"origins"`
+}
+
+type StashSphereOIDCConfig struct {
+	// issuer url of the provider, single sign-on is disabled if empty
+	Issuer       string `koanf:"issuer"`
+	ClientId     string `koanf:"clientId"`
+	ClientSecret string `koanf:"clientSecret"`
+	// requested in addition to openid, defaults to email and profile
+	Scopes []string `koanf:"scopes"`
+	// create accounts for users of the provider without one, invite codes
+	// are still required if invites are enabled
+	AutoProvision bool `koanf:"autoProvision"`
+	// label of the login button, defaults to "Single Sign-On"
+	DisplayName string `koanf:"displayName"`
+}
+
 type StashSphereServeConfig struct {
 	Database StashSphereDatabaseConfig `koanf:"database"`
 
 	ListenAddress string `koanf:"listenAddress"`
 
 	Auth struct {
-		PrivateKey           string `koanf:"privateKey"`
-		DisableSecureCookies bool   `koanf:"disableSecureCookies"`
+		PrivateKey           string                    `koanf:"privateKey"`
+		DisableSecureCookies bool                      `koanf:"disableSecureCookies"`
+		WebAuthn             StashSphereWebAuthnConfig `koanf:"webauthn"`
+		OIDC                 StashSphereOIDCConfig     `koanf:"oidc"`
+		// only allow logins with single sign-on and passkeys, registration
+		// and password resets are disabled as well
+		DisablePasswordLogin bool `koanf:"disablePasswordLogin"`
 	} `koanf:"auth"`
 
 	Image struct {
@@ -31,10 +58,19 @@ type StashSphereServeConfig struct {
 	UserDeletion StashSphereUserDeletionConfig `koanf:"userDeletion"`
 
 	Invites struct {
-		Enabled    bool   `koanf:"enabled"`
+		// registrations require an invite code
+		Enabled bool `koanf:"enabled"`
+		// optional code accepted in addition to the invites created by users
+		// and with the create-invite command, it is not consumed
 		InviteCode string `koanf:"code"`
 	} `koanf:"invites"`
 
+	Webhooks struct {
+		// allow webhook urls in the local network and on this host, only for
+		// instances whose users are trusted
+		AllowPrivateNetworks bool `koanf:"allowPrivateNetworks"`
+	} `koanf:"webhooks"`
+
 	Domains struct {
 		AllowedDomains []string `koanf:"allowed"`
 		ApiDomain      string   `koanf:"api"`
@@ -42,6 +78,9 @@ type StashSphereServeConfig struct {
 
 	FrontendUrl  string `koanf:"frontendUrl"`
 	InstanceName string `koanf:"instanceName"`
+	// public url of this api, used for links in emails which are handled by
+	// the backend
+	ApiUrl string `koanf:"apiUrl"`
 
 	Email StashSphereMailConfig `koanf:"email"`
 }
@@ -57,4 +96,13 @@ type StashSphereMailConfig struct {
 	Password string `koanf:"password"`
 	Host     string `koanf:"host"`
 	Port     uint16 `koanf:"port"`
+	// none, opportunistic, starttls or tls for implicit TLS. Defaults to
+	// opportunistic, which uses STARTTLS only if the server offers it
+	TLS string `koanf:"tls"`
+	// PEM file with CA certificates trusted in addition to the system ones
+	CAFile string `koanf:"caFile"`
+	// only for test setups with self-signed certificates
+	InsecureSkipVerify bool `koanf:"insecureSkipVerify"`
+	// SASL mechanism: none, plain or login, defaults to plain
+	Auth string `koanf:"auth"`
 }
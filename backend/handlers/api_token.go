@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/resources"
+	"github.com/stashsphere/backend/services"
+	"github.com/stashsphere/backend/utils"
+)
+
+type ApiTokenHandler struct {
+	apiTokenService *services.ApiTokenService
+}
+
+func NewApiTokenHandler(apiTokenService *services.ApiTokenService) *ApiTokenHandler {
+	return &ApiTokenHandler{apiTokenService}
+}
+
+type NewApiTokenParams struct {
+	Name   string   `json:"name" validate:"required,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1"`
+	// the token does not expire if omitted
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+func (ah *ApiTokenHandler) ApiTokenHandlerIndex(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	apiTokens, err := ah.apiTokenService.GetApiTokensForUser(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.ApiTokensFromModelSlice(apiTokens))
+}
+
+func (ah *ApiTokenHandler) ApiTokenHandlerPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	params := NewApiTokenParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	apiToken, token, err := ah.apiTokenService.CreateApiToken(c.Request().Context(), services.CreateApiTokenParams{
+		OwnerId:   authCtx.User.UserId,
+		Name:      params.Name,
+		Scopes:    params.Scopes,
+		ExpiresAt: params.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, resources.ApiTokenWithToken{
+		ApiToken: resources.ApiTokenFromModel(apiToken),
+		Token:    token,
+	})
+}
+
+func (ah *ApiTokenHandler) ApiTokenHandlerDelete(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	tokenId := c.Param("tokenId")
+	err := ah.apiTokenService.DeleteApiToken(c.Request().Context(), tokenId, authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/resources"
+	"github.com/stashsphere/backend/services"
+	"github.com/stashsphere/backend/utils"
+)
+
+type EmailChangeHandler struct {
+	userService *services.UserService
+	authService *services.AuthService
+}
+
+func NewEmailChangeHandler(userService *services.UserService, authService *services.AuthService) *EmailChangeHandler {
+	return &EmailChangeHandler{userService, authService}
+}
+
+type RequestEmailChangeParams struct {
+	Password string `json:"password" validate:"required"`
+	NewEmail string `json:"newEmail" validate:"required,email"`
+}
+
+func (h *EmailChangeHandler) RequestPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+
+	var params RequestEmailChangeParams
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+
+	err := h.userService.RequestEmailChange(c.Request().Context(), authCtx.User.UserId, params.Password, params.NewEmail)
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+type ConfirmEmailChangeParams struct {
+	Code string `json:"code" validate:"required,len=8"`
+}
+
+func (h *EmailChangeHandler) ConfirmPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+
+	var params ConfirmEmailChangeParams
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+
+	ctx := c.Request().Context()
+	user, err := h.userService.ConfirmEmailChange(ctx, authCtx.User.UserId, params.Code)
+	if err != nil {
+		return err
+	}
+	// the access token carries the email address, so the cookies of the
+	// current session are replaced
+	if authCtx.SessionId != "" {
+		accessToken, infoToken, refreshToken, refreshInfoToken, err := h.authService.ReissueTokens(ctx, user.ID, authCtx.SessionId)
+		if err != nil {
+			return err
+		}
+		h.authService.SetAuthCookies(c, accessToken, infoToken, refreshToken, refreshInfoToken)
+	}
+	verification, err := h.userService.GetEmailVerificationStatus(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.ProfileFromModel(user).WithEmailVerification(verification).WithPendingEmail(user))
+}
@@ -178,6 +178,8 @@ type ImagesParams struct {
 	Page           uint64 `query:"page"`
 	PerPage        uint64 `query:"perPage"`
 	OnlyUnassigned bool   `query:"onlyUnassigned"`
+	Cursor         string `query:"cursor"`
+	UseCursor      bool   `query:"useCursor"`
 }
 
 // this handler only lists own images to be able to create galleries and manage
@@ -198,12 +200,13 @@ func (is *ImageHandler) ImageHandlerIndex(c echo.Context) error {
 		params.PerPage = 50
 	}
 
-	totalCount, totalPageCount, images, err := is.imageService.ImageIndex(c.Request().Context(),
+	pageInfo, images, err := is.imageService.ImageIndex(c.Request().Context(),
 		services.ImageIndexParams{
 			UserId:         authCtx.User.UserId,
 			PerPage:        params.PerPage,
 			Page:           params.Page,
 			OnlyUnassigned: params.OnlyUnassigned,
+			Cursor:         cursorParam(params.UseCursor, params.Cursor),
 		})
 
 	if err != nil {
@@ -213,8 +216,9 @@ func (is *ImageHandler) ImageHandlerIndex(c echo.Context) error {
 		Images:         resources.ImagesFromModelSlice(images, authCtx.User.UserId),
 		PerPage:        uint64(params.PerPage),
 		Page:           uint64(params.Page),
-		TotalPageCount: totalPageCount,
-		TotalCount:     totalCount,
+		TotalPageCount: pageInfo.TotalPageCount,
+		TotalCount:     pageInfo.TotalCount,
+		NextCursor:     pageInfo.NextCursor,
 	}
 	return c.JSON(http.StatusOK, paginated)
 }
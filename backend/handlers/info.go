@@ -7,19 +7,22 @@ import (
 )
 
 type InfoHandler struct {
-	inviteRequired bool
+	info InfoGetResponse
 }
 
-func NewInfoHandler(inviteRequired bool) *InfoHandler {
-	return &InfoHandler{inviteRequired: inviteRequired}
+func NewInfoHandler(info InfoGetResponse) *InfoHandler {
+	return &InfoHandler{info}
 }
 
 type InfoGetResponse struct {
-	InviteRequired bool `json:"inviteRequired"`
+	InviteRequired       bool `json:"inviteRequired"`
+	PasskeysEnabled      bool `json:"passkeysEnabled"`
+	PasswordLoginEnabled bool `json:"passwordLoginEnabled"`
+	OidcEnabled          bool `json:"oidcEnabled"`
+	// label of the single sign-on button
+	OidcDisplayName string `json:"oidcDisplayName,omitempty"`
 }
 
 func (h *InfoHandler) InfoHandlerGet(c echo.Context) error {
-	return c.JSON(http.StatusOK, InfoGetResponse{
-		InviteRequired: h.inviteRequired,
-	})
+	return c.JSON(http.StatusOK, h.info)
 }
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/resources"
+	"github.com/stashsphere/backend/services"
+	"github.com/stashsphere/backend/utils"
+)
+
+type InviteHandler struct {
+	inviteService *services.InviteService
+}
+
+func NewInviteHandler(inviteService *services.InviteService) *InviteHandler {
+	return &InviteHandler{inviteService}
+}
+
+func (ih *InviteHandler) InviteHandlerIndex(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	invites, err := ih.inviteService.GetInvitesForUser(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	result := make([]resources.Invite, len(invites))
+	for i, invite := range invites {
+		result[i] = resources.InviteFromModel(invite, ih.inviteService.InviteLink(invite))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+type CreateInviteParams struct {
+	// defaults to a single use
+	MaxUses int `json:"maxUses" validate:"omitempty,min=1,max=100"`
+	// only this address can register with the invite if set
+	Email string `json:"email" validate:"omitempty,email"`
+	// defaults to two weeks, at most 90 days
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+func (ih *InviteHandler) InviteHandlerPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	params := CreateInviteParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	maxUses := params.MaxUses
+	if maxUses == 0 {
+		maxUses = 1
+	}
+	invite, err := ih.inviteService.CreateInvite(c.Request().Context(), services.CreateInviteParams{
+		OwnerId:   authCtx.User.UserId,
+		MaxUses:   maxUses,
+		Email:     params.Email,
+		ExpiresAt: params.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, resources.InviteFromModel(invite, ih.inviteService.InviteLink(invite)))
+}
+
+func (ih *InviteHandler) InviteHandlerDelete(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	err := ih.inviteService.DeleteInvite(c.Request().Context(), c.Param("inviteId"), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/services"
+	"github.com/stashsphere/backend/utils"
+)
+
+type LabelHandler struct {
+	labelService *services.LabelService
+}
+
+func NewLabelHandler(labelService *services.LabelService) *LabelHandler {
+	return &LabelHandler{labelService}
+}
+
+type LabelSheetParams struct {
+	ThingIds    []string `json:"thingIds"`
+	ListIds     []string `json:"listIds"`
+	LocationIds []string `json:"locationIds"`
+	Layout      string   `json:"layout" validate:"omitempty,oneof=avery-3x8 avery-5160"`
+	// number of labels already used on the first sheet
+	Skip int `json:"skip" validate:"min=0"`
+}
+
+func (lh *LabelHandler) LabelHandlerPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	params := LabelSheetParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if params.Layout == "" {
+		params.Layout = services.DefaultLabelLayout
+	}
+	var pdf bytes.Buffer
+	err := lh.labelService.CreateLabelSheet(c.Request().Context(), services.CreateLabelSheetParams{
+		UserId:      authCtx.User.UserId,
+		ThingIds:    params.ThingIds,
+		ListIds:     params.ListIds,
+		LocationIds: params.LocationIds,
+		Layout:      params.Layout,
+		Skip:        params.Skip,
+	}, &pdf)
+	if err != nil {
+		return err
+	}
+	c.Response().Header().Set("Content-Disposition", `inline; filename="labels.pdf"`)
+	return c.Blob(http.StatusOK, "application/pdf", pdf.Bytes())
+}
+
+type ResolveLabelParams struct {
+	Code string `query:"code" validate:"required"`
+}
+
+func (lh *LabelHandler) LabelHandlerResolve(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	params := ResolveLabelParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	label, err := lh.labelService.ResolveLabel(c.Request().Context(), params.Code, authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, label)
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/resources"
+	"github.com/stashsphere/backend/services"
+	"github.com/stashsphere/backend/utils"
+)
+
+type LendingHandler struct {
+	lendingService *services.LendingService
+}
+
+func NewLendingHandler(lendingService *services.LendingService) *LendingHandler {
+	return &LendingHandler{
+		lendingService,
+	}
+}
+
+type CheckoutParams struct {
+	DueAt *time.Time `json:"dueAt"`
+}
+
+func (lh *LendingHandler) Checkout(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	params := CheckoutParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	requests, err := lh.lendingService.CheckoutCart(c.Request().Context(), services.CheckoutCartParams{
+		UserId: authCtx.User.UserId,
+		DueAt:  params.DueAt,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, resources.BorrowRequestsFromModelSlice(requests, authCtx.User.UserId))
+}
+
+func (lh *LendingHandler) BorrowRequestIndex(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	requests, err := lh.lendingService.GetBorrowRequests(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.BorrowRequestsResponseFromResult(requests, authCtx.User.UserId))
+}
+
+type UpdateBorrowRequestParams struct {
+	Accept bool       `json:"accept"`
+	DueAt  *time.Time `json:"dueAt"`
+}
+
+func (lh *LendingHandler) BorrowRequestUpdate(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	params := UpdateBorrowRequestParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	requestId := c.Param("requestId")
+	request, err := lh.lendingService.ReactBorrowRequest(c.Request().Context(), services.ReactBorrowRequestParams{
+		RequestId: requestId,
+		UserId:    authCtx.User.UserId,
+		Accept:    params.Accept,
+		DueAt:     params.DueAt,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.BorrowRequestFromModel(request, authCtx.User.UserId))
+}
+
+func (lh *LendingHandler) BorrowRequestDelete(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	requestId := c.Param("requestId")
+	err := lh.lendingService.CancelBorrowRequest(c.Request().Context(), services.CancelBorrowRequestParams{
+		RequestId: requestId,
+		UserId:    authCtx.User.UserId,
+	})
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (lh *LendingHandler) LoanIndex(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	loans, err := lh.lendingService.GetLoans(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.LoansResponseFromResult(loans, authCtx.User.UserId))
+}
+
+func (lh *LendingHandler) LoanReturn(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	loanId := c.Param("loanId")
+	loan, err := lh.lendingService.ReturnLoan(c.Request().Context(), services.ReturnLoanParams{
+		LoanId: loanId,
+		UserId: authCtx.User.UserId,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.LoanFromModel(loan, authCtx.User.UserId))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/operations"
 	"github.com/stashsphere/backend/resources"
 	"github.com/stashsphere/backend/services"
 	"github.com/stashsphere/backend/utils"
@@ -81,6 +82,10 @@ type ListsParams struct {
 	Page           uint64   `query:"page"`
 	PerPage        uint64   `query:"perPage"`
 	FilterOwnerIds []string `query:"filterOwnerId"`
+	Sort           string   `query:"sort" validate:"omitempty,oneof=name createdAt updatedAt quantity owner"`
+	Order          string   `query:"order" validate:"omitempty,oneof=asc desc"`
+	Cursor         string   `query:"cursor"`
+	UseCursor      bool     `query:"useCursor"`
 	Paginate       *bool    `query:"paginate"`
 }
 
@@ -96,6 +101,9 @@ func (lh *ListHandler) ListHandlerIndex(c echo.Context) error {
 	if err := c.Bind(&params); err != nil {
 		return &utils.ParameterError{Err: err}
 	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
 	if params.PerPage == 0 {
 		params.PerPage = 50
 	}
@@ -103,13 +111,18 @@ func (lh *ListHandler) ListHandlerIndex(c echo.Context) error {
 	if params.Paginate != nil && *params.Paginate == true {
 		paginate = true
 	}
-	totalCount, totalPageCount, lists, err := lh.listService.GetListsForUser(c.Request().Context(),
+	pageInfo, lists, err := lh.listService.GetListsForUser(c.Request().Context(),
 		services.GetListsForUserParams{
 			UserId:         authCtx.User.UserId,
 			PerPage:        params.PerPage,
 			Page:           params.Page,
 			FilterOwnerIds: params.FilterOwnerIds,
 			Paginate:       paginate,
+			Cursor:         cursorParam(params.UseCursor, params.Cursor),
+			Sort: operations.SortParams{
+				Sort:  params.Sort,
+				Order: params.Order,
+			},
 		},
 	)
 	if err != nil {
@@ -123,8 +136,9 @@ func (lh *ListHandler) ListHandlerIndex(c echo.Context) error {
 		Things:         resources.ListsFromModelSlice(lists, authCtx.User.UserId, sharedListIds),
 		PerPage:        uint64(params.PerPage),
 		Page:           uint64(params.Page),
-		TotalPageCount: totalPageCount,
-		TotalCount:     totalCount,
+		TotalPageCount: pageInfo.TotalPageCount,
+		TotalCount:     pageInfo.TotalCount,
+		NextCursor:     pageInfo.NextCursor,
 	}
 	return c.JSON(http.StatusOK, paginated)
 }
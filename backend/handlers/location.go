@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/resources"
+	"github.com/stashsphere/backend/services"
+	"github.com/stashsphere/backend/utils"
+)
+
+type LocationHandler struct {
+	locationService *services.LocationService
+	thingService    *services.ThingService
+}
+
+func NewLocationHandler(locationService *services.LocationService, thingService *services.ThingService) *LocationHandler {
+	return &LocationHandler{locationService, thingService}
+}
+
+type NewLocationParams struct {
+	Name         string  `json:"name" validate:"gt=0"`
+	Description  string  `json:"description"`
+	ParentId     *string `json:"parentId"`
+	SharingState string  `json:"sharingState" validate:"oneof=private friends friends-of-friends"`
+}
+
+type UpdateLocationParams = NewLocationParams
+
+func (lh *LocationHandler) LocationHandlerIndex(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	locations, err := lh.locationService.GetLocationsForUser(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.LocationsFromModelSlice(locations, authCtx.User.UserId))
+}
+
+func (lh *LocationHandler) LocationHandlerPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	params := NewLocationParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	location, err := lh.locationService.CreateLocation(c.Request().Context(), services.CreateLocationParams{
+		Name:         params.Name,
+		Description:  params.Description,
+		OwnerId:      authCtx.User.UserId,
+		ParentId:     params.ParentId,
+		SharingState: params.SharingState,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, resources.LocationFromModel(location, authCtx.User.UserId))
+}
+
+func (lh *LocationHandler) LocationHandlerShow(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	locationId := c.Param("locationId")
+	location, err := lh.locationService.GetLocation(c.Request().Context(), locationId, authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.LocationFromModel(location, authCtx.User.UserId))
+}
+
+func (lh *LocationHandler) LocationHandlerTree(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	locationId := c.Param("locationId")
+	tree, err := lh.locationService.GetLocationTree(c.Request().Context(), locationId, authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.LocationTreeFromResult(tree, authCtx.User.UserId))
+}
+
+func (lh *LocationHandler) LocationHandlerPatch(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	locationId := c.Param("locationId")
+	params := UpdateLocationParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	location, err := lh.locationService.UpdateLocation(c.Request().Context(), locationId, authCtx.User.UserId, services.UpdateLocationParams{
+		Name:         params.Name,
+		Description:  params.Description,
+		ParentId:     params.ParentId,
+		SharingState: params.SharingState,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.LocationFromModel(location, authCtx.User.UserId))
+}
+
+func (lh *LocationHandler) LocationHandlerDelete(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	locationId := c.Param("locationId")
+	err := lh.locationService.DeleteLocation(c.Request().Context(), locationId, authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+type MoveThingsParams struct {
+	ThingIds []string `json:"thingIds" validate:"required,min=1"`
+	// null removes the things from their location
+	LocationId *string `json:"locationId"`
+}
+
+func (lh *LocationHandler) LocationHandlerMoveThings(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	params := MoveThingsParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	err := lh.locationService.MoveThings(c.Request().Context(), services.MoveThingsParams{
+		UserId:     authCtx.User.UserId,
+		ThingIds:   params.ThingIds,
+		LocationId: params.LocationId,
+	})
+	if err != nil {
+		return err
+	}
+	things := []resources.Thing{}
+	for _, thingId := range params.ThingIds {
+		thing, err := lh.thingService.GetThing(c.Request().Context(), thingId, authCtx.User.UserId)
+		if err != nil {
+			return err
+		}
+		// only own things can be moved, so all of their lists are visible
+		things = append(things, *resources.ThingFromModel(thing, authCtx.User.UserId, []string{}))
+	}
+	return c.JSON(http.StatusOK, things)
+}
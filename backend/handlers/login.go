@@ -1,20 +1,34 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/resources"
 	"github.com/stashsphere/backend/services"
 	"github.com/stashsphere/backend/utils"
 )
 
 type LoginHandler struct {
-	authService *services.AuthService
+	authService           *services.AuthService
+	passwordLoginDisabled bool
 }
 
-func NewLoginHandler(authService *services.AuthService) *LoginHandler {
+func NewLoginHandler(authService *services.AuthService, passwordLoginDisabled bool) *LoginHandler {
 	return &LoginHandler{
 		authService,
+		passwordLoginDisabled,
+	}
+}
+
+// sessionClient describes the device a session is used from
+func sessionClient(c echo.Context) operations.SessionClient {
+	return operations.SessionClient{
+		UserAgent: c.Request().UserAgent(),
+		IPAddress: c.RealIP(),
 	}
 }
 
@@ -24,6 +38,9 @@ type LoginPostParams struct {
 }
 
 func (lh *LoginHandler) LoginHandlerPost(c echo.Context) error {
+	if lh.passwordLoginDisabled {
+		return utils.PasswordLoginDisabledError{}
+	}
 	loginParams := LoginPostParams{}
 	if err := c.Bind(&loginParams); err != nil {
 		return utils.ParameterError{Err: err}
@@ -31,15 +48,58 @@ func (lh *LoginHandler) LoginHandlerPost(c echo.Context) error {
 	if err := c.Validate(loginParams); err != nil {
 		return utils.ParameterError{Err: err}
 	}
-	_, accessToken, infoToken, refreshToken, refreshInfoToken, err := lh.authService.AuthorizeUser(c.Request().Context(), loginParams.Email, loginParams.Password)
+	_, accessToken, infoToken, refreshToken, refreshInfoToken, err := lh.authService.AuthorizeUser(c.Request().Context(), loginParams.Email, loginParams.Password, sessionClient(c))
 	if err != nil {
+		var twoFactorRequired utils.TwoFactorRequiredError
+		if errors.As(err, &twoFactorRequired) {
+			return c.JSON(http.StatusAccepted, resources.TwoFactorChallenge{ChallengeToken: twoFactorRequired.ChallengeToken})
+		}
 		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
 	}
 	lh.authService.SetAuthCookies(c, accessToken, infoToken, refreshToken, refreshInfoToken)
 	return nil
 }
 
+type LoginTwoFactorPostParams struct {
+	ChallengeToken string `json:"challengeToken" validate:"required"`
+	// TOTP code or recovery code
+	Code string `json:"code" validate:"required"`
+}
+
+func (lh *LoginHandler) LoginHandlerTwoFactorPost(c echo.Context) error {
+	if lh.passwordLoginDisabled {
+		return utils.PasswordLoginDisabledError{}
+	}
+	params := LoginTwoFactorPostParams{}
+	if err := c.Bind(&params); err != nil {
+		return utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return utils.ParameterError{Err: err}
+	}
+	_, accessToken, infoToken, refreshToken, refreshInfoToken, err := lh.authService.CompleteTwoFactorLogin(c.Request().Context(), params.ChallengeToken, params.Code, sessionClient(c))
+	if err != nil {
+		return err
+	}
+	lh.authService.SetAuthCookies(c, accessToken, infoToken, refreshToken, refreshInfoToken)
+	return nil
+}
+
 func (lh *LoginHandler) LogoutHandlerDelete(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if ok && authCtx.Authenticated && authCtx.SessionId != "" {
+		err := lh.authService.RevokeSession(c.Request().Context(), authCtx.User.UserId, authCtx.SessionId)
+		if err != nil && !errors.Is(err, utils.NotFoundError{EntityName: "Session"}) {
+			return err
+		}
+	}
+	// the access token may have expired while the refresh token still works
+	if refreshCookie, err := c.Cookie("stashsphere-refresh"); err == nil && refreshCookie.Value != "" {
+		err = lh.authService.RevokeSessionOfRefreshToken(c.Request().Context(), refreshCookie.Value)
+		if err != nil && !errors.Is(err, utils.NotAuthenticatedError{}) {
+			return err
+		}
+	}
 	lh.authService.ClearAuthCookies(c)
 	return nil
 }
@@ -50,7 +110,7 @@ func (lh *LoginHandler) LoginHandlerRefreshPost(c echo.Context) error {
 	if err != nil || refreshCookie == nil {
 		return utils.NotAuthenticatedError{}
 	}
-	_, accessToken, infoToken, refreshToken, refreshInfoToken, err := lh.authService.AuthorizeUserWithRefreshToken(c.Request().Context(), refreshCookie.Value)
+	_, accessToken, infoToken, refreshToken, refreshInfoToken, err := lh.authService.AuthorizeUserWithRefreshToken(c.Request().Context(), refreshCookie.Value, sessionClient(c))
 	if err != nil {
 		c.Logger().Error("Unable to refresh token:", err)
 		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
@@ -1,22 +1,29 @@
 package handlers
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/models"
 	"github.com/stashsphere/backend/resources"
 	"github.com/stashsphere/backend/services"
 	"github.com/stashsphere/backend/utils"
 )
 
 type NotificationHandler struct {
-	notificationService *services.NotificationService
+	notificationService       *services.NotificationService
+	notificationStreamService *services.NotificationStreamService
 }
 
-func NewNotificationHandler(notificationService *services.NotificationService) *NotificationHandler {
+func NewNotificationHandler(notificationService *services.NotificationService, notificationStreamService *services.NotificationStreamService) *NotificationHandler {
 	return &NotificationHandler{
 		notificationService,
+		notificationStreamService,
 	}
 }
 
@@ -24,6 +31,8 @@ type NotificationParams struct {
 	OnlyUnacknowledged bool   `query:"onlyUnacknowledged"`
 	Page               uint64 `query:"page"`
 	PerPage            uint64 `query:"perPage"`
+	Cursor             string `query:"cursor"`
+	UseCursor          bool   `query:"useCursor"`
 }
 
 func (nh *NotificationHandler) Index(c echo.Context) error {
@@ -41,12 +50,13 @@ func (nh *NotificationHandler) Index(c echo.Context) error {
 	if params.PerPage == 0 {
 		params.PerPage = 50
 	}
-	totalCount, totalPageCount, notifications, err := nh.notificationService.GetNotifications(c.Request().Context(), services.GetNotificationsForUserParams{
+	pageInfo, notifications, err := nh.notificationService.GetNotifications(c.Request().Context(), services.GetNotificationsForUserParams{
 		UserId:             authCtx.User.UserId,
 		PerPage:            params.PerPage,
 		Page:               params.Page,
 		Paginate:           true,
 		OnlyUnacknowledged: params.OnlyUnacknowledged,
+		Cursor:             cursorParam(params.UseCursor, params.Cursor),
 	})
 	if err != nil {
 		return err
@@ -55,8 +65,9 @@ func (nh *NotificationHandler) Index(c echo.Context) error {
 		Notifications:  resources.NotificationsFromModelSlice(notifications),
 		PerPage:        uint64(params.PerPage),
 		Page:           uint64(params.Page),
-		TotalPageCount: totalPageCount,
-		TotalCount:     totalCount,
+		TotalPageCount: pageInfo.TotalPageCount,
+		TotalCount:     pageInfo.TotalCount,
+		NextCursor:     pageInfo.NextCursor,
 	}
 	return c.JSON(http.StatusOK, paginated)
 }
@@ -79,3 +90,143 @@ func (nh *NotificationHandler) Acknowledge(c echo.Context) error {
 	}
 	return c.NoContent(http.StatusOK)
 }
+
+// interval of comments sent to keep proxies from closing idle streams
+const notificationStreamKeepAlive = 30 * time.Second
+
+// Stream sends notification events to the client as Server-Sent Events until
+// the client disconnects. Created and acknowledged events carry the
+// notification, resync events ask the client to fetch the notifications again.
+func (nh *NotificationHandler) Stream(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	subscription := nh.notificationStreamService.Subscribe(authCtx.User.UserId)
+	defer nh.notificationStreamService.Unsubscribe(subscription)
+
+	response := c.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	// disable response buffering in nginx
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	ticker := time.NewTicker(notificationStreamKeepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event, ok := <-subscription.Events:
+			if !ok {
+				return nil
+			}
+			var data []byte
+			var err error
+			if event.Notification != nil {
+				data, err = json.Marshal(resources.NotificationFromModel(event.Notification))
+			} else {
+				data, err = json.Marshal(struct{}{})
+			}
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(response, "event: %s\ndata: %s\n\n", event.Event, data)
+			if err != nil {
+				return nil
+			}
+			response.Flush()
+		case <-ticker.C:
+			_, err := fmt.Fprint(response, ": keep-alive\n\n")
+			if err != nil {
+				return nil
+			}
+			response.Flush()
+		case <-c.Request().Context().Done():
+			return nil
+		}
+	}
+}
+
+func (nh *NotificationHandler) PreferencesGet(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	preferences, err := nh.notificationService.GetNotificationPreferences(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.NotificationPreferencesFromService(preferences))
+}
+
+type NotificationPreferenceParams struct {
+	ContentType string `json:"contentType" validate:"required"`
+	// in_app only stores the notification, email additionally sends an email
+	// right away, digest collects the emails in a digest and off drops them
+	Channel string `json:"channel" validate:"required,oneof=in_app email digest off"`
+}
+
+type NotificationPreferencesParams struct {
+	Preferences []NotificationPreferenceParams `json:"preferences" validate:"dive"`
+	// how often notifications on the digest channel are sent
+	DigestFrequency *string `json:"digestFrequency" validate:"omitempty,oneof=daily weekly"`
+}
+
+func (nh *NotificationHandler) PreferencesPatch(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	var params NotificationPreferencesParams
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return err
+	}
+	channels := make(map[string]models.NotificationChannel, len(params.Preferences))
+	for _, preference := range params.Preferences {
+		channels[preference.ContentType] = models.NotificationChannel(preference.Channel)
+	}
+	var digestFrequency *models.DigestFrequency
+	if params.DigestFrequency != nil {
+		frequency := models.DigestFrequency(*params.DigestFrequency)
+		digestFrequency = &frequency
+	}
+	updated, err := nh.notificationService.UpdateNotificationPreferences(c.Request().Context(), services.UpdateNotificationPreferencesParams{
+		UserId:          authCtx.User.UserId,
+		Channels:        channels,
+		DigestFrequency: digestFrequency,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.NotificationPreferencesFromService(updated))
+}
+
+// Unsubscribe handles the one-click unsubscribe links of notification emails
+// (RFC 8058). The token in the link identifies the user, so no session is
+// required.
+func (nh *NotificationHandler) Unsubscribe(c echo.Context) error {
+	token := c.QueryParam("token")
+	if token == "" {
+		return &utils.ParameterError{Err: errors.New("token is required")}
+	}
+	err := nh.notificationService.Unsubscribe(c.Request().Context(), token, c.QueryParam("contentType"))
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/resources"
+	"github.com/stashsphere/backend/services"
+	"github.com/stashsphere/backend/utils"
+)
+
+type OIDCHandler struct {
+	oidcService *services.OIDCService
+	authService *services.AuthService
+	frontendUrl string
+}
+
+func NewOIDCHandler(oidcService *services.OIDCService, authService *services.AuthService, frontendUrl string) *OIDCHandler {
+	return &OIDCHandler{oidcService, authService, strings.TrimSuffix(frontendUrl, "/")}
+}
+
+type OIDCBeginParams struct {
+	// used if the login creates an account on an instance requiring invites
+	InviteCode string `json:"inviteCode"`
+}
+
+func (oh *OIDCHandler) BeginPost(c echo.Context) error {
+	params := OIDCBeginParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	authorizationUrl, state, err := oh.oidcService.BeginLogin(c.Request().Context(), params.InviteCode)
+	if err != nil {
+		return err
+	}
+	oh.authService.SetOidcStateCookie(c, state)
+	return c.JSON(http.StatusOK, resources.OidcLogin{AuthorizationUrl: authorizationUrl})
+}
+
+// LinkBeginPost starts a login at the provider which links the account there
+// to the authenticated user
+func (oh *OIDCHandler) LinkBeginPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	authorizationUrl, state, err := oh.oidcService.BeginLink(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	oh.authService.SetOidcStateCookie(c, state)
+	return c.JSON(http.StatusOK, resources.OidcLogin{AuthorizationUrl: authorizationUrl})
+}
+
+// redirectWithError sends the browser back to the login page of the frontend,
+// which shows the error
+func (oh *OIDCHandler) redirectWithError(c echo.Context, errorType string) error {
+	query := url.Values{}
+	query.Set("oidcError", errorType)
+	return c.Redirect(http.StatusFound, oh.frontendUrl+"/user/login?"+query.Encode())
+}
+
+// CallbackGet is the redirect target of the provider. It sets the cookies
+// and sends the browser to the frontend.
+func (oh *OIDCHandler) CallbackGet(c echo.Context) error {
+	browserState := ""
+	if cookie, err := c.Cookie("stashsphere-oidc-state"); err == nil {
+		browserState = cookie.Value
+	}
+	oh.authService.ClearOidcStateCookie(c)
+	if providerError := c.QueryParam("error"); providerError != "" {
+		log.Info().Str("error", providerError).Str("description", c.QueryParam("error_description")).Msg("OpenID Connect provider denied the login")
+		return oh.redirectWithError(c, providerError)
+	}
+	_, accessToken, infoToken, refreshToken, refreshInfoToken, err := oh.oidcService.FinishLogin(c.Request().Context(), c.QueryParam("state"), browserState, c.QueryParam("code"), sessionClient(c))
+	if err != nil {
+		var stashsphereError utils.StashsphereError
+		if errors.As(err, &stashsphereError) {
+			return oh.redirectWithError(c, stashsphereError.ErrorType())
+		}
+		log.Error().Err(err).Msg("OpenID Connect login failed")
+		return oh.redirectWithError(c, "server-error")
+	}
+	if accessToken == "" {
+		// the login linked the account of the logged in user
+		return c.Redirect(http.StatusFound, oh.frontendUrl+"/user/account?oidcLinked=true")
+	}
+	oh.authService.SetAuthCookies(c, accessToken, infoToken, refreshToken, refreshInfoToken)
+	return c.Redirect(http.StatusFound, oh.frontendUrl+"/")
+}
@@ -0,0 +1,11 @@
+package handlers
+
+// cursorParam switches an index to cursor pagination when a cursor is given or
+// the first page is requested with useCursor. Without both the offset based
+// pagination is used.
+func cursorParam(useCursor bool, cursor string) *string {
+	if !useCursor && cursor == "" {
+		return nil
+	}
+	return &cursor
+}
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/resources"
+	"github.com/stashsphere/backend/services"
+	"github.com/stashsphere/backend/utils"
+)
+
+type PasskeyHandler struct {
+	passkeyService *services.PasskeyService
+	authService    *services.AuthService
+}
+
+func NewPasskeyHandler(passkeyService *services.PasskeyService, authService *services.AuthService) *PasskeyHandler {
+	return &PasskeyHandler{passkeyService, authService}
+}
+
+func (ph *PasskeyHandler) PasskeyHandlerIndex(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	passkeys, err := ph.passkeyService.GetPasskeysForUser(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.PasskeysFromModelSlice(passkeys))
+}
+
+func (ph *PasskeyHandler) RegistrationBeginPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	ceremonyId, options, err := ph.passkeyService.BeginRegistration(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.PasskeyRegistrationOptions{CeremonyId: ceremonyId, Options: options})
+}
+
+type PasskeyRegistrationFinishParams struct {
+	CeremonyId string `json:"ceremonyId" validate:"required"`
+	Name       string `json:"name" validate:"required,max=100"`
+	// result of navigator.credentials.create
+	Credential json.RawMessage `json:"credential" validate:"required"`
+}
+
+func (ph *PasskeyHandler) RegistrationFinishPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	params := PasskeyRegistrationFinishParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	passkey, err := ph.passkeyService.FinishRegistration(c.Request().Context(), authCtx.User.UserId, params.CeremonyId, params.Name, params.Credential)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, resources.PasskeyFromModel(passkey))
+}
+
+type PasskeyPatchParams struct {
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+func (ph *PasskeyHandler) PasskeyHandlerPatch(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	params := PasskeyPatchParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	passkey, err := ph.passkeyService.RenamePasskey(c.Request().Context(), c.Param("passkeyId"), authCtx.User.UserId, params.Name)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.PasskeyFromModel(passkey))
+}
+
+func (ph *PasskeyHandler) PasskeyHandlerDelete(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	err := ph.passkeyService.DeletePasskey(c.Request().Context(), c.Param("passkeyId"), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (ph *PasskeyHandler) LoginBeginPost(c echo.Context) error {
+	ceremonyId, options, err := ph.passkeyService.BeginLogin(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.PasskeyLoginOptions{CeremonyId: ceremonyId, Options: options})
+}
+
+type PasskeyLoginFinishParams struct {
+	CeremonyId string `json:"ceremonyId" validate:"required"`
+	// result of navigator.credentials.get
+	Credential json.RawMessage `json:"credential" validate:"required"`
+}
+
+func (ph *PasskeyHandler) LoginFinishPost(c echo.Context) error {
+	params := PasskeyLoginFinishParams{}
+	if err := c.Bind(&params); err != nil {
+		return utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return utils.ParameterError{Err: err}
+	}
+	_, accessToken, infoToken, refreshToken, refreshInfoToken, err := ph.passkeyService.FinishLogin(c.Request().Context(), params.CeremonyId, params.Credential, sessionClient(c))
+	if err != nil {
+		return err
+	}
+	ph.authService.SetAuthCookies(c, accessToken, infoToken, refreshToken, refreshInfoToken)
+	return nil
+}
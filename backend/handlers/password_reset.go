@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/services"
+	"github.com/stashsphere/backend/utils"
+)
+
+type PasswordResetHandler struct {
+	userService           *services.UserService
+	passwordLoginDisabled bool
+}
+
+func NewPasswordResetHandler(userService *services.UserService, passwordLoginDisabled bool) *PasswordResetHandler {
+	return &PasswordResetHandler{userService, passwordLoginDisabled}
+}
+
+type RequestPasswordResetParams struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+func (h *PasswordResetHandler) RequestPost(c echo.Context) error {
+	if h.passwordLoginDisabled {
+		return utils.PasswordLoginDisabledError{}
+	}
+	var params RequestPasswordResetParams
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+
+	err := h.userService.RequestPasswordReset(c.Request().Context(), params.Email)
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+type ConfirmPasswordResetParams struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"newPassword" validate:"gt=3"`
+}
+
+func (h *PasswordResetHandler) ConfirmPost(c echo.Context) error {
+	if h.passwordLoginDisabled {
+		return utils.PasswordLoginDisabledError{}
+	}
+	var params ConfirmPasswordResetParams
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+
+	err := h.userService.ResetPassword(c.Request().Context(), params.Token, params.NewPassword)
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
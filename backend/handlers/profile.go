@@ -35,7 +35,7 @@ func (ph *ProfileHandler) ProfileHandlerGet(c echo.Context) error {
 	if err != nil {
 		return err
 	}
-	return c.JSON(http.StatusOK, resources.ProfileFromModel(user).WithEmailVerification(verification))
+	return c.JSON(http.StatusOK, resources.ProfileFromModel(user).WithEmailVerification(verification).WithPendingEmail(user))
 }
 
 type ProfileUpdateParams struct {
@@ -43,6 +43,8 @@ type ProfileUpdateParams struct {
 	FullName    string  `json:"fullName"`
 	Information string  `json:"information"`
 	ImageId     *string `json:"imageId"`
+	// language of emails, unchanged if not given
+	Locale *string `json:"locale" validate:"omitempty,oneof=en de fr"`
 }
 
 func (p *ProfileUpdateParams) ToUpdateUserParams() services.UpdateUserParams {
@@ -51,6 +53,7 @@ func (p *ProfileUpdateParams) ToUpdateUserParams() services.UpdateUserParams {
 		FullName:    p.FullName,
 		Information: p.Information,
 		ImageId:     p.ImageId,
+		Locale:      p.Locale,
 	}
 }
 
@@ -66,6 +69,9 @@ func (ph *ProfileHandler) ProfileHandlerPatch(c echo.Context) error {
 	if err := c.Bind(&params); err != nil {
 		return &utils.ParameterError{Err: err}
 	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
 	serviceParams := params.ToUpdateUserParams()
 	serviceParams.UserId = authCtx.User.UserId
 	user, err := ph.userService.UpdateUser(c.Request().Context(), serviceParams)
@@ -7,12 +7,14 @@ import (
 )
 
 type RegisterHandler struct {
-	userService *services.UserService
+	userService           *services.UserService
+	passwordLoginDisabled bool
 }
 
-func NewRegisterHandler(userService *services.UserService) *RegisterHandler {
+func NewRegisterHandler(userService *services.UserService, passwordLoginDisabled bool) *RegisterHandler {
 	return &RegisterHandler{
 		userService,
+		passwordLoginDisabled,
 	}
 }
 
@@ -21,9 +23,14 @@ type RegisterPostParams struct {
 	Email      string `json:"email" validate:"email"`
 	Password   string `json:"password" validate:"gt=3"`
 	InviteCode string `json:"inviteCode"`
+	// language of emails, taken from the Accept-Language header if not given
+	Locale string `json:"locale" validate:"omitempty,oneof=en de fr"`
 }
 
 func (rh *RegisterHandler) RegisterHandlerPost(c echo.Context) error {
+	if rh.passwordLoginDisabled {
+		return utils.PasswordLoginDisabledError{}
+	}
 	registerParams := RegisterPostParams{}
 	if err := c.Bind(&registerParams); err != nil {
 		return &utils.ParameterError{Err: err}
@@ -31,11 +38,15 @@ func (rh *RegisterHandler) RegisterHandlerPost(c echo.Context) error {
 	if err := c.Validate(registerParams); err != nil {
 		return &utils.ParameterError{Err: err}
 	}
+	if registerParams.Locale == "" {
+		registerParams.Locale = utils.PreferredLocale(c.Request().Header.Get("Accept-Language"))
+	}
 	_, err := rh.userService.CreateUser(c.Request().Context(), services.CreateUserParams{
 		Name:                  registerParams.Name,
 		Email:                 registerParams.Email,
 		Password:              registerParams.Password,
 		InviteCode:            registerParams.InviteCode,
+		Locale:                registerParams.Locale,
 		SendEmailVerification: true,
 	})
 	if err != nil {
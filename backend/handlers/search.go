@@ -25,7 +25,9 @@ func NewSearchHandler(searchService *services.SearchService, listService *servic
 }
 
 type SearchParams struct {
-	Query string `query:"query"`
+	Query   string `query:"query"`
+	Page    uint64 `query:"page"`
+	PerPage uint64 `query:"perPage"`
 }
 
 func (sh *SearchHandler) SearchHandlerGet(c echo.Context) error {
@@ -40,7 +42,14 @@ func (sh *SearchHandler) SearchHandlerGet(c echo.Context) error {
 	if err := c.Bind(&searchParams); err != nil {
 		return &utils.ParameterError{Err: err}
 	}
-	results, err := sh.searchService.Search(c.Request().Context(), authCtx.User.UserId, &services.SearchParams{Query: searchParams.Query})
+	if searchParams.PerPage == 0 {
+		searchParams.PerPage = 50
+	}
+	results, err := sh.searchService.Search(c.Request().Context(), authCtx.User.UserId, &services.SearchParams{
+		Query:   searchParams.Query,
+		PerPage: searchParams.PerPage,
+		Page:    searchParams.Page,
+	})
 	if err != nil {
 		return err
 	}
@@ -48,7 +57,7 @@ func (sh *SearchHandler) SearchHandlerGet(c echo.Context) error {
 	if err != nil {
 		return err
 	}
-	return c.JSON(http.StatusOK, resources.SearchResultsFromModel(results, authCtx.User.UserId, sharedListIds))
+	return c.JSON(http.StatusOK, resources.SearchResultsFromModel(results, authCtx.User.UserId, sharedListIds, searchParams.PerPage, searchParams.Page))
 }
 
 type AutoCompleteParams struct {
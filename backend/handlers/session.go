@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/resources"
+	"github.com/stashsphere/backend/services"
+	"github.com/stashsphere/backend/utils"
+)
+
+type SessionHandler struct {
+	authService *services.AuthService
+}
+
+func NewSessionHandler(authService *services.AuthService) *SessionHandler {
+	return &SessionHandler{authService}
+}
+
+func (sh *SessionHandler) SessionHandlerIndex(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	sessions, err := sh.authService.GetSessionsForUser(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.SessionsFromModelSlice(sessions, authCtx.SessionId))
+}
+
+func (sh *SessionHandler) SessionHandlerDelete(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	sessionId := c.Param("sessionId")
+	err := sh.authService.RevokeSession(c.Request().Context(), authCtx.User.UserId, sessionId)
+	if err != nil {
+		return err
+	}
+	if sessionId == authCtx.SessionId {
+		sh.authService.ClearAuthCookies(c)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// SessionHandlerDeleteAll revokes all sessions except the current one
+func (sh *SessionHandler) SessionHandlerDeleteAll(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	err := sh.authService.RevokeOtherSessions(c.Request().Context(), authCtx.User.UserId, authCtx.SessionId)
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -29,6 +29,12 @@ type ThingsParams struct {
 	PerPage        uint64   `query:"perPage"`
 	FilterOwnerIds []string `query:"filterOwnerId"`
 	SearchTerm     string   `query:"searchTerm"`
+	PropertyFilter string   `query:"propertyFilter"`
+	Sort           string   `query:"sort" validate:"omitempty,oneof=name createdAt updatedAt quantity owner property"`
+	Order          string   `query:"order" validate:"omitempty,oneof=asc desc"`
+	SortProperty   string   `query:"sortProperty" validate:"required_if=Sort property"`
+	Cursor         string   `query:"cursor"`
+	UseCursor      bool     `query:"useCursor"`
 	Paginate       *bool    `query:"paginate"`
 }
 
@@ -44,6 +50,9 @@ func (th *ThingHandler) ThingHandlerIndex(c echo.Context) error {
 	if err := c.Bind(&params); err != nil {
 		return &utils.ParameterError{Err: err}
 	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
 	if params.PerPage == 0 {
 		params.PerPage = 50
 	}
@@ -51,7 +60,7 @@ func (th *ThingHandler) ThingHandlerIndex(c echo.Context) error {
 	if params.Paginate != nil && *params.Paginate == false {
 		paginate = false
 	}
-	totalCount, totalPageCount, things, err := th.thingService.GetThingsForUser(c.Request().Context(),
+	pageInfo, things, err := th.thingService.GetThingsForUser(c.Request().Context(),
 		services.GetThingsForUserParams{
 			UserId:         authCtx.User.UserId,
 			PerPage:        params.PerPage,
@@ -59,6 +68,13 @@ func (th *ThingHandler) ThingHandlerIndex(c echo.Context) error {
 			Paginate:       paginate,
 			FilterOwnerIds: params.FilterOwnerIds,
 			SearchTerm:     params.SearchTerm,
+			PropertyFilter: params.PropertyFilter,
+			Cursor:         cursorParam(params.UseCursor, params.Cursor),
+			Sort: operations.SortParams{
+				Sort:     params.Sort,
+				Order:    params.Order,
+				Property: params.SortProperty,
+			},
 		},
 	)
 	if err != nil {
@@ -72,8 +88,9 @@ func (th *ThingHandler) ThingHandlerIndex(c echo.Context) error {
 		Things:         resources.ThingsFromModelSlice(things, authCtx.User.UserId, sharedListIds),
 		PerPage:        uint64(params.PerPage),
 		Page:           uint64(params.Page),
-		TotalPageCount: totalPageCount,
-		TotalCount:     totalCount,
+		TotalPageCount: pageInfo.TotalPageCount,
+		TotalCount:     pageInfo.TotalCount,
+		NextCursor:     pageInfo.NextCursor,
 	}
 	return c.JSON(http.StatusOK, paginated)
 }
@@ -138,14 +155,15 @@ func (PropertyMapper) Unmarshal(b []byte, t PropertyTypeTag) (jtug.Union[Propert
 }
 
 type NewThingParams struct {
-	Name         string       `json:"name" validate:"gt=3"`
-	PrivateNote  string       `json:"privateNote"`
-	Description  string       `json:"description"`
-	ImagesIds    []string     `json:"imagesIds"`
-	Properties   PropertyList `json:"properties"`
-	Quantity     uint64       `json:"quantity"`
-	QuantityUnit string       `json:"quantityUnit"`
-	SharingState string       `json:"sharingState" validate:"oneof=private friends friends-of-friends"`
+	Name              string       `json:"name" validate:"gt=3"`
+	PrivateNote       string       `json:"privateNote"`
+	Description       string       `json:"description"`
+	ImagesIds         []string     `json:"imagesIds"`
+	Properties        PropertyList `json:"properties"`
+	Quantity          uint64       `json:"quantity"`
+	QuantityUnit      string       `json:"quantityUnit"`
+	SharingState      string       `json:"sharingState" validate:"oneof=private friends friends-of-friends"`
+	LowStockThreshold *uint64      `json:"lowStockThreshold"`
 }
 
 func NewThingParamsToCreateThingParams(param NewThingParams, ownerId string) services.CreateThingParams {
@@ -171,15 +189,16 @@ func NewThingParamsToCreateThingParams(param NewThingParams, ownerId string) ser
 		}
 	}
 	return services.CreateThingParams{
-		Name:         param.Name,
-		OwnerId:      ownerId,
-		Properties:   properties,
-		ImagesIds:    param.ImagesIds,
-		Description:  param.Description,
-		PrivateNote:  param.PrivateNote,
-		Quantity:     param.Quantity,
-		QuantityUnit: param.QuantityUnit,
-		SharingState: param.SharingState,
+		Name:              param.Name,
+		OwnerId:           ownerId,
+		Properties:        properties,
+		ImagesIds:         param.ImagesIds,
+		Description:       param.Description,
+		PrivateNote:       param.PrivateNote,
+		Quantity:          param.Quantity,
+		QuantityUnit:      param.QuantityUnit,
+		SharingState:      param.SharingState,
+		LowStockThreshold: param.LowStockThreshold,
 	}
 }
 
@@ -230,14 +249,15 @@ func UpdateThingParamsToUpdateThingParams(param UpdateThingParams) services.Upda
 		}
 	}
 	return services.UpdateThingParams{
-		Name:         param.Name,
-		Properties:   properties,
-		ImagesIds:    param.ImagesIds,
-		Description:  param.Description,
-		PrivateNote:  param.PrivateNote,
-		Quantity:     param.Quantity,
-		QuantityUnit: param.QuantityUnit,
-		SharingState: param.SharingState,
+		Name:              param.Name,
+		Properties:        properties,
+		ImagesIds:         param.ImagesIds,
+		Description:       param.Description,
+		PrivateNote:       param.PrivateNote,
+		Quantity:          param.Quantity,
+		QuantityUnit:      param.QuantityUnit,
+		SharingState:      param.SharingState,
+		LowStockThreshold: param.LowStockThreshold,
 	}
 }
 
@@ -307,3 +327,94 @@ func (th *ThingHandler) ThingHandlerDelete(c echo.Context) error {
 	}
 	return c.NoContent(http.StatusNoContent)
 }
+
+func (th *ThingHandler) ThingHandlerLowStock(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	things, err := th.thingService.GetLowStockThings(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	// only own things are returned, so all of their lists are visible
+	return c.JSON(http.StatusOK, resources.ThingsFromModelSlice(things, authCtx.User.UserId, []string{}))
+}
+
+type QuantityChangeParams struct {
+	Delta  int64   `json:"delta" validate:"required"`
+	Reason *string `json:"reason" validate:"omitempty,oneof=consumed bought lost lent correction"`
+	Note   string  `json:"note" validate:"max=1000"`
+}
+
+func (th *ThingHandler) ThingHandlerQuantityPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	thingId := c.Param("thingId")
+	params := QuantityChangeParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	entry, err := th.thingService.ChangeQuantity(c.Request().Context(), services.ChangeQuantityParams{
+		ThingId: thingId,
+		UserId:  authCtx.User.UserId,
+		Delta:   params.Delta,
+		Reason:  params.Reason,
+		Note:    params.Note,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, resources.QuantityEntryFromModel(entry))
+}
+
+type QuantityEntriesParams struct {
+	Page    uint64 `query:"page"`
+	PerPage uint64 `query:"perPage"`
+}
+
+func (th *ThingHandler) ThingHandlerQuantityIndex(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	thingId := c.Param("thingId")
+	var params QuantityEntriesParams
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if params.PerPage == 0 {
+		params.PerPage = 50
+	}
+	totalCount, totalPageCount, entries, err := th.thingService.GetQuantityEntries(c.Request().Context(), services.GetQuantityEntriesParams{
+		ThingId: thingId,
+		UserId:  authCtx.User.UserId,
+		PerPage: params.PerPage,
+		Page:    params.Page,
+	})
+	if err != nil {
+		return err
+	}
+	paginated := resources.PaginatedQuantityEntries{
+		Entries:        resources.QuantityEntriesFromModelSlice(entries),
+		PerPage:        params.PerPage,
+		Page:           params.Page,
+		TotalPageCount: totalPageCount,
+		TotalCount:     totalCount,
+	}
+	return c.JSON(http.StatusOK, paginated)
+}
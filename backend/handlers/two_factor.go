@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/resources"
+	"github.com/stashsphere/backend/services"
+	"github.com/stashsphere/backend/utils"
+)
+
+type TwoFactorHandler struct {
+	twoFactorService *services.TwoFactorService
+}
+
+func NewTwoFactorHandler(twoFactorService *services.TwoFactorService) *TwoFactorHandler {
+	return &TwoFactorHandler{twoFactorService}
+}
+
+func (th *TwoFactorHandler) EnrollPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	enrollment, err := th.twoFactorService.Enroll(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.TotpEnrollmentFromService(enrollment))
+}
+
+type ConfirmTwoFactorParams struct {
+	Code string `json:"code" validate:"required"`
+}
+
+func (th *TwoFactorHandler) ConfirmPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	var params ConfirmTwoFactorParams
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	codes, err := th.twoFactorService.Confirm(c.Request().Context(), authCtx.User.UserId, params.Code)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.RecoveryCodes{Codes: codes})
+}
+
+type TwoFactorPasswordParams struct {
+	Password string `json:"password" validate:"required"`
+}
+
+func (th *TwoFactorHandler) DisablePost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	var params TwoFactorPasswordParams
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	err := th.twoFactorService.Disable(c.Request().Context(), authCtx.User.UserId, params.Password)
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (th *TwoFactorHandler) RecoveryCodesPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	var params TwoFactorPasswordParams
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	codes, err := th.twoFactorService.RegenerateRecoveryCodes(c.Request().Context(), authCtx.User.UserId, params.Password)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.RecoveryCodes{Codes: codes})
+}
@@ -75,6 +75,7 @@ func (ph *UserHandler) PatchPassword(c echo.Context) error {
 		UserId:      authCtx.User.UserId,
 		OldPassword: params.OldPassword,
 		NewPassword: params.NewPassword,
+		SessionId:   authCtx.SessionId,
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/middleware"
+	"github.com/stashsphere/backend/resources"
+	"github.com/stashsphere/backend/services"
+	"github.com/stashsphere/backend/utils"
+)
+
+type WebhookHandler struct {
+	webhookService *services.WebhookService
+}
+
+func NewWebhookHandler(webhookService *services.WebhookService) *WebhookHandler {
+	return &WebhookHandler{webhookService}
+}
+
+type NewWebhookParams struct {
+	Url        string   `json:"url" validate:"required,url"`
+	EventTypes []string `json:"eventTypes" validate:"required,min=1"`
+	// a random secret is generated if none is given
+	Secret *string `json:"secret" validate:"omitempty,min=16"`
+}
+
+type UpdateWebhookParams struct {
+	Url        *string  `json:"url" validate:"omitempty,url"`
+	EventTypes []string `json:"eventTypes" validate:"omitempty,min=1"`
+	Active     *bool    `json:"active"`
+	Secret     *string  `json:"secret" validate:"omitempty,min=16"`
+}
+
+type WebhookDeliveriesParams struct {
+	Page    uint64 `query:"page"`
+	PerPage uint64 `query:"perPage"`
+}
+
+func (wh *WebhookHandler) WebhookHandlerIndex(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	webhooks, err := wh.webhookService.GetWebhooksForUser(c.Request().Context(), authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.WebhooksFromModelSlice(webhooks))
+}
+
+func (wh *WebhookHandler) WebhookHandlerPost(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	params := NewWebhookParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	webhook, err := wh.webhookService.CreateWebhook(c.Request().Context(), services.CreateWebhookParams{
+		OwnerId:    authCtx.User.UserId,
+		Url:        params.Url,
+		EventTypes: params.EventTypes,
+		Secret:     params.Secret,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, resources.WebhookWithSecret{
+		Webhook: resources.WebhookFromModel(webhook),
+		Secret:  webhook.Secret,
+	})
+}
+
+func (wh *WebhookHandler) WebhookHandlerShow(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	webhookId := c.Param("webhookId")
+	webhook, err := wh.webhookService.GetWebhook(c.Request().Context(), webhookId, authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.WebhookFromModel(webhook))
+}
+
+func (wh *WebhookHandler) WebhookHandlerPatch(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	webhookId := c.Param("webhookId")
+	params := UpdateWebhookParams{}
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if err := c.Validate(params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	webhook, err := wh.webhookService.UpdateWebhook(c.Request().Context(), webhookId, authCtx.User.UserId, services.UpdateWebhookParams{
+		Url:        params.Url,
+		EventTypes: params.EventTypes,
+		Active:     params.Active,
+		Secret:     params.Secret,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resources.WebhookFromModel(webhook))
+}
+
+func (wh *WebhookHandler) WebhookHandlerDelete(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	webhookId := c.Param("webhookId")
+	err := wh.webhookService.DeleteWebhook(c.Request().Context(), webhookId, authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (wh *WebhookHandler) WebhookHandlerPing(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	webhookId := c.Param("webhookId")
+	delivery, err := wh.webhookService.PingWebhook(c.Request().Context(), webhookId, authCtx.User.UserId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusAccepted, resources.WebhookDeliveryFromModel(delivery))
+}
+
+func (wh *WebhookHandler) WebhookHandlerDeliveries(c echo.Context) error {
+	authCtx, ok := c.Get("auth").(*middleware.AuthContext)
+	if !ok {
+		return utils.NoAuthContextError{}
+	}
+	if !authCtx.Authenticated {
+		return utils.NotAuthenticatedError{}
+	}
+	webhookId := c.Param("webhookId")
+	var params WebhookDeliveriesParams
+	if err := c.Bind(&params); err != nil {
+		return &utils.ParameterError{Err: err}
+	}
+	if params.PerPage == 0 {
+		params.PerPage = 50
+	}
+	totalCount, totalPageCount, deliveries, err := wh.webhookService.GetWebhookDeliveries(c.Request().Context(), services.GetWebhookDeliveriesParams{
+		WebhookId: webhookId,
+		UserId:    authCtx.User.UserId,
+		PerPage:   params.PerPage,
+		Page:      params.Page,
+	})
+	if err != nil {
+		return err
+	}
+	paginated := resources.PaginatedWebhookDeliveries{
+		Deliveries:     resources.WebhookDeliveriesFromModelSlice(deliveries),
+		PerPage:        params.PerPage,
+		Page:           params.Page,
+		TotalPageCount: totalPageCount,
+		TotalCount:     totalCount,
+	}
+	return c.JSON(http.StatusOK, paginated)
+}
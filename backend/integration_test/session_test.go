@@ -0,0 +1,88 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/cmd"
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/resources"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stretchr/testify/assert"
+)
+
+// login returns the access token cookie of a new session
+func login(t *testing.T, e *echo.Echo, email string, password string) *http.Cookie {
+	body, err := json.Marshal(map[string]string{"email": email, "password": password})
+	assert.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/api/user/login", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == "stashsphere-access" {
+			return cookie
+		}
+	}
+	t.Fatal("no access token cookie")
+	return nil
+}
+
+func authenticatedRequest(e *echo.Echo, method string, path string, accessCookie *http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.AddCookie(accessCookie)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRevokedSessionAccessToken(t *testing.T) {
+	db, tearDown, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(tearDown)
+	t.Cleanup(func() { db.Close() })
+
+	e, _, err := cmd.SetupWithDB(db, testConfig(t), false, false, "")
+	assert.NoError(t, err)
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	_, err = userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+
+	laptop := login(t, e, aliceParams.Email, aliceParams.Password)
+	phone := login(t, e, aliceParams.Email, aliceParams.Password)
+
+	rec := authenticatedRequest(e, http.MethodGet, "/api/user/sessions", laptop)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var sessions []resources.Session
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &sessions))
+	assert.Len(t, sessions, 2)
+	var phoneSessionId string
+	for _, session := range sessions {
+		if !session.Current {
+			phoneSessionId = session.ID
+		}
+	}
+
+	// the access token of a revoked session is rejected before it expires
+	rec = authenticatedRequest(e, http.MethodDelete, "/api/user/sessions/"+phoneSessionId, laptop)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	rec = authenticatedRequest(e, http.MethodGet, "/api/user/profile", phone)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	rec = authenticatedRequest(e, http.MethodGet, "/api/user/profile", laptop)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// logging out revokes the session as well
+	rec = authenticatedRequest(e, http.MethodDelete, "/api/user/logout", laptop)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec = authenticatedRequest(e, http.MethodGet, "/api/user/profile", laptop)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
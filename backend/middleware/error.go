@@ -64,6 +64,36 @@ func CreateStashSphereHTTPErrorHandler(echoInstance *echo.Echo) func(err error,
 			case utils.ErrVerificationCodeExpired:
 				statusCode = http.StatusBadRequest
 				message = "Verification code has expired"
+			case utils.ErrBorrowRequestNotPending:
+				statusCode = http.StatusBadRequest
+				message = "Borrow request not pending"
+			case utils.ErrThingAlreadyLent:
+				statusCode = http.StatusConflict
+				message = "Thing is already lent"
+			case utils.ErrLoanNotActive:
+				statusCode = http.StatusBadRequest
+				message = "Loan is not active"
+			case utils.ErrInsufficientScope:
+				statusCode = http.StatusForbidden
+				message = e.Error()
+			case utils.ErrTwoFactorRequired:
+				statusCode = http.StatusUnauthorized
+				message = "Two-factor authentication required"
+			case utils.ErrInvalidTwoFactorCode:
+				statusCode = http.StatusBadRequest
+				message = "Invalid two-factor code"
+			case utils.ErrInvalidPasswordResetToken:
+				statusCode = http.StatusBadRequest
+				message = "Invalid password reset token"
+			case utils.ErrPasswordResetTokenExpired:
+				statusCode = http.StatusBadRequest
+				message = "Password reset token has expired"
+			case utils.ErrPasswordLoginDisabled:
+				statusCode = http.StatusForbidden
+				message = "Password login is disabled"
+			case utils.ErrOidcLinkRequired:
+				statusCode = http.StatusConflict
+				message = "Account has to be linked to single sign-on"
 			}
 		default:
 			echoInstance.DefaultHTTPErrorHandler(err, c)
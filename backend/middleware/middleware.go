@@ -1,11 +1,14 @@
 package middleware
 
 import (
+	"context"
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
+	"github.com/stashsphere/backend/models"
 	"github.com/stashsphere/backend/operations"
 )
 
@@ -20,9 +23,42 @@ type AuthContext struct {
 	Authenticated bool
 	User          *UserContext
 	AccessToken   *jwt.Token
+	// session of the access token, empty for personal access tokens
+	SessionId string
+	// set instead of AccessToken if the user authenticated with a personal
+	// access token
+	ApiToken *models.APIToken
 }
 
-func ExtractClaims(tokenContextKey string) echo.MiddlewareFunc {
+// ApiTokenAuthenticator checks a personal access token and whether its scopes
+// allow the request
+type ApiTokenAuthenticator interface {
+	AuthenticateApiToken(ctx context.Context, token string, method string, path string) (*models.APIToken, error)
+}
+
+// SessionChecker tells whether the session of an access token still exists,
+// revoked sessions must not be usable until their access token expires
+type SessionChecker interface {
+	SessionActive(ctx context.Context, userId string, sessionId string) (bool, error)
+}
+
+// bearerApiToken returns the personal access token of the Authorization header
+func bearerApiToken(c echo.Context) (string, bool) {
+	scheme, token, found := strings.Cut(c.Request().Header.Get(echo.HeaderAuthorization), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	if !strings.HasPrefix(token, operations.ApiTokenPrefix) {
+		return "", false
+	}
+	return token, true
+}
+
+// ExtractClaims sets the auth context from the access token cookie or, if
+// there is none, from a personal access token sent as bearer token. Access
+// tokens of revoked sessions leave the request unauthenticated.
+func ExtractClaims(tokenContextKey string, apiTokens ApiTokenAuthenticator, sessions SessionChecker) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			anonymousContext := AuthContext{
@@ -32,13 +68,53 @@ func ExtractClaims(tokenContextKey string) echo.MiddlewareFunc {
 			}
 			token, ok := c.Get(tokenContextKey).(*jwt.Token)
 			if !ok {
-				c.Set("auth", &anonymousContext)
+				bearer, ok := bearerApiToken(c)
+				if !ok {
+					c.Set("auth", &anonymousContext)
+					return next(c)
+				}
+				path := c.Path()
+				if path == "" {
+					path = c.Request().URL.Path
+				}
+				apiToken, err := apiTokens.AuthenticateApiToken(c.Request().Context(), bearer, c.Request().Method, path)
+				if err != nil {
+					return err
+				}
+				owner := apiToken.R.Owner
+				c.Set("auth", &AuthContext{
+					Authenticated: true,
+					User: &UserContext{
+						UserId: owner.ID,
+						Email:  owner.Email,
+						Name:   owner.Name,
+					},
+					ApiToken: apiToken,
+				})
 				return next(c)
 			}
 			claims, ok := token.Claims.(*operations.AccessClaims)
 			if !ok {
 				return errors.New("failed to cast claims as ApplicationClaims")
 			}
+			if claims.Subject != "access" {
+				// other tokens signed with the same key, like 2FA challenges,
+				// do not authenticate requests
+				c.Set("auth", &anonymousContext)
+				return next(c)
+			}
+			if claims.SessionId == "" {
+				c.Set("auth", &anonymousContext)
+				return next(c)
+			}
+			active, err := sessions.SessionActive(c.Request().Context(), claims.UserId, claims.SessionId)
+			if err != nil {
+				return err
+			}
+			if !active {
+				c.Set("auth", &anonymousContext)
+				return next(c)
+			}
 			authenticatedContext := AuthContext{
 				Authenticated: true,
 				User: &UserContext{
@@ -47,6 +123,7 @@ func ExtractClaims(tokenContextKey string) echo.MiddlewareFunc {
 					Name:   claims.Name,
 				},
 				AccessToken: token,
+				SessionId:   claims.SessionId,
 			}
 			c.Set("auth", &authenticatedContext)
 			return next(c)
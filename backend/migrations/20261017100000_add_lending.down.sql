@@ -0,0 +1,4 @@
+DROP TABLE loans;
+DROP TYPE loan_state;
+DROP TABLE borrow_requests;
+DROP TYPE borrow_request_state;
@@ -0,0 +1,37 @@
+CREATE TYPE borrow_request_state AS ENUM('pending', 'accepted', 'rejected');
+
+CREATE TABLE borrow_requests (
+  id text PRIMARY KEY,
+  thing_id text NOT NULL,
+  borrower_id text NOT NULL,
+  owner_id text NOT NULL,
+  state borrow_request_state NOT NULL DEFAULT 'pending',
+  requested_due_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (thing_id) REFERENCES things(id) ON DELETE CASCADE,
+  FOREIGN KEY (borrower_id) REFERENCES users(id) ON DELETE CASCADE,
+  FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX borrow_requests_borrower_id_idx ON borrow_requests (borrower_id);
+CREATE INDEX borrow_requests_owner_id_idx ON borrow_requests (owner_id);
+
+CREATE TYPE loan_state AS ENUM('lent', 'returned', 'overdue');
+
+CREATE TABLE loans (
+  id text PRIMARY KEY,
+  borrow_request_id text NOT NULL UNIQUE,
+  thing_id text NOT NULL,
+  borrower_id text NOT NULL,
+  owner_id text NOT NULL,
+  state loan_state NOT NULL DEFAULT 'lent',
+  lent_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  due_at TIMESTAMP NOT NULL,
+  returned_at TIMESTAMP,
+  FOREIGN KEY (borrow_request_id) REFERENCES borrow_requests(id) ON DELETE CASCADE,
+  FOREIGN KEY (thing_id) REFERENCES things(id) ON DELETE CASCADE,
+  FOREIGN KEY (borrower_id) REFERENCES users(id) ON DELETE CASCADE,
+  FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX loans_state_due_at_idx ON loans (state, due_at);
@@ -0,0 +1,5 @@
+DROP INDEX quantity_entries_thing_id_created_at_idx;
+ALTER TABLE quantity_entries DROP COLUMN created_by_id;
+ALTER TABLE quantity_entries DROP COLUMN note;
+ALTER TABLE quantity_entries DROP COLUMN reason;
+DROP TYPE quantity_reason;
@@ -0,0 +1,10 @@
+CREATE TYPE quantity_reason AS ENUM('consumed', 'bought', 'lost', 'lent', 'correction');
+
+ALTER TABLE quantity_entries ADD COLUMN reason quantity_reason;
+ALTER TABLE quantity_entries ADD COLUMN note text NOT NULL DEFAULT '';
+ALTER TABLE quantity_entries ADD COLUMN created_by_id text REFERENCES users(id) ON DELETE SET NULL;
+
+-- until now only owners could change the quantity
+UPDATE quantity_entries SET created_by_id = things.owner_id FROM things WHERE things.id = quantity_entries.thing_id;
+
+CREATE INDEX quantity_entries_thing_id_created_at_idx ON quantity_entries (thing_id, created_at);
@@ -0,0 +1 @@
+ALTER TABLE things DROP COLUMN low_stock_threshold;
@@ -0,0 +1 @@
+ALTER TABLE things ADD COLUMN low_stock_threshold bigint;
@@ -0,0 +1,3 @@
+DROP INDEX things_location_id_idx;
+ALTER TABLE things DROP COLUMN location_id;
+DROP TABLE locations;
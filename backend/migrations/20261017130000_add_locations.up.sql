@@ -0,0 +1,18 @@
+CREATE TABLE locations (
+  id text PRIMARY KEY,
+  name text NOT NULL,
+  description text NOT NULL DEFAULT '',
+  owner_id text NOT NULL,
+  parent_id text,
+  sharing_state sharing_state NOT NULL DEFAULT 'private',
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE,
+  FOREIGN KEY (parent_id) REFERENCES locations(id) ON DELETE CASCADE
+);
+
+CREATE INDEX locations_owner_id_idx ON locations (owner_id);
+CREATE INDEX locations_parent_id_idx ON locations (parent_id);
+
+ALTER TABLE things ADD COLUMN location_id text REFERENCES locations(id) ON DELETE SET NULL;
+
+CREATE INDEX things_location_id_idx ON things (location_id);
@@ -0,0 +1,4 @@
+DROP INDEX lists_search_idx;
+DROP INDEX properties_search_idx;
+DROP INDEX things_private_note_search_idx;
+DROP INDEX things_search_idx;
@@ -0,0 +1,5 @@
+-- the expressions have to match the ones used in operations/search.go
+CREATE INDEX things_search_idx ON things USING GIN ((setweight(to_tsvector('simple', name), 'A') || setweight(to_tsvector('simple', description), 'B')));
+CREATE INDEX things_private_note_search_idx ON things USING GIN (to_tsvector('simple', private_note));
+CREATE INDEX properties_search_idx ON properties USING GIN (to_tsvector('simple', coalesce(value_string, '')));
+CREATE INDEX lists_search_idx ON lists USING GIN (to_tsvector('simple', name));
@@ -0,0 +1,2 @@
+ALTER TABLE lists DROP COLUMN updated_at;
+ALTER TABLE things DROP COLUMN updated_at;
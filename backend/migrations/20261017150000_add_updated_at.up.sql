@@ -0,0 +1,4 @@
+ALTER TABLE things ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE lists ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+UPDATE things SET updated_at = created_at;
+UPDATE lists SET updated_at = created_at;
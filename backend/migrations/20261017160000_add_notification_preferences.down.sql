@@ -0,0 +1,2 @@
+DROP TABLE notification_preferences;
+DROP TYPE notification_channel;
@@ -0,0 +1,12 @@
+CREATE TYPE notification_channel AS ENUM('in_app', 'email', 'digest', 'off');
+
+-- only preferences differing from the default are stored
+CREATE TABLE notification_preferences (
+  user_id text NOT NULL,
+  content_type text NOT NULL,
+  channel notification_channel NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (user_id, content_type),
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,6 @@
+CREATE TYPE digest_frequency AS ENUM('daily', 'weekly');
+
+ALTER TABLE users ADD COLUMN digest_frequency digest_frequency NOT NULL DEFAULT 'daily';
+-- time of the last digest, the next one is due after the digest interval.
+-- NULL until the first digest is sent
+ALTER TABLE users ADD COLUMN last_digest_at TIMESTAMP;
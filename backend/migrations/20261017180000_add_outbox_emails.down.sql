@@ -0,0 +1,2 @@
+DROP TABLE outbox_emails;
+DROP TYPE outbox_email_state;
@@ -0,0 +1,17 @@
+CREATE TYPE outbox_email_state AS ENUM('pending', 'sent', 'dead');
+
+CREATE TABLE outbox_emails (
+  id text PRIMARY KEY,
+  recipient text NOT NULL,
+  subject text NOT NULL,
+  body text NOT NULL,
+  state outbox_email_state NOT NULL DEFAULT 'pending',
+  attempts integer NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMP NOT NULL,
+  last_error text,
+  sent_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX outbox_emails_state_next_attempt_at_idx ON outbox_emails (state, next_attempt_at);
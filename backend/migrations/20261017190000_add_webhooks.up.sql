@@ -0,0 +1,35 @@
+CREATE TABLE webhooks (
+  id text PRIMARY KEY,
+  owner_id text NOT NULL,
+  url text NOT NULL,
+  secret text NOT NULL,
+  event_types text[] NOT NULL,
+  active boolean NOT NULL DEFAULT true,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX webhooks_owner_id_idx ON webhooks (owner_id);
+
+CREATE TYPE webhook_delivery_state AS ENUM('pending', 'delivered', 'dead');
+
+CREATE TABLE webhook_deliveries (
+  id text PRIMARY KEY,
+  webhook_id text NOT NULL,
+  event_type text NOT NULL,
+  payload text NOT NULL,
+  state webhook_delivery_state NOT NULL DEFAULT 'pending',
+  attempts integer NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMP NOT NULL,
+  -- status code of the last attempt, null if no response was received
+  response_code integer,
+  last_error text,
+  delivered_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX webhook_deliveries_state_next_attempt_at_idx ON webhook_deliveries (state, next_attempt_at);
+CREATE INDEX webhook_deliveries_webhook_id_created_at_idx ON webhook_deliveries (webhook_id, created_at);
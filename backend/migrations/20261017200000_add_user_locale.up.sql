@@ -0,0 +1,2 @@
+-- language of the emails sent to the user
+ALTER TABLE users ADD COLUMN locale text NOT NULL DEFAULT 'en';
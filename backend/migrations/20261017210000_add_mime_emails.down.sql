@@ -0,0 +1,6 @@
+ALTER TABLE users DROP COLUMN unsubscribe_token;
+
+ALTER TABLE outbox_emails DROP COLUMN inline_image_mime;
+ALTER TABLE outbox_emails DROP COLUMN inline_image;
+ALTER TABLE outbox_emails DROP COLUMN unsubscribe_url;
+ALTER TABLE outbox_emails DROP COLUMN html_body;
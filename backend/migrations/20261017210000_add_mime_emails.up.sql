@@ -0,0 +1,9 @@
+ALTER TABLE outbox_emails ADD COLUMN html_body text;
+-- sent as List-Unsubscribe header
+ALTER TABLE outbox_emails ADD COLUMN unsubscribe_url text;
+-- embedded into the HTML body, e.g. the thumbnail of a shared thing
+ALTER TABLE outbox_emails ADD COLUMN inline_image bytea;
+ALTER TABLE outbox_emails ADD COLUMN inline_image_mime text;
+
+-- identifies the user in one-click unsubscribe links, created on first use
+ALTER TABLE users ADD COLUMN unsubscribe_token text UNIQUE;
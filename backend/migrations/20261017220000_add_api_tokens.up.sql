@@ -0,0 +1,17 @@
+CREATE TABLE api_tokens (
+  id text PRIMARY KEY,
+  owner_id text NOT NULL,
+  name text NOT NULL,
+  -- sha256 of the token, the token itself is only shown on creation
+  token_hash text NOT NULL UNIQUE,
+  -- start of the token to tell tokens apart in listings
+  token_prefix text NOT NULL,
+  scopes text[] NOT NULL,
+  expires_at TIMESTAMP,
+  last_used_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX api_tokens_owner_id_idx ON api_tokens (owner_id);
@@ -0,0 +1,17 @@
+CREATE TABLE sessions (
+  id text PRIMARY KEY,
+  owner_id text NOT NULL,
+  -- id of the only refresh token currently valid for the session, rotated on
+  -- every refresh
+  refresh_token_id text NOT NULL UNIQUE,
+  user_agent text NOT NULL DEFAULT '',
+  ip_address text NOT NULL DEFAULT '',
+  last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  expires_at TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX sessions_owner_id_idx ON sessions (owner_id);
+CREATE INDEX sessions_expires_at_idx ON sessions (expires_at);
@@ -0,0 +1,19 @@
+-- base32 encoded TOTP secret, set on enrollment and kept while 2FA is enabled
+ALTER TABLE users ADD COLUMN totp_secret text;
+-- set once the enrollment has been confirmed with a code
+ALTER TABLE users ADD COLUMN totp_enabled_at TIMESTAMP;
+-- time step of the last accepted code, codes can not be used twice
+ALTER TABLE users ADD COLUMN totp_last_step bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE recovery_codes (
+  id text PRIMARY KEY,
+  owner_id text NOT NULL,
+  -- sha256 of the normalized code
+  code_hash text NOT NULL,
+  used_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX recovery_codes_owner_id_idx ON recovery_codes (owner_id);
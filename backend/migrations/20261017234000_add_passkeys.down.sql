@@ -0,0 +1,3 @@
+DROP TABLE webauthn_ceremonies;
+DROP TYPE webauthn_ceremony_kind;
+DROP TABLE passkeys;
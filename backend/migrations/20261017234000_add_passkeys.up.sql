@@ -0,0 +1,31 @@
+CREATE TABLE passkeys (
+  id text PRIMARY KEY,
+  owner_id text NOT NULL,
+  name text NOT NULL,
+  credential_id bytea NOT NULL UNIQUE,
+  -- the webauthn credential with its public key and sign count
+  credential jsonb NOT NULL,
+  last_used_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX passkeys_owner_id_idx ON passkeys (owner_id);
+
+CREATE TYPE webauthn_ceremony_kind AS ENUM ('registration', 'login');
+
+-- challenges of running passkey registrations and logins, deleted once the
+-- ceremony is finished so challenges can not be replayed
+CREATE TABLE webauthn_ceremonies (
+  id text PRIMARY KEY,
+  kind webauthn_ceremony_kind NOT NULL,
+  -- null for logins, where the user is only known from the credential
+  user_id text,
+  session_data jsonb NOT NULL,
+  expires_at TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX webauthn_ceremonies_expires_at_idx ON webauthn_ceremonies (expires_at);
@@ -0,0 +1,11 @@
+CREATE TABLE password_reset_tokens (
+  id text PRIMARY KEY,
+  user_id text NOT NULL,
+  -- sha256 of the token sent by email
+  token_hash text NOT NULL UNIQUE,
+  valid_until TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX password_reset_tokens_user_id_idx ON password_reset_tokens (user_id);
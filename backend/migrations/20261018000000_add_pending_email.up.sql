@@ -0,0 +1,3 @@
+-- new email address waiting for its verification code, the address is
+-- switched once the code is confirmed
+ALTER TABLE users ADD COLUMN pending_email VARCHAR(255);
@@ -0,0 +1,3 @@
+DROP TABLE oidc_logins;
+
+ALTER TABLE users DROP COLUMN oidc_subject;
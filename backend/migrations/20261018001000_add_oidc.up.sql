@@ -0,0 +1,15 @@
+-- subject of the user at the configured OpenID Connect provider
+ALTER TABLE users ADD COLUMN oidc_subject text UNIQUE;
+
+-- started logins at the OpenID Connect provider, the id is the state parameter
+CREATE TABLE oidc_logins (
+  id text PRIMARY KEY,
+  nonce text NOT NULL,
+  code_verifier text NOT NULL,
+  -- invite code for accounts provisioned by the login
+  invite_code text,
+  expires_at TIMESTAMP NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX oidc_logins_expires_at_idx ON oidc_logins (expires_at);
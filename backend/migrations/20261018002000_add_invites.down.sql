@@ -0,0 +1,3 @@
+ALTER TABLE users DROP COLUMN invite_id;
+
+DROP TABLE invites;
@@ -0,0 +1,21 @@
+CREATE TABLE invites (
+  id text PRIMARY KEY,
+  code text NOT NULL UNIQUE,
+  -- null for invites created by an administrator on the command line
+  owner_id text,
+  max_uses integer NOT NULL DEFAULT 1,
+  uses integer NOT NULL DEFAULT 0,
+  -- only this address can register with the invite if set
+  email VARCHAR(255),
+  expires_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE,
+  CHECK (max_uses > 0),
+  CHECK (uses <= max_uses)
+);
+
+CREATE INDEX invites_owner_id_idx ON invites (owner_id);
+
+-- invite the user registered with
+ALTER TABLE users ADD COLUMN invite_id text REFERENCES invites(id) ON DELETE SET NULL;
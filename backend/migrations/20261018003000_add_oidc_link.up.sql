@@ -0,0 +1,3 @@
+-- user linking their account at the OpenID Connect provider, the login does
+-- not start a session if set
+ALTER TABLE oidc_logins ADD COLUMN link_user_id text REFERENCES users(id) ON DELETE CASCADE;
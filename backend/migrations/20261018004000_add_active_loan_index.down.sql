@@ -0,0 +1 @@
+DROP INDEX loans_active_thing_id_idx;
@@ -0,0 +1,2 @@
+-- a thing can only be lent once at a time, also for concurrent approvals
+CREATE UNIQUE INDEX loans_active_thing_id_idx ON loans (thing_id) WHERE state IN ('lent', 'overdue');
@@ -0,0 +1,6 @@
+-- notifications already sent in a digest. The creation time can not be
+-- compared with last_digest_at, a notification committed after the digest
+-- query may have been created before it.
+ALTER TABLE notifications ADD COLUMN digested_at TIMESTAMP;
+UPDATE notifications SET digested_at = users.last_digest_at FROM users
+    WHERE users.id = notifications.recipient_id AND notifications.created_at <= users.last_digest_at;
@@ -4,41 +4,71 @@
 package models
 
 var TableNames = struct {
-	CartEntries            string
-	EmailVerificationCodes string
-	EmailVerifications     string
-	FriendRequests         string
-	Friendships            string
-	Images                 string
-	ImagesThings           string
-	Lists                  string
-	ListsThings            string
-	Notifications          string
-	Profiles               string
-	Properties             string
-	QuantityEntries        string
-	Shares                 string
-	SharesLists            string
-	SharesThings           string
-	Things                 string
-	Users                  string
+	APITokens               string
+	BorrowRequests          string
+	CartEntries             string
+	EmailVerificationCodes  string
+	EmailVerifications      string
+	FriendRequests          string
+	Friendships             string
+	Images                  string
+	ImagesThings            string
+	Invites                 string
+	Lists                   string
+	ListsThings             string
+	Loans                   string
+	Locations               string
+	NotificationPreferences string
+	Notifications           string
+	OidcLogins              string
+	OutboxEmails            string
+	Passkeys                string
+	PasswordResetTokens     string
+	Profiles                string
+	Properties              string
+	QuantityEntries         string
+	RecoveryCodes           string
+	Sessions                string
+	Shares                  string
+	SharesLists             string
+	SharesThings            string
+	Things                  string
+	Users                   string
+	WebauthnCeremonies      string
+	WebhookDeliveries       string
+	Webhooks                string
 }{
-	CartEntries:            "cart_entries",
-	EmailVerificationCodes: "email_verification_codes",
-	EmailVerifications:     "email_verifications",
-	FriendRequests:         "friend_requests",
-	Friendships:            "friendships",
-	Images:                 "images",
-	ImagesThings:           "images_things",
-	Lists:                  "lists",
-	ListsThings:            "lists_things",
-	Notifications:          "notifications",
-	Profiles:               "profiles",
-	Properties:             "properties",
-	QuantityEntries:        "quantity_entries",
-	Shares:                 "shares",
-	SharesLists:            "shares_lists",
-	SharesThings:           "shares_things",
-	Things:                 "things",
-	Users:                  "users",
+	APITokens:               "api_tokens",
+	BorrowRequests:          "borrow_requests",
+	CartEntries:             "cart_entries",
+	EmailVerificationCodes:  "email_verification_codes",
+	EmailVerifications:      "email_verifications",
+	FriendRequests:          "friend_requests",
+	Friendships:             "friendships",
+	Images:                  "images",
+	ImagesThings:            "images_things",
+	Invites:                 "invites",
+	Lists:                   "lists",
+	ListsThings:             "lists_things",
+	Loans:                   "loans",
+	Locations:               "locations",
+	NotificationPreferences: "notification_preferences",
+	Notifications:           "notifications",
+	OidcLogins:              "oidc_logins",
+	OutboxEmails:            "outbox_emails",
+	Passkeys:                "passkeys",
+	PasswordResetTokens:     "password_reset_tokens",
+	Profiles:                "profiles",
+	Properties:              "properties",
+	QuantityEntries:         "quantity_entries",
+	RecoveryCodes:           "recovery_codes",
+	Sessions:                "sessions",
+	Shares:                  "shares",
+	SharesLists:             "shares_lists",
+	SharesThings:            "shares_things",
+	Things:                  "things",
+	Users:                   "users",
+	WebauthnCeremonies:      "webauthn_ceremonies",
+	WebhookDeliveries:       "webhook_deliveries",
+	Webhooks:                "webhooks",
 }
@@ -4,8 +4,13 @@
 package models
 
 import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
 	"strconv"
 
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/null/v8/convert"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/strmangle"
 	"github.com/friendsofgo/errors"
@@ -51,6 +56,50 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	return str
 }
 
+type BorrowRequestState string
+
+// Enum values for BorrowRequestState
+const (
+	BorrowRequestStatePending  BorrowRequestState = "pending"
+	BorrowRequestStateAccepted BorrowRequestState = "accepted"
+	BorrowRequestStateRejected BorrowRequestState = "rejected"
+)
+
+func AllBorrowRequestState() []BorrowRequestState {
+	return []BorrowRequestState{
+		BorrowRequestStatePending,
+		BorrowRequestStateAccepted,
+		BorrowRequestStateRejected,
+	}
+}
+
+func (e BorrowRequestState) IsValid() error {
+	switch e {
+	case BorrowRequestStatePending, BorrowRequestStateAccepted, BorrowRequestStateRejected:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e BorrowRequestState) String() string {
+	return string(e)
+}
+
+func (e BorrowRequestState) Ordinal() int {
+	switch e {
+	case BorrowRequestStatePending:
+		return 0
+	case BorrowRequestStateAccepted:
+		return 1
+	case BorrowRequestStateRejected:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
 type FriendRequestState string
 
 // Enum values for FriendRequestState
@@ -139,6 +188,142 @@ func (e SharingState) Ordinal() int {
 	}
 }
 
+type LoanState string
+
+// Enum values for LoanState
+const (
+	LoanStateLent     LoanState = "lent"
+	LoanStateReturned LoanState = "returned"
+	LoanStateOverdue  LoanState = "overdue"
+)
+
+func AllLoanState() []LoanState {
+	return []LoanState{
+		LoanStateLent,
+		LoanStateReturned,
+		LoanStateOverdue,
+	}
+}
+
+func (e LoanState) IsValid() error {
+	switch e {
+	case LoanStateLent, LoanStateReturned, LoanStateOverdue:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e LoanState) String() string {
+	return string(e)
+}
+
+func (e LoanState) Ordinal() int {
+	switch e {
+	case LoanStateLent:
+		return 0
+	case LoanStateReturned:
+		return 1
+	case LoanStateOverdue:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type NotificationChannel string
+
+// Enum values for NotificationChannel
+const (
+	NotificationChannelInApp  NotificationChannel = "in_app"
+	NotificationChannelEmail  NotificationChannel = "email"
+	NotificationChannelDigest NotificationChannel = "digest"
+	NotificationChannelOff    NotificationChannel = "off"
+)
+
+func AllNotificationChannel() []NotificationChannel {
+	return []NotificationChannel{
+		NotificationChannelInApp,
+		NotificationChannelEmail,
+		NotificationChannelDigest,
+		NotificationChannelOff,
+	}
+}
+
+func (e NotificationChannel) IsValid() error {
+	switch e {
+	case NotificationChannelInApp, NotificationChannelEmail, NotificationChannelDigest, NotificationChannelOff:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e NotificationChannel) String() string {
+	return string(e)
+}
+
+func (e NotificationChannel) Ordinal() int {
+	switch e {
+	case NotificationChannelInApp:
+		return 0
+	case NotificationChannelEmail:
+		return 1
+	case NotificationChannelDigest:
+		return 2
+	case NotificationChannelOff:
+		return 3
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type OutboxEmailState string
+
+// Enum values for OutboxEmailState
+const (
+	OutboxEmailStatePending OutboxEmailState = "pending"
+	OutboxEmailStateSent    OutboxEmailState = "sent"
+	OutboxEmailStateDead    OutboxEmailState = "dead"
+)
+
+func AllOutboxEmailState() []OutboxEmailState {
+	return []OutboxEmailState{
+		OutboxEmailStatePending,
+		OutboxEmailStateSent,
+		OutboxEmailStateDead,
+	}
+}
+
+func (e OutboxEmailState) IsValid() error {
+	switch e {
+	case OutboxEmailStatePending, OutboxEmailStateSent, OutboxEmailStateDead:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e OutboxEmailState) String() string {
+	return string(e)
+}
+
+func (e OutboxEmailState) Ordinal() int {
+	switch e {
+	case OutboxEmailStatePending:
+		return 0
+	case OutboxEmailStateSent:
+		return 1
+	case OutboxEmailStateDead:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
 type PropertyType string
 
 // Enum values for PropertyType
@@ -182,3 +367,287 @@ func (e PropertyType) Ordinal() int {
 		panic(errors.New("enum is not valid"))
 	}
 }
+
+type QuantityReason string
+
+// Enum values for QuantityReason
+const (
+	QuantityReasonConsumed   QuantityReason = "consumed"
+	QuantityReasonBought     QuantityReason = "bought"
+	QuantityReasonLost       QuantityReason = "lost"
+	QuantityReasonLent       QuantityReason = "lent"
+	QuantityReasonCorrection QuantityReason = "correction"
+)
+
+func AllQuantityReason() []QuantityReason {
+	return []QuantityReason{
+		QuantityReasonConsumed,
+		QuantityReasonBought,
+		QuantityReasonLost,
+		QuantityReasonLent,
+		QuantityReasonCorrection,
+	}
+}
+
+func (e QuantityReason) IsValid() error {
+	switch e {
+	case QuantityReasonConsumed, QuantityReasonBought, QuantityReasonLost, QuantityReasonLent, QuantityReasonCorrection:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e QuantityReason) String() string {
+	return string(e)
+}
+
+func (e QuantityReason) Ordinal() int {
+	switch e {
+	case QuantityReasonConsumed:
+		return 0
+	case QuantityReasonBought:
+		return 1
+	case QuantityReasonLost:
+		return 2
+	case QuantityReasonLent:
+		return 3
+	case QuantityReasonCorrection:
+		return 4
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+// NullQuantityReason is a nullable QuantityReason enum type. It supports SQL and JSON serialization.
+type NullQuantityReason struct {
+	Val   QuantityReason
+	Valid bool
+}
+
+// NullQuantityReasonFrom creates a new QuantityReason that will never be blank.
+func NullQuantityReasonFrom(v QuantityReason) NullQuantityReason {
+	return NewNullQuantityReason(v, true)
+}
+
+// NullQuantityReasonFromPtr creates a new NullQuantityReason that be null if s is nil.
+func NullQuantityReasonFromPtr(v *QuantityReason) NullQuantityReason {
+	if v == nil {
+		return NewNullQuantityReason("", false)
+	}
+	return NewNullQuantityReason(*v, true)
+}
+
+// NewNullQuantityReason creates a new NullQuantityReason
+func NewNullQuantityReason(v QuantityReason, valid bool) NullQuantityReason {
+	return NullQuantityReason{
+		Val:   v,
+		Valid: valid,
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *NullQuantityReason) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, null.NullBytes) {
+		e.Val = ""
+		e.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &e.Val); err != nil {
+		return err
+	}
+
+	e.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e NullQuantityReason) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return null.NullBytes, nil
+	}
+	return json.Marshal(e.Val)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (e NullQuantityReason) MarshalText() ([]byte, error) {
+	if !e.Valid {
+		return []byte{}, nil
+	}
+	return []byte(e.Val), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *NullQuantityReason) UnmarshalText(text []byte) error {
+	if text == nil || len(text) == 0 {
+		e.Valid = false
+		return nil
+	}
+
+	e.Val = QuantityReason(text)
+	e.Valid = true
+	return nil
+}
+
+// SetValid changes this NullQuantityReason value and also sets it to be non-null.
+func (e *NullQuantityReason) SetValid(v QuantityReason) {
+	e.Val = v
+	e.Valid = true
+}
+
+// Ptr returns a pointer to this NullQuantityReason value, or a nil pointer if this NullQuantityReason is null.
+func (e NullQuantityReason) Ptr() *QuantityReason {
+	if !e.Valid {
+		return nil
+	}
+	return &e.Val
+}
+
+// IsZero returns true for null types.
+func (e NullQuantityReason) IsZero() bool {
+	return !e.Valid
+}
+
+// Scan implements the Scanner interface.
+func (e *NullQuantityReason) Scan(value interface{}) error {
+	if value == nil {
+		e.Val, e.Valid = "", false
+		return nil
+	}
+	e.Valid = true
+	return convert.ConvertAssign((*string)(&e.Val), value)
+}
+
+// Value implements the driver Valuer interface.
+func (e NullQuantityReason) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	return string(e.Val), nil
+}
+
+type DigestFrequency string
+
+// Enum values for DigestFrequency
+const (
+	DigestFrequencyDaily  DigestFrequency = "daily"
+	DigestFrequencyWeekly DigestFrequency = "weekly"
+)
+
+func AllDigestFrequency() []DigestFrequency {
+	return []DigestFrequency{
+		DigestFrequencyDaily,
+		DigestFrequencyWeekly,
+	}
+}
+
+func (e DigestFrequency) IsValid() error {
+	switch e {
+	case DigestFrequencyDaily, DigestFrequencyWeekly:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e DigestFrequency) String() string {
+	return string(e)
+}
+
+func (e DigestFrequency) Ordinal() int {
+	switch e {
+	case DigestFrequencyDaily:
+		return 0
+	case DigestFrequencyWeekly:
+		return 1
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type WebauthnCeremonyKind string
+
+// Enum values for WebauthnCeremonyKind
+const (
+	WebauthnCeremonyKindRegistration WebauthnCeremonyKind = "registration"
+	WebauthnCeremonyKindLogin        WebauthnCeremonyKind = "login"
+)
+
+func AllWebauthnCeremonyKind() []WebauthnCeremonyKind {
+	return []WebauthnCeremonyKind{
+		WebauthnCeremonyKindRegistration,
+		WebauthnCeremonyKindLogin,
+	}
+}
+
+func (e WebauthnCeremonyKind) IsValid() error {
+	switch e {
+	case WebauthnCeremonyKindRegistration, WebauthnCeremonyKindLogin:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e WebauthnCeremonyKind) String() string {
+	return string(e)
+}
+
+func (e WebauthnCeremonyKind) Ordinal() int {
+	switch e {
+	case WebauthnCeremonyKindRegistration:
+		return 0
+	case WebauthnCeremonyKindLogin:
+		return 1
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type WebhookDeliveryState string
+
+// Enum values for WebhookDeliveryState
+const (
+	WebhookDeliveryStatePending   WebhookDeliveryState = "pending"
+	WebhookDeliveryStateDelivered WebhookDeliveryState = "delivered"
+	WebhookDeliveryStateDead      WebhookDeliveryState = "dead"
+)
+
+func AllWebhookDeliveryState() []WebhookDeliveryState {
+	return []WebhookDeliveryState{
+		WebhookDeliveryStatePending,
+		WebhookDeliveryStateDelivered,
+		WebhookDeliveryStateDead,
+	}
+}
+
+func (e WebhookDeliveryState) IsValid() error {
+	switch e {
+	case WebhookDeliveryStatePending, WebhookDeliveryStateDelivered, WebhookDeliveryStateDead:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e WebhookDeliveryState) String() string {
+	return string(e)
+}
+
+func (e WebhookDeliveryState) Ordinal() int {
+	switch e {
+	case WebhookDeliveryStatePending:
+		return 0
+	case WebhookDeliveryStateDelivered:
+		return 1
+	case WebhookDeliveryStateDead:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
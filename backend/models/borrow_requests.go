@@ -0,0 +1,1802 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// BorrowRequest is an object representing the database table.
+type BorrowRequest struct {
+	ID             string             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ThingID        string             `boil:"thing_id" json:"thing_id" toml:"thing_id" yaml:"thing_id"`
+	BorrowerID     string             `boil:"borrower_id" json:"borrower_id" toml:"borrower_id" yaml:"borrower_id"`
+	OwnerID        string             `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	State          BorrowRequestState `boil:"state" json:"state" toml:"state" yaml:"state"`
+	RequestedDueAt null.Time          `boil:"requested_due_at" json:"requested_due_at,omitempty" toml:"requested_due_at" yaml:"requested_due_at,omitempty"`
+	CreatedAt      time.Time          `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *borrowRequestR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L borrowRequestL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BorrowRequestColumns = struct {
+	ID             string
+	ThingID        string
+	BorrowerID     string
+	OwnerID        string
+	State          string
+	RequestedDueAt string
+	CreatedAt      string
+}{
+	ID:             "id",
+	ThingID:        "thing_id",
+	BorrowerID:     "borrower_id",
+	OwnerID:        "owner_id",
+	State:          "state",
+	RequestedDueAt: "requested_due_at",
+	CreatedAt:      "created_at",
+}
+
+var BorrowRequestTableColumns = struct {
+	ID             string
+	ThingID        string
+	BorrowerID     string
+	OwnerID        string
+	State          string
+	RequestedDueAt string
+	CreatedAt      string
+}{
+	ID:             "borrow_requests.id",
+	ThingID:        "borrow_requests.thing_id",
+	BorrowerID:     "borrow_requests.borrower_id",
+	OwnerID:        "borrow_requests.owner_id",
+	State:          "borrow_requests.state",
+	RequestedDueAt: "borrow_requests.requested_due_at",
+	CreatedAt:      "borrow_requests.created_at",
+}
+
+// Generated where
+
+type whereHelperBorrowRequestState struct{ field string }
+
+func (w whereHelperBorrowRequestState) EQ(x BorrowRequestState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperBorrowRequestState) NEQ(x BorrowRequestState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperBorrowRequestState) LT(x BorrowRequestState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperBorrowRequestState) LTE(x BorrowRequestState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperBorrowRequestState) GT(x BorrowRequestState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperBorrowRequestState) GTE(x BorrowRequestState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperBorrowRequestState) IN(slice []BorrowRequestState) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperBorrowRequestState) NIN(slice []BorrowRequestState) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var BorrowRequestWhere = struct {
+	ID             whereHelperstring
+	ThingID        whereHelperstring
+	BorrowerID     whereHelperstring
+	OwnerID        whereHelperstring
+	State          whereHelperBorrowRequestState
+	RequestedDueAt whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperstring{field: "\"borrow_requests\".\"id\""},
+	ThingID:        whereHelperstring{field: "\"borrow_requests\".\"thing_id\""},
+	BorrowerID:     whereHelperstring{field: "\"borrow_requests\".\"borrower_id\""},
+	OwnerID:        whereHelperstring{field: "\"borrow_requests\".\"owner_id\""},
+	State:          whereHelperBorrowRequestState{field: "\"borrow_requests\".\"state\""},
+	RequestedDueAt: whereHelpernull_Time{field: "\"borrow_requests\".\"requested_due_at\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"borrow_requests\".\"created_at\""},
+}
+
+// BorrowRequestRels is where relationship names are stored.
+var BorrowRequestRels = struct {
+	Borrower string
+	Owner    string
+	Thing    string
+	Loan     string
+}{
+	Borrower: "Borrower",
+	Owner:    "Owner",
+	Thing:    "Thing",
+	Loan:     "Loan",
+}
+
+// borrowRequestR is where relationships are stored.
+type borrowRequestR struct {
+	Borrower *User  `boil:"Borrower" json:"Borrower" toml:"Borrower" yaml:"Borrower"`
+	Owner    *User  `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
+	Thing    *Thing `boil:"Thing" json:"Thing" toml:"Thing" yaml:"Thing"`
+	Loan     *Loan  `boil:"Loan" json:"Loan" toml:"Loan" yaml:"Loan"`
+}
+
+// NewStruct creates a new relationship struct
+func (*borrowRequestR) NewStruct() *borrowRequestR {
+	return &borrowRequestR{}
+}
+
+func (o *BorrowRequest) GetBorrower() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBorrower()
+}
+
+func (r *borrowRequestR) GetBorrower() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Borrower
+}
+
+func (o *BorrowRequest) GetOwner() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwner()
+}
+
+func (r *borrowRequestR) GetOwner() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Owner
+}
+
+func (o *BorrowRequest) GetThing() *Thing {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetThing()
+}
+
+func (r *borrowRequestR) GetThing() *Thing {
+	if r == nil {
+		return nil
+	}
+
+	return r.Thing
+}
+
+func (o *BorrowRequest) GetLoan() *Loan {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLoan()
+}
+
+func (r *borrowRequestR) GetLoan() *Loan {
+	if r == nil {
+		return nil
+	}
+
+	return r.Loan
+}
+
+// borrowRequestL is where Load methods for each relationship are stored.
+type borrowRequestL struct{}
+
+var (
+	borrowRequestAllColumns            = []string{"id", "thing_id", "borrower_id", "owner_id", "state", "requested_due_at", "created_at"}
+	borrowRequestColumnsWithoutDefault = []string{"id", "thing_id", "borrower_id", "owner_id"}
+	borrowRequestColumnsWithDefault    = []string{"state", "requested_due_at", "created_at"}
+	borrowRequestPrimaryKeyColumns     = []string{"id"}
+	borrowRequestGeneratedColumns      = []string{}
+)
+
+type (
+	// BorrowRequestSlice is an alias for a slice of pointers to BorrowRequest.
+	// This should almost always be used instead of []BorrowRequest.
+	BorrowRequestSlice []*BorrowRequest
+	// BorrowRequestHook is the signature for custom BorrowRequest hook methods
+	BorrowRequestHook func(context.Context, boil.ContextExecutor, *BorrowRequest) error
+
+	borrowRequestQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	borrowRequestType                 = reflect.TypeOf(&BorrowRequest{})
+	borrowRequestMapping              = queries.MakeStructMapping(borrowRequestType)
+	borrowRequestPrimaryKeyMapping, _ = queries.BindMapping(borrowRequestType, borrowRequestMapping, borrowRequestPrimaryKeyColumns)
+	borrowRequestInsertCacheMut       sync.RWMutex
+	borrowRequestInsertCache          = make(map[string]insertCache)
+	borrowRequestUpdateCacheMut       sync.RWMutex
+	borrowRequestUpdateCache          = make(map[string]updateCache)
+	borrowRequestUpsertCacheMut       sync.RWMutex
+	borrowRequestUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var borrowRequestAfterSelectMu sync.Mutex
+var borrowRequestAfterSelectHooks []BorrowRequestHook
+
+var borrowRequestBeforeInsertMu sync.Mutex
+var borrowRequestBeforeInsertHooks []BorrowRequestHook
+var borrowRequestAfterInsertMu sync.Mutex
+var borrowRequestAfterInsertHooks []BorrowRequestHook
+
+var borrowRequestBeforeUpdateMu sync.Mutex
+var borrowRequestBeforeUpdateHooks []BorrowRequestHook
+var borrowRequestAfterUpdateMu sync.Mutex
+var borrowRequestAfterUpdateHooks []BorrowRequestHook
+
+var borrowRequestBeforeDeleteMu sync.Mutex
+var borrowRequestBeforeDeleteHooks []BorrowRequestHook
+var borrowRequestAfterDeleteMu sync.Mutex
+var borrowRequestAfterDeleteHooks []BorrowRequestHook
+
+var borrowRequestBeforeUpsertMu sync.Mutex
+var borrowRequestBeforeUpsertHooks []BorrowRequestHook
+var borrowRequestAfterUpsertMu sync.Mutex
+var borrowRequestAfterUpsertHooks []BorrowRequestHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BorrowRequest) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range borrowRequestAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BorrowRequest) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range borrowRequestBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BorrowRequest) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range borrowRequestAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BorrowRequest) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range borrowRequestBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BorrowRequest) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range borrowRequestAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BorrowRequest) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range borrowRequestBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BorrowRequest) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range borrowRequestAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BorrowRequest) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range borrowRequestBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BorrowRequest) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range borrowRequestAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBorrowRequestHook registers your hook function for all future operations.
+func AddBorrowRequestHook(hookPoint boil.HookPoint, borrowRequestHook BorrowRequestHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		borrowRequestAfterSelectMu.Lock()
+		borrowRequestAfterSelectHooks = append(borrowRequestAfterSelectHooks, borrowRequestHook)
+		borrowRequestAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		borrowRequestBeforeInsertMu.Lock()
+		borrowRequestBeforeInsertHooks = append(borrowRequestBeforeInsertHooks, borrowRequestHook)
+		borrowRequestBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		borrowRequestAfterInsertMu.Lock()
+		borrowRequestAfterInsertHooks = append(borrowRequestAfterInsertHooks, borrowRequestHook)
+		borrowRequestAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		borrowRequestBeforeUpdateMu.Lock()
+		borrowRequestBeforeUpdateHooks = append(borrowRequestBeforeUpdateHooks, borrowRequestHook)
+		borrowRequestBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		borrowRequestAfterUpdateMu.Lock()
+		borrowRequestAfterUpdateHooks = append(borrowRequestAfterUpdateHooks, borrowRequestHook)
+		borrowRequestAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		borrowRequestBeforeDeleteMu.Lock()
+		borrowRequestBeforeDeleteHooks = append(borrowRequestBeforeDeleteHooks, borrowRequestHook)
+		borrowRequestBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		borrowRequestAfterDeleteMu.Lock()
+		borrowRequestAfterDeleteHooks = append(borrowRequestAfterDeleteHooks, borrowRequestHook)
+		borrowRequestAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		borrowRequestBeforeUpsertMu.Lock()
+		borrowRequestBeforeUpsertHooks = append(borrowRequestBeforeUpsertHooks, borrowRequestHook)
+		borrowRequestBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		borrowRequestAfterUpsertMu.Lock()
+		borrowRequestAfterUpsertHooks = append(borrowRequestAfterUpsertHooks, borrowRequestHook)
+		borrowRequestAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single borrowRequest record from the query.
+func (q borrowRequestQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BorrowRequest, error) {
+	o := &BorrowRequest{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for borrow_requests")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BorrowRequest records from the query.
+func (q borrowRequestQuery) All(ctx context.Context, exec boil.ContextExecutor) (BorrowRequestSlice, error) {
+	var o []*BorrowRequest
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to BorrowRequest slice")
+	}
+
+	if len(borrowRequestAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BorrowRequest records in the query.
+func (q borrowRequestQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count borrow_requests rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q borrowRequestQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if borrow_requests exists")
+	}
+
+	return count > 0, nil
+}
+
+// Borrower pointed to by the foreign key.
+func (o *BorrowRequest) Borrower(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BorrowerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Owner pointed to by the foreign key.
+func (o *BorrowRequest) Owner(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OwnerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Thing pointed to by the foreign key.
+func (o *BorrowRequest) Thing(mods ...qm.QueryMod) thingQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ThingID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Things(queryMods...)
+}
+
+// Loan pointed to by the foreign key.
+func (o *BorrowRequest) Loan(mods ...qm.QueryMod) loanQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"borrow_request_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Loans(queryMods...)
+}
+
+// LoadBorrower allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (borrowRequestL) LoadBorrower(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBorrowRequest interface{}, mods queries.Applicator) error {
+	var slice []*BorrowRequest
+	var object *BorrowRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeBorrowRequest.(*BorrowRequest)
+		if !ok {
+			object = new(BorrowRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBorrowRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBorrowRequest))
+			}
+		}
+	} else {
+		s, ok := maybeBorrowRequest.(*[]*BorrowRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBorrowRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBorrowRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &borrowRequestR{}
+		}
+		args[object.BorrowerID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &borrowRequestR{}
+			}
+
+			args[obj.BorrowerID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Borrower = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.BorrowerBorrowRequests = append(foreign.R.BorrowerBorrowRequests, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BorrowerID == foreign.ID {
+				local.R.Borrower = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.BorrowerBorrowRequests = append(foreign.R.BorrowerBorrowRequests, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwner allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (borrowRequestL) LoadOwner(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBorrowRequest interface{}, mods queries.Applicator) error {
+	var slice []*BorrowRequest
+	var object *BorrowRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeBorrowRequest.(*BorrowRequest)
+		if !ok {
+			object = new(BorrowRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBorrowRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBorrowRequest))
+			}
+		}
+	} else {
+		s, ok := maybeBorrowRequest.(*[]*BorrowRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBorrowRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBorrowRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &borrowRequestR{}
+		}
+		args[object.OwnerID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &borrowRequestR{}
+			}
+
+			args[obj.OwnerID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Owner = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OwnerBorrowRequests = append(foreign.R.OwnerBorrowRequests, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OwnerID == foreign.ID {
+				local.R.Owner = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OwnerBorrowRequests = append(foreign.R.OwnerBorrowRequests, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadThing allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (borrowRequestL) LoadThing(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBorrowRequest interface{}, mods queries.Applicator) error {
+	var slice []*BorrowRequest
+	var object *BorrowRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeBorrowRequest.(*BorrowRequest)
+		if !ok {
+			object = new(BorrowRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBorrowRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBorrowRequest))
+			}
+		}
+	} else {
+		s, ok := maybeBorrowRequest.(*[]*BorrowRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBorrowRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBorrowRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &borrowRequestR{}
+		}
+		args[object.ThingID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &borrowRequestR{}
+			}
+
+			args[obj.ThingID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`things`),
+		qm.WhereIn(`things.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Thing")
+	}
+
+	var resultSlice []*Thing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Thing")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for things")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for things")
+	}
+
+	if len(thingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Thing = foreign
+		if foreign.R == nil {
+			foreign.R = &thingR{}
+		}
+		foreign.R.BorrowRequests = append(foreign.R.BorrowRequests, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ThingID == foreign.ID {
+				local.R.Thing = foreign
+				if foreign.R == nil {
+					foreign.R = &thingR{}
+				}
+				foreign.R.BorrowRequests = append(foreign.R.BorrowRequests, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLoan allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (borrowRequestL) LoadLoan(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBorrowRequest interface{}, mods queries.Applicator) error {
+	var slice []*BorrowRequest
+	var object *BorrowRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeBorrowRequest.(*BorrowRequest)
+		if !ok {
+			object = new(BorrowRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBorrowRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBorrowRequest))
+			}
+		}
+	} else {
+		s, ok := maybeBorrowRequest.(*[]*BorrowRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBorrowRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBorrowRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &borrowRequestR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &borrowRequestR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`loans`),
+		qm.WhereIn(`loans.borrow_request_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Loan")
+	}
+
+	var resultSlice []*Loan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Loan")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for loans")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loans")
+	}
+
+	if len(loanAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Loan = foreign
+		if foreign.R == nil {
+			foreign.R = &loanR{}
+		}
+		foreign.R.BorrowRequest = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.BorrowRequestID {
+				local.R.Loan = foreign
+				if foreign.R == nil {
+					foreign.R = &loanR{}
+				}
+				foreign.R.BorrowRequest = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBorrower of the borrowRequest to the related item.
+// Sets o.R.Borrower to related.
+// Adds o to related.R.BorrowerBorrowRequests.
+func (o *BorrowRequest) SetBorrower(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"borrow_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"borrower_id"}),
+		strmangle.WhereClause("\"", "\"", 2, borrowRequestPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BorrowerID = related.ID
+	if o.R == nil {
+		o.R = &borrowRequestR{
+			Borrower: related,
+		}
+	} else {
+		o.R.Borrower = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			BorrowerBorrowRequests: BorrowRequestSlice{o},
+		}
+	} else {
+		related.R.BorrowerBorrowRequests = append(related.R.BorrowerBorrowRequests, o)
+	}
+
+	return nil
+}
+
+// SetOwner of the borrowRequest to the related item.
+// Sets o.R.Owner to related.
+// Adds o to related.R.OwnerBorrowRequests.
+func (o *BorrowRequest) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"borrow_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+		strmangle.WhereClause("\"", "\"", 2, borrowRequestPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OwnerID = related.ID
+	if o.R == nil {
+		o.R = &borrowRequestR{
+			Owner: related,
+		}
+	} else {
+		o.R.Owner = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OwnerBorrowRequests: BorrowRequestSlice{o},
+		}
+	} else {
+		related.R.OwnerBorrowRequests = append(related.R.OwnerBorrowRequests, o)
+	}
+
+	return nil
+}
+
+// SetThing of the borrowRequest to the related item.
+// Sets o.R.Thing to related.
+// Adds o to related.R.BorrowRequests.
+func (o *BorrowRequest) SetThing(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Thing) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"borrow_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"thing_id"}),
+		strmangle.WhereClause("\"", "\"", 2, borrowRequestPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ThingID = related.ID
+	if o.R == nil {
+		o.R = &borrowRequestR{
+			Thing: related,
+		}
+	} else {
+		o.R.Thing = related
+	}
+
+	if related.R == nil {
+		related.R = &thingR{
+			BorrowRequests: BorrowRequestSlice{o},
+		}
+	} else {
+		related.R.BorrowRequests = append(related.R.BorrowRequests, o)
+	}
+
+	return nil
+}
+
+// SetLoan of the borrowRequest to the related item.
+// Sets o.R.Loan to related.
+// Adds o to related.R.BorrowRequest.
+func (o *BorrowRequest) SetLoan(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Loan) error {
+	var err error
+
+	if insert {
+		related.BorrowRequestID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"loans\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"borrow_request_id"}),
+			strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.BorrowRequestID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &borrowRequestR{
+			Loan: related,
+		}
+	} else {
+		o.R.Loan = related
+	}
+
+	if related.R == nil {
+		related.R = &loanR{
+			BorrowRequest: o,
+		}
+	} else {
+		related.R.BorrowRequest = o
+	}
+	return nil
+}
+
+// BorrowRequests retrieves all the records using an executor.
+func BorrowRequests(mods ...qm.QueryMod) borrowRequestQuery {
+	mods = append(mods, qm.From("\"borrow_requests\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"borrow_requests\".*"})
+	}
+
+	return borrowRequestQuery{q}
+}
+
+// FindBorrowRequest retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBorrowRequest(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*BorrowRequest, error) {
+	borrowRequestObj := &BorrowRequest{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"borrow_requests\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, borrowRequestObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from borrow_requests")
+	}
+
+	if err = borrowRequestObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return borrowRequestObj, err
+	}
+
+	return borrowRequestObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BorrowRequest) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no borrow_requests provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(borrowRequestColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	borrowRequestInsertCacheMut.RLock()
+	cache, cached := borrowRequestInsertCache[key]
+	borrowRequestInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			borrowRequestAllColumns,
+			borrowRequestColumnsWithDefault,
+			borrowRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(borrowRequestType, borrowRequestMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(borrowRequestType, borrowRequestMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"borrow_requests\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"borrow_requests\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into borrow_requests")
+	}
+
+	if !cached {
+		borrowRequestInsertCacheMut.Lock()
+		borrowRequestInsertCache[key] = cache
+		borrowRequestInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BorrowRequest.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BorrowRequest) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	borrowRequestUpdateCacheMut.RLock()
+	cache, cached := borrowRequestUpdateCache[key]
+	borrowRequestUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			borrowRequestAllColumns,
+			borrowRequestPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update borrow_requests, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"borrow_requests\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, borrowRequestPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(borrowRequestType, borrowRequestMapping, append(wl, borrowRequestPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update borrow_requests row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for borrow_requests")
+	}
+
+	if !cached {
+		borrowRequestUpdateCacheMut.Lock()
+		borrowRequestUpdateCache[key] = cache
+		borrowRequestUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q borrowRequestQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for borrow_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for borrow_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BorrowRequestSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), borrowRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"borrow_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, borrowRequestPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in borrowRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all borrowRequest")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BorrowRequest) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no borrow_requests provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(borrowRequestColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	borrowRequestUpsertCacheMut.RLock()
+	cache, cached := borrowRequestUpsertCache[key]
+	borrowRequestUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			borrowRequestAllColumns,
+			borrowRequestColumnsWithDefault,
+			borrowRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			borrowRequestAllColumns,
+			borrowRequestPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert borrow_requests, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(borrowRequestAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(borrowRequestPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert borrow_requests, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(borrowRequestPrimaryKeyColumns))
+			copy(conflict, borrowRequestPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"borrow_requests\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(borrowRequestType, borrowRequestMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(borrowRequestType, borrowRequestMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert borrow_requests")
+	}
+
+	if !cached {
+		borrowRequestUpsertCacheMut.Lock()
+		borrowRequestUpsertCache[key] = cache
+		borrowRequestUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single BorrowRequest record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BorrowRequest) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no BorrowRequest provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), borrowRequestPrimaryKeyMapping)
+	sql := "DELETE FROM \"borrow_requests\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from borrow_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for borrow_requests")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q borrowRequestQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no borrowRequestQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from borrow_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for borrow_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BorrowRequestSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(borrowRequestBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), borrowRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"borrow_requests\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, borrowRequestPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from borrowRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for borrow_requests")
+	}
+
+	if len(borrowRequestAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BorrowRequest) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBorrowRequest(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BorrowRequestSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BorrowRequestSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), borrowRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"borrow_requests\".* FROM \"borrow_requests\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, borrowRequestPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in BorrowRequestSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BorrowRequestExists checks if the BorrowRequest row exists.
+func BorrowRequestExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"borrow_requests\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if borrow_requests exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the BorrowRequest row exists.
+func (o *BorrowRequest) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BorrowRequestExists(ctx, exec, o.ID)
+}
@@ -28,6 +28,7 @@ type List struct {
 	CreatedAt    time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	OwnerID      string       `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
 	SharingState SharingState `boil:"sharing_state" json:"sharing_state" toml:"sharing_state" yaml:"sharing_state"`
+	UpdatedAt    time.Time    `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *listR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L listL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -39,12 +40,14 @@ var ListColumns = struct {
 	CreatedAt    string
 	OwnerID      string
 	SharingState string
+	UpdatedAt    string
 }{
 	ID:           "id",
 	Name:         "name",
 	CreatedAt:    "created_at",
 	OwnerID:      "owner_id",
 	SharingState: "sharing_state",
+	UpdatedAt:    "updated_at",
 }
 
 var ListTableColumns = struct {
@@ -53,12 +56,14 @@ var ListTableColumns = struct {
 	CreatedAt    string
 	OwnerID      string
 	SharingState string
+	UpdatedAt    string
 }{
 	ID:           "lists.id",
 	Name:         "lists.name",
 	CreatedAt:    "lists.created_at",
 	OwnerID:      "lists.owner_id",
 	SharingState: "lists.sharing_state",
+	UpdatedAt:    "lists.updated_at",
 }
 
 // Generated where
@@ -104,12 +109,14 @@ var ListWhere = struct {
 	CreatedAt    whereHelpertime_Time
 	OwnerID      whereHelperstring
 	SharingState whereHelperSharingState
+	UpdatedAt    whereHelpertime_Time
 }{
 	ID:           whereHelperstring{field: "\"lists\".\"id\""},
 	Name:         whereHelperstring{field: "\"lists\".\"name\""},
 	CreatedAt:    whereHelpertime_Time{field: "\"lists\".\"created_at\""},
 	OwnerID:      whereHelperstring{field: "\"lists\".\"owner_id\""},
 	SharingState: whereHelperSharingState{field: "\"lists\".\"sharing_state\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"lists\".\"updated_at\""},
 }
 
 // ListRels is where relationship names are stored.
@@ -187,9 +194,9 @@ func (r *listR) GetShares() ShareSlice {
 type listL struct{}
 
 var (
-	listAllColumns            = []string{"id", "name", "created_at", "owner_id", "sharing_state"}
+	listAllColumns            = []string{"id", "name", "created_at", "owner_id", "sharing_state", "updated_at"}
 	listColumnsWithoutDefault = []string{"id", "name", "owner_id"}
-	listColumnsWithDefault    = []string{"created_at", "sharing_state"}
+	listColumnsWithDefault    = []string{"created_at", "sharing_state", "updated_at"}
 	listPrimaryKeyColumns     = []string{"id"}
 	listGeneratedColumns      = []string{}
 )
@@ -715,7 +722,7 @@ func (listL) LoadThings(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.Select("\"things\".\"id\", \"things\".\"name\", \"things\".\"created_at\", \"things\".\"owner_id\", \"things\".\"description\", \"things\".\"private_note\", \"things\".\"quantity_unit\", \"things\".\"sharing_state\", \"a\".\"list_id\""),
+		qm.Select("\"things\".\"id\", \"things\".\"name\", \"things\".\"created_at\", \"things\".\"owner_id\", \"things\".\"description\", \"things\".\"private_note\", \"things\".\"quantity_unit\", \"things\".\"sharing_state\", \"things\".\"low_stock_threshold\", \"things\".\"location_id\", \"things\".\"updated_at\", \"a\".\"list_id\""),
 		qm.From("\"things\""),
 		qm.InnerJoin("\"lists_things\" as \"a\" on \"things\".\"id\" = \"a\".\"thing_id\""),
 		qm.WhereIn("\"a\".\"list_id\" in ?", argsSlice...),
@@ -736,7 +743,7 @@ func (listL) LoadThings(ctx context.Context, e boil.ContextExecutor, singular bo
 		one := new(Thing)
 		var localJoinCol string
 
-		err = results.Scan(&one.ID, &one.Name, &one.CreatedAt, &one.OwnerID, &one.Description, &one.PrivateNote, &one.QuantityUnit, &one.SharingState, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Name, &one.CreatedAt, &one.OwnerID, &one.Description, &one.PrivateNote, &one.QuantityUnit, &one.SharingState, &one.LowStockThreshold, &one.LocationID, &one.UpdatedAt, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for things")
 		}
@@ -1310,6 +1317,9 @@ func (o *List) Insert(ctx context.Context, exec boil.ContextExecutor, columns bo
 		if o.CreatedAt.IsZero() {
 			o.CreatedAt = currTime
 		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
 	}
 
 	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
@@ -1386,6 +1396,12 @@ func (o *List) Insert(ctx context.Context, exec boil.ContextExecutor, columns bo
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
 func (o *List) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
 	var err error
 	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
 		return 0, err
@@ -1522,6 +1538,7 @@ func (o *List) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnCo
 		if o.CreatedAt.IsZero() {
 			o.CreatedAt = currTime
 		}
+		o.UpdatedAt = currTime
 	}
 
 	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
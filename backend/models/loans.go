@@ -0,0 +1,1802 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Loan is an object representing the database table.
+type Loan struct {
+	ID              string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	BorrowRequestID string    `boil:"borrow_request_id" json:"borrow_request_id" toml:"borrow_request_id" yaml:"borrow_request_id"`
+	ThingID         string    `boil:"thing_id" json:"thing_id" toml:"thing_id" yaml:"thing_id"`
+	BorrowerID      string    `boil:"borrower_id" json:"borrower_id" toml:"borrower_id" yaml:"borrower_id"`
+	OwnerID         string    `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	State           LoanState `boil:"state" json:"state" toml:"state" yaml:"state"`
+	LentAt          time.Time `boil:"lent_at" json:"lent_at" toml:"lent_at" yaml:"lent_at"`
+	DueAt           time.Time `boil:"due_at" json:"due_at" toml:"due_at" yaml:"due_at"`
+	ReturnedAt      null.Time `boil:"returned_at" json:"returned_at,omitempty" toml:"returned_at" yaml:"returned_at,omitempty"`
+
+	R *loanR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L loanL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LoanColumns = struct {
+	ID              string
+	BorrowRequestID string
+	ThingID         string
+	BorrowerID      string
+	OwnerID         string
+	State           string
+	LentAt          string
+	DueAt           string
+	ReturnedAt      string
+}{
+	ID:              "id",
+	BorrowRequestID: "borrow_request_id",
+	ThingID:         "thing_id",
+	BorrowerID:      "borrower_id",
+	OwnerID:         "owner_id",
+	State:           "state",
+	LentAt:          "lent_at",
+	DueAt:           "due_at",
+	ReturnedAt:      "returned_at",
+}
+
+var LoanTableColumns = struct {
+	ID              string
+	BorrowRequestID string
+	ThingID         string
+	BorrowerID      string
+	OwnerID         string
+	State           string
+	LentAt          string
+	DueAt           string
+	ReturnedAt      string
+}{
+	ID:              "loans.id",
+	BorrowRequestID: "loans.borrow_request_id",
+	ThingID:         "loans.thing_id",
+	BorrowerID:      "loans.borrower_id",
+	OwnerID:         "loans.owner_id",
+	State:           "loans.state",
+	LentAt:          "loans.lent_at",
+	DueAt:           "loans.due_at",
+	ReturnedAt:      "loans.returned_at",
+}
+
+// Generated where
+
+type whereHelperLoanState struct{ field string }
+
+func (w whereHelperLoanState) EQ(x LoanState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperLoanState) NEQ(x LoanState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperLoanState) LT(x LoanState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperLoanState) LTE(x LoanState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperLoanState) GT(x LoanState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperLoanState) GTE(x LoanState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperLoanState) IN(slice []LoanState) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperLoanState) NIN(slice []LoanState) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var LoanWhere = struct {
+	ID              whereHelperstring
+	BorrowRequestID whereHelperstring
+	ThingID         whereHelperstring
+	BorrowerID      whereHelperstring
+	OwnerID         whereHelperstring
+	State           whereHelperLoanState
+	LentAt          whereHelpertime_Time
+	DueAt           whereHelpertime_Time
+	ReturnedAt      whereHelpernull_Time
+}{
+	ID:              whereHelperstring{field: "\"loans\".\"id\""},
+	BorrowRequestID: whereHelperstring{field: "\"loans\".\"borrow_request_id\""},
+	ThingID:         whereHelperstring{field: "\"loans\".\"thing_id\""},
+	BorrowerID:      whereHelperstring{field: "\"loans\".\"borrower_id\""},
+	OwnerID:         whereHelperstring{field: "\"loans\".\"owner_id\""},
+	State:           whereHelperLoanState{field: "\"loans\".\"state\""},
+	LentAt:          whereHelpertime_Time{field: "\"loans\".\"lent_at\""},
+	DueAt:           whereHelpertime_Time{field: "\"loans\".\"due_at\""},
+	ReturnedAt:      whereHelpernull_Time{field: "\"loans\".\"returned_at\""},
+}
+
+// LoanRels is where relationship names are stored.
+var LoanRels = struct {
+	BorrowRequest string
+	Borrower      string
+	Owner         string
+	Thing         string
+}{
+	BorrowRequest: "BorrowRequest",
+	Borrower:      "Borrower",
+	Owner:         "Owner",
+	Thing:         "Thing",
+}
+
+// loanR is where relationships are stored.
+type loanR struct {
+	BorrowRequest *BorrowRequest `boil:"BorrowRequest" json:"BorrowRequest" toml:"BorrowRequest" yaml:"BorrowRequest"`
+	Borrower      *User          `boil:"Borrower" json:"Borrower" toml:"Borrower" yaml:"Borrower"`
+	Owner         *User          `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
+	Thing         *Thing         `boil:"Thing" json:"Thing" toml:"Thing" yaml:"Thing"`
+}
+
+// NewStruct creates a new relationship struct
+func (*loanR) NewStruct() *loanR {
+	return &loanR{}
+}
+
+func (o *Loan) GetBorrowRequest() *BorrowRequest {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBorrowRequest()
+}
+
+func (r *loanR) GetBorrowRequest() *BorrowRequest {
+	if r == nil {
+		return nil
+	}
+
+	return r.BorrowRequest
+}
+
+func (o *Loan) GetBorrower() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBorrower()
+}
+
+func (r *loanR) GetBorrower() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Borrower
+}
+
+func (o *Loan) GetOwner() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwner()
+}
+
+func (r *loanR) GetOwner() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Owner
+}
+
+func (o *Loan) GetThing() *Thing {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetThing()
+}
+
+func (r *loanR) GetThing() *Thing {
+	if r == nil {
+		return nil
+	}
+
+	return r.Thing
+}
+
+// loanL is where Load methods for each relationship are stored.
+type loanL struct{}
+
+var (
+	loanAllColumns            = []string{"id", "borrow_request_id", "thing_id", "borrower_id", "owner_id", "state", "lent_at", "due_at", "returned_at"}
+	loanColumnsWithoutDefault = []string{"id", "borrow_request_id", "thing_id", "borrower_id", "owner_id", "due_at"}
+	loanColumnsWithDefault    = []string{"state", "lent_at", "returned_at"}
+	loanPrimaryKeyColumns     = []string{"id"}
+	loanGeneratedColumns      = []string{}
+)
+
+type (
+	// LoanSlice is an alias for a slice of pointers to Loan.
+	// This should almost always be used instead of []Loan.
+	LoanSlice []*Loan
+	// LoanHook is the signature for custom Loan hook methods
+	LoanHook func(context.Context, boil.ContextExecutor, *Loan) error
+
+	loanQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	loanType                 = reflect.TypeOf(&Loan{})
+	loanMapping              = queries.MakeStructMapping(loanType)
+	loanPrimaryKeyMapping, _ = queries.BindMapping(loanType, loanMapping, loanPrimaryKeyColumns)
+	loanInsertCacheMut       sync.RWMutex
+	loanInsertCache          = make(map[string]insertCache)
+	loanUpdateCacheMut       sync.RWMutex
+	loanUpdateCache          = make(map[string]updateCache)
+	loanUpsertCacheMut       sync.RWMutex
+	loanUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var loanAfterSelectMu sync.Mutex
+var loanAfterSelectHooks []LoanHook
+
+var loanBeforeInsertMu sync.Mutex
+var loanBeforeInsertHooks []LoanHook
+var loanAfterInsertMu sync.Mutex
+var loanAfterInsertHooks []LoanHook
+
+var loanBeforeUpdateMu sync.Mutex
+var loanBeforeUpdateHooks []LoanHook
+var loanAfterUpdateMu sync.Mutex
+var loanAfterUpdateHooks []LoanHook
+
+var loanBeforeDeleteMu sync.Mutex
+var loanBeforeDeleteHooks []LoanHook
+var loanAfterDeleteMu sync.Mutex
+var loanAfterDeleteHooks []LoanHook
+
+var loanBeforeUpsertMu sync.Mutex
+var loanBeforeUpsertHooks []LoanHook
+var loanAfterUpsertMu sync.Mutex
+var loanAfterUpsertHooks []LoanHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Loan) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loanAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Loan) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loanBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Loan) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loanAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Loan) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loanBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Loan) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loanAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Loan) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loanBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Loan) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loanAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Loan) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loanBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Loan) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loanAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLoanHook registers your hook function for all future operations.
+func AddLoanHook(hookPoint boil.HookPoint, loanHook LoanHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		loanAfterSelectMu.Lock()
+		loanAfterSelectHooks = append(loanAfterSelectHooks, loanHook)
+		loanAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		loanBeforeInsertMu.Lock()
+		loanBeforeInsertHooks = append(loanBeforeInsertHooks, loanHook)
+		loanBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		loanAfterInsertMu.Lock()
+		loanAfterInsertHooks = append(loanAfterInsertHooks, loanHook)
+		loanAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		loanBeforeUpdateMu.Lock()
+		loanBeforeUpdateHooks = append(loanBeforeUpdateHooks, loanHook)
+		loanBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		loanAfterUpdateMu.Lock()
+		loanAfterUpdateHooks = append(loanAfterUpdateHooks, loanHook)
+		loanAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		loanBeforeDeleteMu.Lock()
+		loanBeforeDeleteHooks = append(loanBeforeDeleteHooks, loanHook)
+		loanBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		loanAfterDeleteMu.Lock()
+		loanAfterDeleteHooks = append(loanAfterDeleteHooks, loanHook)
+		loanAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		loanBeforeUpsertMu.Lock()
+		loanBeforeUpsertHooks = append(loanBeforeUpsertHooks, loanHook)
+		loanBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		loanAfterUpsertMu.Lock()
+		loanAfterUpsertHooks = append(loanAfterUpsertHooks, loanHook)
+		loanAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single loan record from the query.
+func (q loanQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Loan, error) {
+	o := &Loan{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for loans")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Loan records from the query.
+func (q loanQuery) All(ctx context.Context, exec boil.ContextExecutor) (LoanSlice, error) {
+	var o []*Loan
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Loan slice")
+	}
+
+	if len(loanAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Loan records in the query.
+func (q loanQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count loans rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q loanQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if loans exists")
+	}
+
+	return count > 0, nil
+}
+
+// BorrowRequest pointed to by the foreign key.
+func (o *Loan) BorrowRequest(mods ...qm.QueryMod) borrowRequestQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BorrowRequestID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return BorrowRequests(queryMods...)
+}
+
+// Borrower pointed to by the foreign key.
+func (o *Loan) Borrower(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BorrowerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Owner pointed to by the foreign key.
+func (o *Loan) Owner(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OwnerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Thing pointed to by the foreign key.
+func (o *Loan) Thing(mods ...qm.QueryMod) thingQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ThingID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Things(queryMods...)
+}
+
+// LoadBorrowRequest allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (loanL) LoadBorrowRequest(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLoan interface{}, mods queries.Applicator) error {
+	var slice []*Loan
+	var object *Loan
+
+	if singular {
+		var ok bool
+		object, ok = maybeLoan.(*Loan)
+		if !ok {
+			object = new(Loan)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLoan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLoan))
+			}
+		}
+	} else {
+		s, ok := maybeLoan.(*[]*Loan)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLoan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLoan))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &loanR{}
+		}
+		args[object.BorrowRequestID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &loanR{}
+			}
+
+			args[obj.BorrowRequestID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`borrow_requests`),
+		qm.WhereIn(`borrow_requests.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load BorrowRequest")
+	}
+
+	var resultSlice []*BorrowRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice BorrowRequest")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for borrow_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for borrow_requests")
+	}
+
+	if len(borrowRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.BorrowRequest = foreign
+		if foreign.R == nil {
+			foreign.R = &borrowRequestR{}
+		}
+		foreign.R.Loan = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BorrowRequestID == foreign.ID {
+				local.R.BorrowRequest = foreign
+				if foreign.R == nil {
+					foreign.R = &borrowRequestR{}
+				}
+				foreign.R.Loan = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBorrower allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (loanL) LoadBorrower(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLoan interface{}, mods queries.Applicator) error {
+	var slice []*Loan
+	var object *Loan
+
+	if singular {
+		var ok bool
+		object, ok = maybeLoan.(*Loan)
+		if !ok {
+			object = new(Loan)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLoan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLoan))
+			}
+		}
+	} else {
+		s, ok := maybeLoan.(*[]*Loan)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLoan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLoan))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &loanR{}
+		}
+		args[object.BorrowerID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &loanR{}
+			}
+
+			args[obj.BorrowerID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Borrower = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.BorrowerLoans = append(foreign.R.BorrowerLoans, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BorrowerID == foreign.ID {
+				local.R.Borrower = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.BorrowerLoans = append(foreign.R.BorrowerLoans, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwner allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (loanL) LoadOwner(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLoan interface{}, mods queries.Applicator) error {
+	var slice []*Loan
+	var object *Loan
+
+	if singular {
+		var ok bool
+		object, ok = maybeLoan.(*Loan)
+		if !ok {
+			object = new(Loan)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLoan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLoan))
+			}
+		}
+	} else {
+		s, ok := maybeLoan.(*[]*Loan)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLoan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLoan))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &loanR{}
+		}
+		args[object.OwnerID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &loanR{}
+			}
+
+			args[obj.OwnerID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Owner = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OwnerLoans = append(foreign.R.OwnerLoans, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OwnerID == foreign.ID {
+				local.R.Owner = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OwnerLoans = append(foreign.R.OwnerLoans, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadThing allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (loanL) LoadThing(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLoan interface{}, mods queries.Applicator) error {
+	var slice []*Loan
+	var object *Loan
+
+	if singular {
+		var ok bool
+		object, ok = maybeLoan.(*Loan)
+		if !ok {
+			object = new(Loan)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLoan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLoan))
+			}
+		}
+	} else {
+		s, ok := maybeLoan.(*[]*Loan)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLoan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLoan))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &loanR{}
+		}
+		args[object.ThingID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &loanR{}
+			}
+
+			args[obj.ThingID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`things`),
+		qm.WhereIn(`things.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Thing")
+	}
+
+	var resultSlice []*Thing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Thing")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for things")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for things")
+	}
+
+	if len(thingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Thing = foreign
+		if foreign.R == nil {
+			foreign.R = &thingR{}
+		}
+		foreign.R.Loans = append(foreign.R.Loans, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ThingID == foreign.ID {
+				local.R.Thing = foreign
+				if foreign.R == nil {
+					foreign.R = &thingR{}
+				}
+				foreign.R.Loans = append(foreign.R.Loans, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBorrowRequest of the loan to the related item.
+// Sets o.R.BorrowRequest to related.
+// Adds o to related.R.Loan.
+func (o *Loan) SetBorrowRequest(ctx context.Context, exec boil.ContextExecutor, insert bool, related *BorrowRequest) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"loans\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"borrow_request_id"}),
+		strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BorrowRequestID = related.ID
+	if o.R == nil {
+		o.R = &loanR{
+			BorrowRequest: related,
+		}
+	} else {
+		o.R.BorrowRequest = related
+	}
+
+	if related.R == nil {
+		related.R = &borrowRequestR{
+			Loan: o,
+		}
+	} else {
+		related.R.Loan = o
+	}
+
+	return nil
+}
+
+// SetBorrower of the loan to the related item.
+// Sets o.R.Borrower to related.
+// Adds o to related.R.BorrowerLoans.
+func (o *Loan) SetBorrower(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"loans\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"borrower_id"}),
+		strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BorrowerID = related.ID
+	if o.R == nil {
+		o.R = &loanR{
+			Borrower: related,
+		}
+	} else {
+		o.R.Borrower = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			BorrowerLoans: LoanSlice{o},
+		}
+	} else {
+		related.R.BorrowerLoans = append(related.R.BorrowerLoans, o)
+	}
+
+	return nil
+}
+
+// SetOwner of the loan to the related item.
+// Sets o.R.Owner to related.
+// Adds o to related.R.OwnerLoans.
+func (o *Loan) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"loans\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+		strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OwnerID = related.ID
+	if o.R == nil {
+		o.R = &loanR{
+			Owner: related,
+		}
+	} else {
+		o.R.Owner = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OwnerLoans: LoanSlice{o},
+		}
+	} else {
+		related.R.OwnerLoans = append(related.R.OwnerLoans, o)
+	}
+
+	return nil
+}
+
+// SetThing of the loan to the related item.
+// Sets o.R.Thing to related.
+// Adds o to related.R.Loans.
+func (o *Loan) SetThing(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Thing) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"loans\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"thing_id"}),
+		strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ThingID = related.ID
+	if o.R == nil {
+		o.R = &loanR{
+			Thing: related,
+		}
+	} else {
+		o.R.Thing = related
+	}
+
+	if related.R == nil {
+		related.R = &thingR{
+			Loans: LoanSlice{o},
+		}
+	} else {
+		related.R.Loans = append(related.R.Loans, o)
+	}
+
+	return nil
+}
+
+// Loans retrieves all the records using an executor.
+func Loans(mods ...qm.QueryMod) loanQuery {
+	mods = append(mods, qm.From("\"loans\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"loans\".*"})
+	}
+
+	return loanQuery{q}
+}
+
+// FindLoan retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLoan(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Loan, error) {
+	loanObj := &Loan{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"loans\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, loanObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from loans")
+	}
+
+	if err = loanObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return loanObj, err
+	}
+
+	return loanObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Loan) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no loans provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(loanColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	loanInsertCacheMut.RLock()
+	cache, cached := loanInsertCache[key]
+	loanInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			loanAllColumns,
+			loanColumnsWithDefault,
+			loanColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(loanType, loanMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(loanType, loanMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"loans\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"loans\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into loans")
+	}
+
+	if !cached {
+		loanInsertCacheMut.Lock()
+		loanInsertCache[key] = cache
+		loanInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Loan.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Loan) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	loanUpdateCacheMut.RLock()
+	cache, cached := loanUpdateCache[key]
+	loanUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			loanAllColumns,
+			loanPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update loans, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"loans\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, loanPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(loanType, loanMapping, append(wl, loanPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update loans row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for loans")
+	}
+
+	if !cached {
+		loanUpdateCacheMut.Lock()
+		loanUpdateCache[key] = cache
+		loanUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q loanQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for loans")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for loans")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LoanSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), loanPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"loans\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, loanPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in loan slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all loan")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Loan) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no loans provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(loanColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	loanUpsertCacheMut.RLock()
+	cache, cached := loanUpsertCache[key]
+	loanUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			loanAllColumns,
+			loanColumnsWithDefault,
+			loanColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			loanAllColumns,
+			loanPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert loans, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(loanAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(loanPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert loans, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(loanPrimaryKeyColumns))
+			copy(conflict, loanPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"loans\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(loanType, loanMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(loanType, loanMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert loans")
+	}
+
+	if !cached {
+		loanUpsertCacheMut.Lock()
+		loanUpsertCache[key] = cache
+		loanUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Loan record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Loan) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Loan provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), loanPrimaryKeyMapping)
+	sql := "DELETE FROM \"loans\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from loans")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for loans")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q loanQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no loanQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from loans")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for loans")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LoanSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(loanBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), loanPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"loans\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, loanPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from loan slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for loans")
+	}
+
+	if len(loanAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Loan) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLoan(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LoanSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LoanSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), loanPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"loans\".* FROM \"loans\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, loanPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LoanSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LoanExists checks if the Loan row exists.
+func LoanExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"loans\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if loans exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Loan row exists.
+func (o *Loan) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LoanExists(ctx, exec, o.ID)
+}
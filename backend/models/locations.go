@@ -0,0 +1,1954 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// Location is an object representing the database table.
+type Location struct {
+	ID           string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name         string       `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description  string       `boil:"description" json:"description" toml:"description" yaml:"description"`
+	OwnerID      string       `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	ParentID     null.String  `boil:"parent_id" json:"parent_id,omitempty" toml:"parent_id" yaml:"parent_id,omitempty"`
+	SharingState SharingState `boil:"sharing_state" json:"sharing_state" toml:"sharing_state" yaml:"sharing_state"`
+	CreatedAt    time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *locationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L locationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LocationColumns = struct {
+	ID           string
+	Name         string
+	Description  string
+	OwnerID      string
+	ParentID     string
+	SharingState string
+	CreatedAt    string
+}{
+	ID:           "id",
+	Name:         "name",
+	Description:  "description",
+	OwnerID:      "owner_id",
+	ParentID:     "parent_id",
+	SharingState: "sharing_state",
+	CreatedAt:    "created_at",
+}
+
+var LocationTableColumns = struct {
+	ID           string
+	Name         string
+	Description  string
+	OwnerID      string
+	ParentID     string
+	SharingState string
+	CreatedAt    string
+}{
+	ID:           "locations.id",
+	Name:         "locations.name",
+	Description:  "locations.description",
+	OwnerID:      "locations.owner_id",
+	ParentID:     "locations.parent_id",
+	SharingState: "locations.sharing_state",
+	CreatedAt:    "locations.created_at",
+}
+
+// Generated where
+
+var LocationWhere = struct {
+	ID           whereHelperstring
+	Name         whereHelperstring
+	Description  whereHelperstring
+	OwnerID      whereHelperstring
+	ParentID     whereHelpernull_String
+	SharingState whereHelperSharingState
+	CreatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperstring{field: "\"locations\".\"id\""},
+	Name:         whereHelperstring{field: "\"locations\".\"name\""},
+	Description:  whereHelperstring{field: "\"locations\".\"description\""},
+	OwnerID:      whereHelperstring{field: "\"locations\".\"owner_id\""},
+	ParentID:     whereHelpernull_String{field: "\"locations\".\"parent_id\""},
+	SharingState: whereHelperSharingState{field: "\"locations\".\"sharing_state\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"locations\".\"created_at\""},
+}
+
+// LocationRels is where relationship names are stored.
+var LocationRels = struct {
+	Owner           string
+	Parent          string
+	ParentLocations string
+	Things          string
+}{
+	Owner:           "Owner",
+	Parent:          "Parent",
+	ParentLocations: "ParentLocations",
+	Things:          "Things",
+}
+
+// locationR is where relationships are stored.
+type locationR struct {
+	Owner           *User         `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
+	Parent          *Location     `boil:"Parent" json:"Parent" toml:"Parent" yaml:"Parent"`
+	ParentLocations LocationSlice `boil:"ParentLocations" json:"ParentLocations" toml:"ParentLocations" yaml:"ParentLocations"`
+	Things          ThingSlice    `boil:"Things" json:"Things" toml:"Things" yaml:"Things"`
+}
+
+// NewStruct creates a new relationship struct
+func (*locationR) NewStruct() *locationR {
+	return &locationR{}
+}
+
+func (o *Location) GetOwner() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwner()
+}
+
+func (r *locationR) GetOwner() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.Owner
+}
+
+func (o *Location) GetParent() *Location {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetParent()
+}
+
+func (r *locationR) GetParent() *Location {
+	if r == nil {
+		return nil
+	}
+
+	return r.Parent
+}
+
+func (o *Location) GetParentLocations() LocationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetParentLocations()
+}
+
+func (r *locationR) GetParentLocations() LocationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ParentLocations
+}
+
+func (o *Location) GetThings() ThingSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetThings()
+}
+
+func (r *locationR) GetThings() ThingSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Things
+}
+
+// locationL is where Load methods for each relationship are stored.
+type locationL struct{}
+
+var (
+	locationAllColumns            = []string{"id", "name", "description", "owner_id", "parent_id", "sharing_state", "created_at"}
+	locationColumnsWithoutDefault = []string{"id", "name", "owner_id"}
+	locationColumnsWithDefault    = []string{"description", "parent_id", "sharing_state", "created_at"}
+	locationPrimaryKeyColumns     = []string{"id"}
+	locationGeneratedColumns      = []string{}
+)
+
+type (
+	// LocationSlice is an alias for a slice of pointers to Location.
+	// This should almost always be used instead of []Location.
+	LocationSlice []*Location
+	// LocationHook is the signature for custom Location hook methods
+	LocationHook func(context.Context, boil.ContextExecutor, *Location) error
+
+	locationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	locationType                 = reflect.TypeOf(&Location{})
+	locationMapping              = queries.MakeStructMapping(locationType)
+	locationPrimaryKeyMapping, _ = queries.BindMapping(locationType, locationMapping, locationPrimaryKeyColumns)
+	locationInsertCacheMut       sync.RWMutex
+	locationInsertCache          = make(map[string]insertCache)
+	locationUpdateCacheMut       sync.RWMutex
+	locationUpdateCache          = make(map[string]updateCache)
+	locationUpsertCacheMut       sync.RWMutex
+	locationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var locationAfterSelectMu sync.Mutex
+var locationAfterSelectHooks []LocationHook
+
+var locationBeforeInsertMu sync.Mutex
+var locationBeforeInsertHooks []LocationHook
+var locationAfterInsertMu sync.Mutex
+var locationAfterInsertHooks []LocationHook
+
+var locationBeforeUpdateMu sync.Mutex
+var locationBeforeUpdateHooks []LocationHook
+var locationAfterUpdateMu sync.Mutex
+var locationAfterUpdateHooks []LocationHook
+
+var locationBeforeDeleteMu sync.Mutex
+var locationBeforeDeleteHooks []LocationHook
+var locationAfterDeleteMu sync.Mutex
+var locationAfterDeleteHooks []LocationHook
+
+var locationBeforeUpsertMu sync.Mutex
+var locationBeforeUpsertHooks []LocationHook
+var locationAfterUpsertMu sync.Mutex
+var locationAfterUpsertHooks []LocationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Location) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Location) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Location) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Location) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Location) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Location) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Location) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Location) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Location) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range locationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLocationHook registers your hook function for all future operations.
+func AddLocationHook(hookPoint boil.HookPoint, locationHook LocationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		locationAfterSelectMu.Lock()
+		locationAfterSelectHooks = append(locationAfterSelectHooks, locationHook)
+		locationAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		locationBeforeInsertMu.Lock()
+		locationBeforeInsertHooks = append(locationBeforeInsertHooks, locationHook)
+		locationBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		locationAfterInsertMu.Lock()
+		locationAfterInsertHooks = append(locationAfterInsertHooks, locationHook)
+		locationAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		locationBeforeUpdateMu.Lock()
+		locationBeforeUpdateHooks = append(locationBeforeUpdateHooks, locationHook)
+		locationBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		locationAfterUpdateMu.Lock()
+		locationAfterUpdateHooks = append(locationAfterUpdateHooks, locationHook)
+		locationAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		locationBeforeDeleteMu.Lock()
+		locationBeforeDeleteHooks = append(locationBeforeDeleteHooks, locationHook)
+		locationBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		locationAfterDeleteMu.Lock()
+		locationAfterDeleteHooks = append(locationAfterDeleteHooks, locationHook)
+		locationAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		locationBeforeUpsertMu.Lock()
+		locationBeforeUpsertHooks = append(locationBeforeUpsertHooks, locationHook)
+		locationBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		locationAfterUpsertMu.Lock()
+		locationAfterUpsertHooks = append(locationAfterUpsertHooks, locationHook)
+		locationAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single location record from the query.
+func (q locationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Location, error) {
+	o := &Location{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for locations")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Location records from the query.
+func (q locationQuery) All(ctx context.Context, exec boil.ContextExecutor) (LocationSlice, error) {
+	var o []*Location
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Location slice")
+	}
+
+	if len(locationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Location records in the query.
+func (q locationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count locations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q locationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if locations exists")
+	}
+
+	return count > 0, nil
+}
+
+// Owner pointed to by the foreign key.
+func (o *Location) Owner(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OwnerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Parent pointed to by the foreign key.
+func (o *Location) Parent(mods ...qm.QueryMod) locationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ParentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Locations(queryMods...)
+}
+
+// ParentLocations retrieves all the location's Locations with an executor via parent_id column.
+func (o *Location) ParentLocations(mods ...qm.QueryMod) locationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"locations\".\"parent_id\"=?", o.ID),
+	)
+
+	return Locations(queryMods...)
+}
+
+// Things retrieves all the thing's Things with an executor.
+func (o *Location) Things(mods ...qm.QueryMod) thingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"things\".\"location_id\"=?", o.ID),
+	)
+
+	return Things(queryMods...)
+}
+
+// LoadOwner allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (locationL) LoadOwner(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLocation interface{}, mods queries.Applicator) error {
+	var slice []*Location
+	var object *Location
+
+	if singular {
+		var ok bool
+		object, ok = maybeLocation.(*Location)
+		if !ok {
+			object = new(Location)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLocation))
+			}
+		}
+	} else {
+		s, ok := maybeLocation.(*[]*Location)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLocation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &locationR{}
+		}
+		args[object.OwnerID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &locationR{}
+			}
+
+			args[obj.OwnerID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Owner = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OwnerLocations = append(foreign.R.OwnerLocations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OwnerID == foreign.ID {
+				local.R.Owner = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OwnerLocations = append(foreign.R.OwnerLocations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadParent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (locationL) LoadParent(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLocation interface{}, mods queries.Applicator) error {
+	var slice []*Location
+	var object *Location
+
+	if singular {
+		var ok bool
+		object, ok = maybeLocation.(*Location)
+		if !ok {
+			object = new(Location)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLocation))
+			}
+		}
+	} else {
+		s, ok := maybeLocation.(*[]*Location)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLocation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &locationR{}
+		}
+		if !queries.IsNil(object.ParentID) {
+			args[object.ParentID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &locationR{}
+			}
+
+			if !queries.IsNil(obj.ParentID) {
+				args[obj.ParentID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`locations`),
+		qm.WhereIn(`locations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Location")
+	}
+
+	var resultSlice []*Location
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Location")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for locations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for locations")
+	}
+
+	if len(locationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Parent = foreign
+		if foreign.R == nil {
+			foreign.R = &locationR{}
+		}
+		foreign.R.ParentLocations = append(foreign.R.ParentLocations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ParentID, foreign.ID) {
+				local.R.Parent = foreign
+				if foreign.R == nil {
+					foreign.R = &locationR{}
+				}
+				foreign.R.ParentLocations = append(foreign.R.ParentLocations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadParentLocations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (locationL) LoadParentLocations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLocation interface{}, mods queries.Applicator) error {
+	var slice []*Location
+	var object *Location
+
+	if singular {
+		var ok bool
+		object, ok = maybeLocation.(*Location)
+		if !ok {
+			object = new(Location)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLocation))
+			}
+		}
+	} else {
+		s, ok := maybeLocation.(*[]*Location)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLocation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &locationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &locationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`locations`),
+		qm.WhereIn(`locations.parent_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load locations")
+	}
+
+	var resultSlice []*Location
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice locations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on locations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for locations")
+	}
+
+	if len(locationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ParentLocations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &locationR{}
+			}
+			foreign.R.Parent = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ParentID) {
+				local.R.ParentLocations = append(local.R.ParentLocations, foreign)
+				if foreign.R == nil {
+					foreign.R = &locationR{}
+				}
+				foreign.R.Parent = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadThings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (locationL) LoadThings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLocation interface{}, mods queries.Applicator) error {
+	var slice []*Location
+	var object *Location
+
+	if singular {
+		var ok bool
+		object, ok = maybeLocation.(*Location)
+		if !ok {
+			object = new(Location)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLocation))
+			}
+		}
+	} else {
+		s, ok := maybeLocation.(*[]*Location)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLocation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &locationR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &locationR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`things`),
+		qm.WhereIn(`things.location_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load things")
+	}
+
+	var resultSlice []*Thing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice things")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on things")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for things")
+	}
+
+	if len(thingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Things = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &thingR{}
+			}
+			foreign.R.Location = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.LocationID) {
+				local.R.Things = append(local.R.Things, foreign)
+				if foreign.R == nil {
+					foreign.R = &thingR{}
+				}
+				foreign.R.Location = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOwner of the location to the related item.
+// Sets o.R.Owner to related.
+// Adds o to related.R.OwnerLocations.
+func (o *Location) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"locations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+		strmangle.WhereClause("\"", "\"", 2, locationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OwnerID = related.ID
+	if o.R == nil {
+		o.R = &locationR{
+			Owner: related,
+		}
+	} else {
+		o.R.Owner = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OwnerLocations: LocationSlice{o},
+		}
+	} else {
+		related.R.OwnerLocations = append(related.R.OwnerLocations, o)
+	}
+
+	return nil
+}
+
+// SetParent of the location to the related item.
+// Sets o.R.Parent to related.
+// Adds o to related.R.ParentLocations.
+func (o *Location) SetParent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Location) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"locations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"parent_id"}),
+		strmangle.WhereClause("\"", "\"", 2, locationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ParentID, related.ID)
+	if o.R == nil {
+		o.R = &locationR{
+			Parent: related,
+		}
+	} else {
+		o.R.Parent = related
+	}
+
+	if related.R == nil {
+		related.R = &locationR{
+			ParentLocations: LocationSlice{o},
+		}
+	} else {
+		related.R.ParentLocations = append(related.R.ParentLocations, o)
+	}
+
+	return nil
+}
+
+// RemoveParent relationship.
+// Sets o.R.Parent to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Location) RemoveParent(ctx context.Context, exec boil.ContextExecutor, related *Location) error {
+	var err error
+
+	queries.SetScanner(&o.ParentID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("parent_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Parent = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ParentLocations {
+		if queries.Equal(o.ParentID, ri.ParentID) {
+			continue
+		}
+
+		ln := len(related.R.ParentLocations)
+		if ln > 1 && i < ln-1 {
+			related.R.ParentLocations[i] = related.R.ParentLocations[ln-1]
+		}
+		related.R.ParentLocations = related.R.ParentLocations[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddParentLocations adds the given related objects to the existing relationships
+// of the location, optionally inserting them as new records.
+// Appends related to o.R.ParentLocations.
+// Sets related.R.Parent appropriately.
+func (o *Location) AddParentLocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Location) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ParentID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"locations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"parent_id"}),
+				strmangle.WhereClause("\"", "\"", 2, locationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ParentID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &locationR{
+			ParentLocations: related,
+		}
+	} else {
+		o.R.ParentLocations = append(o.R.ParentLocations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &locationR{
+				Parent: o,
+			}
+		} else {
+			rel.R.Parent = o
+		}
+	}
+	return nil
+}
+
+// SetParentLocations removes all previously related items of the
+// location replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Parent's ParentLocations accordingly.
+// Replaces o.R.ParentLocations with related.
+// Sets related.R.Parent's ParentLocations accordingly.
+func (o *Location) SetParentLocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Location) error {
+	query := "update \"locations\" set \"parent_id\" = null where \"parent_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ParentLocations {
+			queries.SetScanner(&rel.ParentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Parent = nil
+		}
+		o.R.ParentLocations = nil
+	}
+
+	return o.AddParentLocations(ctx, exec, insert, related...)
+}
+
+// RemoveParentLocations relationships from objects passed in.
+// Removes related items from R.ParentLocations (uses pointer comparison, removal does not keep order)
+// Sets related.R.Parent.
+func (o *Location) RemoveParentLocations(ctx context.Context, exec boil.ContextExecutor, related ...*Location) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ParentID, nil)
+		if rel.R != nil {
+			rel.R.Parent = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("parent_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ParentLocations {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ParentLocations)
+			if ln > 1 && i < ln-1 {
+				o.R.ParentLocations[i] = o.R.ParentLocations[ln-1]
+			}
+			o.R.ParentLocations = o.R.ParentLocations[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddThings adds the given related objects to the existing relationships
+// of the location, optionally inserting them as new records.
+// Appends related to o.R.Things.
+// Sets related.R.Location appropriately.
+func (o *Location) AddThings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Thing) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.LocationID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"things\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"location_id"}),
+				strmangle.WhereClause("\"", "\"", 2, thingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.LocationID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &locationR{
+			Things: related,
+		}
+	} else {
+		o.R.Things = append(o.R.Things, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &thingR{
+				Location: o,
+			}
+		} else {
+			rel.R.Location = o
+		}
+	}
+	return nil
+}
+
+// SetThings removes all previously related items of the
+// location replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Location's Things accordingly.
+// Replaces o.R.Things with related.
+// Sets related.R.Location's Things accordingly.
+func (o *Location) SetThings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Thing) error {
+	query := "update \"things\" set \"location_id\" = null where \"location_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Things {
+			queries.SetScanner(&rel.LocationID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Location = nil
+		}
+		o.R.Things = nil
+	}
+
+	return o.AddThings(ctx, exec, insert, related...)
+}
+
+// RemoveThings relationships from objects passed in.
+// Removes related items from R.Things (uses pointer comparison, removal does not keep order)
+// Sets related.R.Location.
+func (o *Location) RemoveThings(ctx context.Context, exec boil.ContextExecutor, related ...*Thing) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.LocationID, nil)
+		if rel.R != nil {
+			rel.R.Location = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("location_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Things {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Things)
+			if ln > 1 && i < ln-1 {
+				o.R.Things[i] = o.R.Things[ln-1]
+			}
+			o.R.Things = o.R.Things[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Locations retrieves all the records using an executor.
+func Locations(mods ...qm.QueryMod) locationQuery {
+	mods = append(mods, qm.From("\"locations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"locations\".*"})
+	}
+
+	return locationQuery{q}
+}
+
+// FindLocation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLocation(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Location, error) {
+	locationObj := &Location{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"locations\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, locationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from locations")
+	}
+
+	if err = locationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return locationObj, err
+	}
+
+	return locationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Location) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no locations provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(locationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	locationInsertCacheMut.RLock()
+	cache, cached := locationInsertCache[key]
+	locationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			locationAllColumns,
+			locationColumnsWithDefault,
+			locationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(locationType, locationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(locationType, locationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"locations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"locations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into locations")
+	}
+
+	if !cached {
+		locationInsertCacheMut.Lock()
+		locationInsertCache[key] = cache
+		locationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Location.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Location) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	locationUpdateCacheMut.RLock()
+	cache, cached := locationUpdateCache[key]
+	locationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			locationAllColumns,
+			locationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update locations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"locations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, locationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(locationType, locationMapping, append(wl, locationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update locations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for locations")
+	}
+
+	if !cached {
+		locationUpdateCacheMut.Lock()
+		locationUpdateCache[key] = cache
+		locationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q locationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for locations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for locations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LocationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), locationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"locations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, locationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in location slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all location")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Location) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no locations provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(locationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	locationUpsertCacheMut.RLock()
+	cache, cached := locationUpsertCache[key]
+	locationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			locationAllColumns,
+			locationColumnsWithDefault,
+			locationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			locationAllColumns,
+			locationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert locations, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(locationAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(locationPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert locations, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(locationPrimaryKeyColumns))
+			copy(conflict, locationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"locations\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(locationType, locationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(locationType, locationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert locations")
+	}
+
+	if !cached {
+		locationUpsertCacheMut.Lock()
+		locationUpsertCache[key] = cache
+		locationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Location record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Location) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Location provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), locationPrimaryKeyMapping)
+	sql := "DELETE FROM \"locations\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from locations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for locations")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q locationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no locationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from locations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for locations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LocationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(locationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), locationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"locations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, locationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from location slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for locations")
+	}
+
+	if len(locationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Location) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLocation(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LocationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LocationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), locationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"locations\".* FROM \"locations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, locationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LocationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LocationExists checks if the Location row exists.
+func LocationExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"locations\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if locations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Location row exists.
+func (o *Location) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LocationExists(ctx, exec, o.ID)
+}
@@ -31,6 +31,7 @@ type Notification struct {
 	Content        types.JSON `boil:"content" json:"content" toml:"content" yaml:"content"`
 	CreatedAt      time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	AcknowledgedAt null.Time  `boil:"acknowledged_at" json:"acknowledged_at,omitempty" toml:"acknowledged_at" yaml:"acknowledged_at,omitempty"`
+	DigestedAt     null.Time  `boil:"digested_at" json:"digested_at,omitempty" toml:"digested_at" yaml:"digested_at,omitempty"`
 
 	R *notificationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L notificationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -43,6 +44,7 @@ var NotificationColumns = struct {
 	Content        string
 	CreatedAt      string
 	AcknowledgedAt string
+	DigestedAt     string
 }{
 	ID:             "id",
 	RecipientID:    "recipient_id",
@@ -50,6 +52,7 @@ var NotificationColumns = struct {
 	Content:        "content",
 	CreatedAt:      "created_at",
 	AcknowledgedAt: "acknowledged_at",
+	DigestedAt:     "digested_at",
 }
 
 var NotificationTableColumns = struct {
@@ -59,6 +62,7 @@ var NotificationTableColumns = struct {
 	Content        string
 	CreatedAt      string
 	AcknowledgedAt string
+	DigestedAt     string
 }{
 	ID:             "notifications.id",
 	RecipientID:    "notifications.recipient_id",
@@ -66,6 +70,7 @@ var NotificationTableColumns = struct {
 	Content:        "notifications.content",
 	CreatedAt:      "notifications.created_at",
 	AcknowledgedAt: "notifications.acknowledged_at",
+	DigestedAt:     "notifications.digested_at",
 }
 
 // Generated where
@@ -98,6 +103,7 @@ var NotificationWhere = struct {
 	Content        whereHelpertypes_JSON
 	CreatedAt      whereHelpertime_Time
 	AcknowledgedAt whereHelpernull_Time
+	DigestedAt     whereHelpernull_Time
 }{
 	ID:             whereHelperstring{field: "\"notifications\".\"id\""},
 	RecipientID:    whereHelperstring{field: "\"notifications\".\"recipient_id\""},
@@ -105,6 +111,7 @@ var NotificationWhere = struct {
 	Content:        whereHelpertypes_JSON{field: "\"notifications\".\"content\""},
 	CreatedAt:      whereHelpertime_Time{field: "\"notifications\".\"created_at\""},
 	AcknowledgedAt: whereHelpernull_Time{field: "\"notifications\".\"acknowledged_at\""},
+	DigestedAt:     whereHelpernull_Time{field: "\"notifications\".\"digested_at\""},
 }
 
 // NotificationRels is where relationship names are stored.
@@ -144,9 +151,9 @@ func (r *notificationR) GetRecipient() *User {
 type notificationL struct{}
 
 var (
-	notificationAllColumns            = []string{"id", "recipient_id", "content_type", "content", "created_at", "acknowledged_at"}
+	notificationAllColumns            = []string{"id", "recipient_id", "content_type", "content", "created_at", "acknowledged_at", "digested_at"}
 	notificationColumnsWithoutDefault = []string{"id", "recipient_id", "content_type", "content"}
-	notificationColumnsWithDefault    = []string{"created_at", "acknowledged_at"}
+	notificationColumnsWithDefault    = []string{"created_at", "acknowledged_at", "digested_at"}
 	notificationPrimaryKeyColumns     = []string{"id"}
 	notificationGeneratedColumns      = []string{}
 )
@@ -0,0 +1,1213 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// OidcLogin is an object representing the database table.
+type OidcLogin struct {
+	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Nonce        string      `boil:"nonce" json:"nonce" toml:"nonce" yaml:"nonce"`
+	CodeVerifier string      `boil:"code_verifier" json:"code_verifier" toml:"code_verifier" yaml:"code_verifier"`
+	InviteCode   null.String `boil:"invite_code" json:"invite_code,omitempty" toml:"invite_code" yaml:"invite_code,omitempty"`
+	ExpiresAt    time.Time   `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	LinkUserID   null.String `boil:"link_user_id" json:"link_user_id,omitempty" toml:"link_user_id" yaml:"link_user_id,omitempty"`
+
+	R *oidcLoginR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oidcLoginL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OidcLoginColumns = struct {
+	ID           string
+	Nonce        string
+	CodeVerifier string
+	InviteCode   string
+	ExpiresAt    string
+	CreatedAt    string
+	LinkUserID   string
+}{
+	ID:           "id",
+	Nonce:        "nonce",
+	CodeVerifier: "code_verifier",
+	InviteCode:   "invite_code",
+	ExpiresAt:    "expires_at",
+	CreatedAt:    "created_at",
+	LinkUserID:   "link_user_id",
+}
+
+var OidcLoginTableColumns = struct {
+	ID           string
+	Nonce        string
+	CodeVerifier string
+	InviteCode   string
+	ExpiresAt    string
+	CreatedAt    string
+	LinkUserID   string
+}{
+	ID:           "oidc_logins.id",
+	Nonce:        "oidc_logins.nonce",
+	CodeVerifier: "oidc_logins.code_verifier",
+	InviteCode:   "oidc_logins.invite_code",
+	ExpiresAt:    "oidc_logins.expires_at",
+	CreatedAt:    "oidc_logins.created_at",
+	LinkUserID:   "oidc_logins.link_user_id",
+}
+
+// Generated where
+
+var OidcLoginWhere = struct {
+	ID           whereHelperstring
+	Nonce        whereHelperstring
+	CodeVerifier whereHelperstring
+	InviteCode   whereHelpernull_String
+	ExpiresAt    whereHelpertime_Time
+	CreatedAt    whereHelpertime_Time
+	LinkUserID   whereHelpernull_String
+}{
+	ID:           whereHelperstring{field: "\"oidc_logins\".\"id\""},
+	Nonce:        whereHelperstring{field: "\"oidc_logins\".\"nonce\""},
+	CodeVerifier: whereHelperstring{field: "\"oidc_logins\".\"code_verifier\""},
+	InviteCode:   whereHelpernull_String{field: "\"oidc_logins\".\"invite_code\""},
+	ExpiresAt:    whereHelpertime_Time{field: "\"oidc_logins\".\"expires_at\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"oidc_logins\".\"created_at\""},
+	LinkUserID:   whereHelpernull_String{field: "\"oidc_logins\".\"link_user_id\""},
+}
+
+// OidcLoginRels is where relationship names are stored.
+var OidcLoginRels = struct {
+	LinkUser string
+}{
+	LinkUser: "LinkUser",
+}
+
+// oidcLoginR is where relationships are stored.
+type oidcLoginR struct {
+	LinkUser *User `boil:"LinkUser" json:"LinkUser" toml:"LinkUser" yaml:"LinkUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*oidcLoginR) NewStruct() *oidcLoginR {
+	return &oidcLoginR{}
+}
+
+func (o *OidcLogin) GetLinkUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLinkUser()
+}
+
+func (r *oidcLoginR) GetLinkUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.LinkUser
+}
+
+// oidcLoginL is where Load methods for each relationship are stored.
+type oidcLoginL struct{}
+
+var (
+	oidcLoginAllColumns            = []string{"id", "nonce", "code_verifier", "invite_code", "expires_at", "created_at", "link_user_id"}
+	oidcLoginColumnsWithoutDefault = []string{"id", "nonce", "code_verifier", "expires_at"}
+	oidcLoginColumnsWithDefault    = []string{"invite_code", "created_at", "link_user_id"}
+	oidcLoginPrimaryKeyColumns     = []string{"id"}
+	oidcLoginGeneratedColumns      = []string{}
+)
+
+type (
+	// OidcLoginSlice is an alias for a slice of pointers to OidcLogin.
+	// This should almost always be used instead of []OidcLogin.
+	OidcLoginSlice []*OidcLogin
+	// OidcLoginHook is the signature for custom OidcLogin hook methods
+	OidcLoginHook func(context.Context, boil.ContextExecutor, *OidcLogin) error
+
+	oidcLoginQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oidcLoginType                 = reflect.TypeOf(&OidcLogin{})
+	oidcLoginMapping              = queries.MakeStructMapping(oidcLoginType)
+	oidcLoginPrimaryKeyMapping, _ = queries.BindMapping(oidcLoginType, oidcLoginMapping, oidcLoginPrimaryKeyColumns)
+	oidcLoginInsertCacheMut       sync.RWMutex
+	oidcLoginInsertCache          = make(map[string]insertCache)
+	oidcLoginUpdateCacheMut       sync.RWMutex
+	oidcLoginUpdateCache          = make(map[string]updateCache)
+	oidcLoginUpsertCacheMut       sync.RWMutex
+	oidcLoginUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var oidcLoginAfterSelectMu sync.Mutex
+var oidcLoginAfterSelectHooks []OidcLoginHook
+
+var oidcLoginBeforeInsertMu sync.Mutex
+var oidcLoginBeforeInsertHooks []OidcLoginHook
+var oidcLoginAfterInsertMu sync.Mutex
+var oidcLoginAfterInsertHooks []OidcLoginHook
+
+var oidcLoginBeforeUpdateMu sync.Mutex
+var oidcLoginBeforeUpdateHooks []OidcLoginHook
+var oidcLoginAfterUpdateMu sync.Mutex
+var oidcLoginAfterUpdateHooks []OidcLoginHook
+
+var oidcLoginBeforeDeleteMu sync.Mutex
+var oidcLoginBeforeDeleteHooks []OidcLoginHook
+var oidcLoginAfterDeleteMu sync.Mutex
+var oidcLoginAfterDeleteHooks []OidcLoginHook
+
+var oidcLoginBeforeUpsertMu sync.Mutex
+var oidcLoginBeforeUpsertHooks []OidcLoginHook
+var oidcLoginAfterUpsertMu sync.Mutex
+var oidcLoginAfterUpsertHooks []OidcLoginHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OidcLogin) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OidcLogin) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OidcLogin) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OidcLogin) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OidcLogin) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OidcLogin) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OidcLogin) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OidcLogin) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OidcLogin) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oidcLoginAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOidcLoginHook registers your hook function for all future operations.
+func AddOidcLoginHook(hookPoint boil.HookPoint, oidcLoginHook OidcLoginHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		oidcLoginAfterSelectMu.Lock()
+		oidcLoginAfterSelectHooks = append(oidcLoginAfterSelectHooks, oidcLoginHook)
+		oidcLoginAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		oidcLoginBeforeInsertMu.Lock()
+		oidcLoginBeforeInsertHooks = append(oidcLoginBeforeInsertHooks, oidcLoginHook)
+		oidcLoginBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		oidcLoginAfterInsertMu.Lock()
+		oidcLoginAfterInsertHooks = append(oidcLoginAfterInsertHooks, oidcLoginHook)
+		oidcLoginAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		oidcLoginBeforeUpdateMu.Lock()
+		oidcLoginBeforeUpdateHooks = append(oidcLoginBeforeUpdateHooks, oidcLoginHook)
+		oidcLoginBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		oidcLoginAfterUpdateMu.Lock()
+		oidcLoginAfterUpdateHooks = append(oidcLoginAfterUpdateHooks, oidcLoginHook)
+		oidcLoginAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		oidcLoginBeforeDeleteMu.Lock()
+		oidcLoginBeforeDeleteHooks = append(oidcLoginBeforeDeleteHooks, oidcLoginHook)
+		oidcLoginBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		oidcLoginAfterDeleteMu.Lock()
+		oidcLoginAfterDeleteHooks = append(oidcLoginAfterDeleteHooks, oidcLoginHook)
+		oidcLoginAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		oidcLoginBeforeUpsertMu.Lock()
+		oidcLoginBeforeUpsertHooks = append(oidcLoginBeforeUpsertHooks, oidcLoginHook)
+		oidcLoginBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		oidcLoginAfterUpsertMu.Lock()
+		oidcLoginAfterUpsertHooks = append(oidcLoginAfterUpsertHooks, oidcLoginHook)
+		oidcLoginAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single oidcLogin record from the query.
+func (q oidcLoginQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OidcLogin, error) {
+	o := &OidcLogin{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for oidc_logins")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OidcLogin records from the query.
+func (q oidcLoginQuery) All(ctx context.Context, exec boil.ContextExecutor) (OidcLoginSlice, error) {
+	var o []*OidcLogin
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OidcLogin slice")
+	}
+
+	if len(oidcLoginAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OidcLogin records in the query.
+func (q oidcLoginQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count oidc_logins rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q oidcLoginQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if oidc_logins exists")
+	}
+
+	return count > 0, nil
+}
+
+// LinkUser pointed to by the foreign key.
+func (o *OidcLogin) LinkUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LinkUserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadLinkUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oidcLoginL) LoadLinkUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOidcLogin interface{}, mods queries.Applicator) error {
+	var slice []*OidcLogin
+	var object *OidcLogin
+
+	if singular {
+		var ok bool
+		object, ok = maybeOidcLogin.(*OidcLogin)
+		if !ok {
+			object = new(OidcLogin)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOidcLogin)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOidcLogin))
+			}
+		}
+	} else {
+		s, ok := maybeOidcLogin.(*[]*OidcLogin)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOidcLogin)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOidcLogin))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &oidcLoginR{}
+		}
+		if !queries.IsNil(object.LinkUserID) {
+			args[object.LinkUserID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oidcLoginR{}
+			}
+
+			if !queries.IsNil(obj.LinkUserID) {
+				args[obj.LinkUserID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.LinkUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.LinkUserOidcLogins = append(foreign.R.LinkUserOidcLogins, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.LinkUserID, foreign.ID) {
+				local.R.LinkUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.LinkUserOidcLogins = append(foreign.R.LinkUserOidcLogins, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetLinkUser of the oidcLogin to the related item.
+// Sets o.R.LinkUser to related.
+// Adds o to related.R.LinkUserOidcLogins.
+func (o *OidcLogin) SetLinkUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oidc_logins\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"link_user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, oidcLoginPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LinkUserID, related.ID)
+	if o.R == nil {
+		o.R = &oidcLoginR{
+			LinkUser: related,
+		}
+	} else {
+		o.R.LinkUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			LinkUserOidcLogins: OidcLoginSlice{o},
+		}
+	} else {
+		related.R.LinkUserOidcLogins = append(related.R.LinkUserOidcLogins, o)
+	}
+
+	return nil
+}
+
+// RemoveLinkUser relationship.
+// Sets o.R.LinkUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *OidcLogin) RemoveLinkUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.LinkUserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("link_user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.LinkUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.LinkUserOidcLogins {
+		if queries.Equal(o.LinkUserID, ri.LinkUserID) {
+			continue
+		}
+
+		ln := len(related.R.LinkUserOidcLogins)
+		if ln > 1 && i < ln-1 {
+			related.R.LinkUserOidcLogins[i] = related.R.LinkUserOidcLogins[ln-1]
+		}
+		related.R.LinkUserOidcLogins = related.R.LinkUserOidcLogins[:ln-1]
+		break
+	}
+	return nil
+}
+
+// OidcLogins retrieves all the records using an executor.
+func OidcLogins(mods ...qm.QueryMod) oidcLoginQuery {
+	mods = append(mods, qm.From("\"oidc_logins\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"oidc_logins\".*"})
+	}
+
+	return oidcLoginQuery{q}
+}
+
+// FindOidcLogin retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOidcLogin(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*OidcLogin, error) {
+	oidcLoginObj := &OidcLogin{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"oidc_logins\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, oidcLoginObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from oidc_logins")
+	}
+
+	if err = oidcLoginObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return oidcLoginObj, err
+	}
+
+	return oidcLoginObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OidcLogin) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oidc_logins provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcLoginColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oidcLoginInsertCacheMut.RLock()
+	cache, cached := oidcLoginInsertCache[key]
+	oidcLoginInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oidcLoginAllColumns,
+			oidcLoginColumnsWithDefault,
+			oidcLoginColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(oidcLoginType, oidcLoginMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oidcLoginType, oidcLoginMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"oidc_logins\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"oidc_logins\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into oidc_logins")
+	}
+
+	if !cached {
+		oidcLoginInsertCacheMut.Lock()
+		oidcLoginInsertCache[key] = cache
+		oidcLoginInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OidcLogin.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OidcLogin) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	oidcLoginUpdateCacheMut.RLock()
+	cache, cached := oidcLoginUpdateCache[key]
+	oidcLoginUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oidcLoginAllColumns,
+			oidcLoginPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update oidc_logins, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"oidc_logins\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, oidcLoginPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oidcLoginType, oidcLoginMapping, append(wl, oidcLoginPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update oidc_logins row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for oidc_logins")
+	}
+
+	if !cached {
+		oidcLoginUpdateCacheMut.Lock()
+		oidcLoginUpdateCache[key] = cache
+		oidcLoginUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oidcLoginQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for oidc_logins")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for oidc_logins")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OidcLoginSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcLoginPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"oidc_logins\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, oidcLoginPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in oidcLogin slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all oidcLogin")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OidcLogin) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no oidc_logins provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oidcLoginColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oidcLoginUpsertCacheMut.RLock()
+	cache, cached := oidcLoginUpsertCache[key]
+	oidcLoginUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			oidcLoginAllColumns,
+			oidcLoginColumnsWithDefault,
+			oidcLoginColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			oidcLoginAllColumns,
+			oidcLoginPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert oidc_logins, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(oidcLoginAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(oidcLoginPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert oidc_logins, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(oidcLoginPrimaryKeyColumns))
+			copy(conflict, oidcLoginPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"oidc_logins\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(oidcLoginType, oidcLoginMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oidcLoginType, oidcLoginMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert oidc_logins")
+	}
+
+	if !cached {
+		oidcLoginUpsertCacheMut.Lock()
+		oidcLoginUpsertCache[key] = cache
+		oidcLoginUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OidcLogin record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OidcLogin) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OidcLogin provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oidcLoginPrimaryKeyMapping)
+	sql := "DELETE FROM \"oidc_logins\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from oidc_logins")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for oidc_logins")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q oidcLoginQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no oidcLoginQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidc_logins")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_logins")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OidcLoginSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(oidcLoginBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcLoginPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"oidc_logins\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oidcLoginPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oidcLogin slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oidc_logins")
+	}
+
+	if len(oidcLoginAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OidcLogin) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOidcLogin(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OidcLoginSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OidcLoginSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oidcLoginPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"oidc_logins\".* FROM \"oidc_logins\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oidcLoginPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OidcLoginSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OidcLoginExists checks if the OidcLogin row exists.
+func OidcLoginExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"oidc_logins\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if oidc_logins exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OidcLogin row exists.
+func (o *OidcLogin) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OidcLoginExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1103 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// OutboxEmail is an object representing the database table.
+type OutboxEmail struct {
+	ID              string           `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Recipient       string           `boil:"recipient" json:"recipient" toml:"recipient" yaml:"recipient"`
+	Subject         string           `boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
+	Body            string           `boil:"body" json:"body" toml:"body" yaml:"body"`
+	State           OutboxEmailState `boil:"state" json:"state" toml:"state" yaml:"state"`
+	Attempts        int              `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	NextAttemptAt   time.Time        `boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	LastError       null.String      `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	SentAt          null.Time        `boil:"sent_at" json:"sent_at,omitempty" toml:"sent_at" yaml:"sent_at,omitempty"`
+	CreatedAt       time.Time        `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time        `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	HTMLBody        null.String      `boil:"html_body" json:"html_body,omitempty" toml:"html_body" yaml:"html_body,omitempty"`
+	UnsubscribeURL  null.String      `boil:"unsubscribe_url" json:"unsubscribe_url,omitempty" toml:"unsubscribe_url" yaml:"unsubscribe_url,omitempty"`
+	InlineImage     null.Bytes       `boil:"inline_image" json:"inline_image,omitempty" toml:"inline_image" yaml:"inline_image,omitempty"`
+	InlineImageMime null.String      `boil:"inline_image_mime" json:"inline_image_mime,omitempty" toml:"inline_image_mime" yaml:"inline_image_mime,omitempty"`
+
+	R *outboxEmailR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L outboxEmailL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OutboxEmailColumns = struct {
+	ID              string
+	Recipient       string
+	Subject         string
+	Body            string
+	State           string
+	Attempts        string
+	NextAttemptAt   string
+	LastError       string
+	SentAt          string
+	CreatedAt       string
+	UpdatedAt       string
+	HTMLBody        string
+	UnsubscribeURL  string
+	InlineImage     string
+	InlineImageMime string
+}{
+	ID:              "id",
+	Recipient:       "recipient",
+	Subject:         "subject",
+	Body:            "body",
+	State:           "state",
+	Attempts:        "attempts",
+	NextAttemptAt:   "next_attempt_at",
+	LastError:       "last_error",
+	SentAt:          "sent_at",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	HTMLBody:        "html_body",
+	UnsubscribeURL:  "unsubscribe_url",
+	InlineImage:     "inline_image",
+	InlineImageMime: "inline_image_mime",
+}
+
+var OutboxEmailTableColumns = struct {
+	ID              string
+	Recipient       string
+	Subject         string
+	Body            string
+	State           string
+	Attempts        string
+	NextAttemptAt   string
+	LastError       string
+	SentAt          string
+	CreatedAt       string
+	UpdatedAt       string
+	HTMLBody        string
+	UnsubscribeURL  string
+	InlineImage     string
+	InlineImageMime string
+}{
+	ID:              "outbox_emails.id",
+	Recipient:       "outbox_emails.recipient",
+	Subject:         "outbox_emails.subject",
+	Body:            "outbox_emails.body",
+	State:           "outbox_emails.state",
+	Attempts:        "outbox_emails.attempts",
+	NextAttemptAt:   "outbox_emails.next_attempt_at",
+	LastError:       "outbox_emails.last_error",
+	SentAt:          "outbox_emails.sent_at",
+	CreatedAt:       "outbox_emails.created_at",
+	UpdatedAt:       "outbox_emails.updated_at",
+	HTMLBody:        "outbox_emails.html_body",
+	UnsubscribeURL:  "outbox_emails.unsubscribe_url",
+	InlineImage:     "outbox_emails.inline_image",
+	InlineImageMime: "outbox_emails.inline_image_mime",
+}
+
+// Generated where
+
+type whereHelperOutboxEmailState struct{ field string }
+
+func (w whereHelperOutboxEmailState) EQ(x OutboxEmailState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperOutboxEmailState) NEQ(x OutboxEmailState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperOutboxEmailState) LT(x OutboxEmailState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperOutboxEmailState) LTE(x OutboxEmailState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperOutboxEmailState) GT(x OutboxEmailState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperOutboxEmailState) GTE(x OutboxEmailState) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperOutboxEmailState) IN(slice []OutboxEmailState) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperOutboxEmailState) NIN(slice []OutboxEmailState) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpernull_Bytes struct{ field string }
+
+func (w whereHelpernull_Bytes) EQ(x null.Bytes) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Bytes) NEQ(x null.Bytes) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Bytes) LT(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Bytes) LTE(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Bytes) GT(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Bytes) GTE(x null.Bytes) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Bytes) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Bytes) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var OutboxEmailWhere = struct {
+	ID              whereHelperstring
+	Recipient       whereHelperstring
+	Subject         whereHelperstring
+	Body            whereHelperstring
+	State           whereHelperOutboxEmailState
+	Attempts        whereHelperint
+	NextAttemptAt   whereHelpertime_Time
+	LastError       whereHelpernull_String
+	SentAt          whereHelpernull_Time
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+	HTMLBody        whereHelpernull_String
+	UnsubscribeURL  whereHelpernull_String
+	InlineImage     whereHelpernull_Bytes
+	InlineImageMime whereHelpernull_String
+}{
+	ID:              whereHelperstring{field: "\"outbox_emails\".\"id\""},
+	Recipient:       whereHelperstring{field: "\"outbox_emails\".\"recipient\""},
+	Subject:         whereHelperstring{field: "\"outbox_emails\".\"subject\""},
+	Body:            whereHelperstring{field: "\"outbox_emails\".\"body\""},
+	State:           whereHelperOutboxEmailState{field: "\"outbox_emails\".\"state\""},
+	Attempts:        whereHelperint{field: "\"outbox_emails\".\"attempts\""},
+	NextAttemptAt:   whereHelpertime_Time{field: "\"outbox_emails\".\"next_attempt_at\""},
+	LastError:       whereHelpernull_String{field: "\"outbox_emails\".\"last_error\""},
+	SentAt:          whereHelpernull_Time{field: "\"outbox_emails\".\"sent_at\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"outbox_emails\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"outbox_emails\".\"updated_at\""},
+	HTMLBody:        whereHelpernull_String{field: "\"outbox_emails\".\"html_body\""},
+	UnsubscribeURL:  whereHelpernull_String{field: "\"outbox_emails\".\"unsubscribe_url\""},
+	InlineImage:     whereHelpernull_Bytes{field: "\"outbox_emails\".\"inline_image\""},
+	InlineImageMime: whereHelpernull_String{field: "\"outbox_emails\".\"inline_image_mime\""},
+}
+
+// OutboxEmailRels is where relationship names are stored.
+var OutboxEmailRels = struct {
+}{}
+
+// outboxEmailR is where relationships are stored.
+type outboxEmailR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*outboxEmailR) NewStruct() *outboxEmailR {
+	return &outboxEmailR{}
+}
+
+// outboxEmailL is where Load methods for each relationship are stored.
+type outboxEmailL struct{}
+
+var (
+	outboxEmailAllColumns            = []string{"id", "recipient", "subject", "body", "state", "attempts", "next_attempt_at", "last_error", "sent_at", "created_at", "updated_at", "html_body", "unsubscribe_url", "inline_image", "inline_image_mime"}
+	outboxEmailColumnsWithoutDefault = []string{"id", "recipient", "subject", "body", "next_attempt_at"}
+	outboxEmailColumnsWithDefault    = []string{"state", "attempts", "last_error", "sent_at", "created_at", "updated_at", "html_body", "unsubscribe_url", "inline_image", "inline_image_mime"}
+	outboxEmailPrimaryKeyColumns     = []string{"id"}
+	outboxEmailGeneratedColumns      = []string{}
+)
+
+type (
+	// OutboxEmailSlice is an alias for a slice of pointers to OutboxEmail.
+	// This should almost always be used instead of []OutboxEmail.
+	OutboxEmailSlice []*OutboxEmail
+	// OutboxEmailHook is the signature for custom OutboxEmail hook methods
+	OutboxEmailHook func(context.Context, boil.ContextExecutor, *OutboxEmail) error
+
+	outboxEmailQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	outboxEmailType                 = reflect.TypeOf(&OutboxEmail{})
+	outboxEmailMapping              = queries.MakeStructMapping(outboxEmailType)
+	outboxEmailPrimaryKeyMapping, _ = queries.BindMapping(outboxEmailType, outboxEmailMapping, outboxEmailPrimaryKeyColumns)
+	outboxEmailInsertCacheMut       sync.RWMutex
+	outboxEmailInsertCache          = make(map[string]insertCache)
+	outboxEmailUpdateCacheMut       sync.RWMutex
+	outboxEmailUpdateCache          = make(map[string]updateCache)
+	outboxEmailUpsertCacheMut       sync.RWMutex
+	outboxEmailUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var outboxEmailAfterSelectMu sync.Mutex
+var outboxEmailAfterSelectHooks []OutboxEmailHook
+
+var outboxEmailBeforeInsertMu sync.Mutex
+var outboxEmailBeforeInsertHooks []OutboxEmailHook
+var outboxEmailAfterInsertMu sync.Mutex
+var outboxEmailAfterInsertHooks []OutboxEmailHook
+
+var outboxEmailBeforeUpdateMu sync.Mutex
+var outboxEmailBeforeUpdateHooks []OutboxEmailHook
+var outboxEmailAfterUpdateMu sync.Mutex
+var outboxEmailAfterUpdateHooks []OutboxEmailHook
+
+var outboxEmailBeforeDeleteMu sync.Mutex
+var outboxEmailBeforeDeleteHooks []OutboxEmailHook
+var outboxEmailAfterDeleteMu sync.Mutex
+var outboxEmailAfterDeleteHooks []OutboxEmailHook
+
+var outboxEmailBeforeUpsertMu sync.Mutex
+var outboxEmailBeforeUpsertHooks []OutboxEmailHook
+var outboxEmailAfterUpsertMu sync.Mutex
+var outboxEmailAfterUpsertHooks []OutboxEmailHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OutboxEmail) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxEmailAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OutboxEmail) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxEmailBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OutboxEmail) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxEmailAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OutboxEmail) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxEmailBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OutboxEmail) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxEmailAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OutboxEmail) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxEmailBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OutboxEmail) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxEmailAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OutboxEmail) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxEmailBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OutboxEmail) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range outboxEmailAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOutboxEmailHook registers your hook function for all future operations.
+func AddOutboxEmailHook(hookPoint boil.HookPoint, outboxEmailHook OutboxEmailHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		outboxEmailAfterSelectMu.Lock()
+		outboxEmailAfterSelectHooks = append(outboxEmailAfterSelectHooks, outboxEmailHook)
+		outboxEmailAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		outboxEmailBeforeInsertMu.Lock()
+		outboxEmailBeforeInsertHooks = append(outboxEmailBeforeInsertHooks, outboxEmailHook)
+		outboxEmailBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		outboxEmailAfterInsertMu.Lock()
+		outboxEmailAfterInsertHooks = append(outboxEmailAfterInsertHooks, outboxEmailHook)
+		outboxEmailAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		outboxEmailBeforeUpdateMu.Lock()
+		outboxEmailBeforeUpdateHooks = append(outboxEmailBeforeUpdateHooks, outboxEmailHook)
+		outboxEmailBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		outboxEmailAfterUpdateMu.Lock()
+		outboxEmailAfterUpdateHooks = append(outboxEmailAfterUpdateHooks, outboxEmailHook)
+		outboxEmailAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		outboxEmailBeforeDeleteMu.Lock()
+		outboxEmailBeforeDeleteHooks = append(outboxEmailBeforeDeleteHooks, outboxEmailHook)
+		outboxEmailBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		outboxEmailAfterDeleteMu.Lock()
+		outboxEmailAfterDeleteHooks = append(outboxEmailAfterDeleteHooks, outboxEmailHook)
+		outboxEmailAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		outboxEmailBeforeUpsertMu.Lock()
+		outboxEmailBeforeUpsertHooks = append(outboxEmailBeforeUpsertHooks, outboxEmailHook)
+		outboxEmailBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		outboxEmailAfterUpsertMu.Lock()
+		outboxEmailAfterUpsertHooks = append(outboxEmailAfterUpsertHooks, outboxEmailHook)
+		outboxEmailAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single outboxEmail record from the query.
+func (q outboxEmailQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OutboxEmail, error) {
+	o := &OutboxEmail{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for outbox_emails")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OutboxEmail records from the query.
+func (q outboxEmailQuery) All(ctx context.Context, exec boil.ContextExecutor) (OutboxEmailSlice, error) {
+	var o []*OutboxEmail
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OutboxEmail slice")
+	}
+
+	if len(outboxEmailAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OutboxEmail records in the query.
+func (q outboxEmailQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count outbox_emails rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q outboxEmailQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if outbox_emails exists")
+	}
+
+	return count > 0, nil
+}
+
+// OutboxEmails retrieves all the records using an executor.
+func OutboxEmails(mods ...qm.QueryMod) outboxEmailQuery {
+	mods = append(mods, qm.From("\"outbox_emails\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"outbox_emails\".*"})
+	}
+
+	return outboxEmailQuery{q}
+}
+
+// FindOutboxEmail retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOutboxEmail(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*OutboxEmail, error) {
+	outboxEmailObj := &OutboxEmail{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"outbox_emails\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, outboxEmailObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from outbox_emails")
+	}
+
+	if err = outboxEmailObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return outboxEmailObj, err
+	}
+
+	return outboxEmailObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OutboxEmail) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no outbox_emails provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(outboxEmailColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	outboxEmailInsertCacheMut.RLock()
+	cache, cached := outboxEmailInsertCache[key]
+	outboxEmailInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			outboxEmailAllColumns,
+			outboxEmailColumnsWithDefault,
+			outboxEmailColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(outboxEmailType, outboxEmailMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(outboxEmailType, outboxEmailMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"outbox_emails\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"outbox_emails\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into outbox_emails")
+	}
+
+	if !cached {
+		outboxEmailInsertCacheMut.Lock()
+		outboxEmailInsertCache[key] = cache
+		outboxEmailInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OutboxEmail.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OutboxEmail) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	outboxEmailUpdateCacheMut.RLock()
+	cache, cached := outboxEmailUpdateCache[key]
+	outboxEmailUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			outboxEmailAllColumns,
+			outboxEmailPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update outbox_emails, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"outbox_emails\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, outboxEmailPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(outboxEmailType, outboxEmailMapping, append(wl, outboxEmailPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update outbox_emails row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for outbox_emails")
+	}
+
+	if !cached {
+		outboxEmailUpdateCacheMut.Lock()
+		outboxEmailUpdateCache[key] = cache
+		outboxEmailUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q outboxEmailQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for outbox_emails")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for outbox_emails")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OutboxEmailSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxEmailPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"outbox_emails\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, outboxEmailPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in outboxEmail slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all outboxEmail")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OutboxEmail) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no outbox_emails provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(outboxEmailColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	outboxEmailUpsertCacheMut.RLock()
+	cache, cached := outboxEmailUpsertCache[key]
+	outboxEmailUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			outboxEmailAllColumns,
+			outboxEmailColumnsWithDefault,
+			outboxEmailColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			outboxEmailAllColumns,
+			outboxEmailPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert outbox_emails, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(outboxEmailAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(outboxEmailPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert outbox_emails, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(outboxEmailPrimaryKeyColumns))
+			copy(conflict, outboxEmailPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"outbox_emails\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(outboxEmailType, outboxEmailMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(outboxEmailType, outboxEmailMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert outbox_emails")
+	}
+
+	if !cached {
+		outboxEmailUpsertCacheMut.Lock()
+		outboxEmailUpsertCache[key] = cache
+		outboxEmailUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OutboxEmail record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OutboxEmail) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OutboxEmail provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), outboxEmailPrimaryKeyMapping)
+	sql := "DELETE FROM \"outbox_emails\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from outbox_emails")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for outbox_emails")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q outboxEmailQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no outboxEmailQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from outbox_emails")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for outbox_emails")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OutboxEmailSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(outboxEmailBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxEmailPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"outbox_emails\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, outboxEmailPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from outboxEmail slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for outbox_emails")
+	}
+
+	if len(outboxEmailAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OutboxEmail) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOutboxEmail(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OutboxEmailSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OutboxEmailSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxEmailPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"outbox_emails\".* FROM \"outbox_emails\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, outboxEmailPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OutboxEmailSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OutboxEmailExists checks if the OutboxEmail row exists.
+func OutboxEmailExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"outbox_emails\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if outbox_emails exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OutboxEmail row exists.
+func (o *OutboxEmail) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OutboxEmailExists(ctx, exec, o.ID)
+}
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,37 +24,52 @@ import (
 
 // QuantityEntry is an object representing the database table.
 type QuantityEntry struct {
-	ID         string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ThingID    string    `boil:"thing_id" json:"thing_id" toml:"thing_id" yaml:"thing_id"`
-	DeltaValue int64     `boil:"delta_value" json:"delta_value" toml:"delta_value" yaml:"delta_value"`
-	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID          string             `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ThingID     string             `boil:"thing_id" json:"thing_id" toml:"thing_id" yaml:"thing_id"`
+	DeltaValue  int64              `boil:"delta_value" json:"delta_value" toml:"delta_value" yaml:"delta_value"`
+	CreatedAt   time.Time          `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Reason      NullQuantityReason `boil:"reason" json:"reason,omitempty" toml:"reason" yaml:"reason,omitempty"`
+	Note        string             `boil:"note" json:"note" toml:"note" yaml:"note"`
+	CreatedByID null.String        `boil:"created_by_id" json:"created_by_id,omitempty" toml:"created_by_id" yaml:"created_by_id,omitempty"`
 
 	R *quantityEntryR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L quantityEntryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var QuantityEntryColumns = struct {
-	ID         string
-	ThingID    string
-	DeltaValue string
-	CreatedAt  string
+	ID          string
+	ThingID     string
+	DeltaValue  string
+	CreatedAt   string
+	Reason      string
+	Note        string
+	CreatedByID string
 }{
-	ID:         "id",
-	ThingID:    "thing_id",
-	DeltaValue: "delta_value",
-	CreatedAt:  "created_at",
+	ID:          "id",
+	ThingID:     "thing_id",
+	DeltaValue:  "delta_value",
+	CreatedAt:   "created_at",
+	Reason:      "reason",
+	Note:        "note",
+	CreatedByID: "created_by_id",
 }
 
 var QuantityEntryTableColumns = struct {
-	ID         string
-	ThingID    string
-	DeltaValue string
-	CreatedAt  string
+	ID          string
+	ThingID     string
+	DeltaValue  string
+	CreatedAt   string
+	Reason      string
+	Note        string
+	CreatedByID string
 }{
-	ID:         "quantity_entries.id",
-	ThingID:    "quantity_entries.thing_id",
-	DeltaValue: "quantity_entries.delta_value",
-	CreatedAt:  "quantity_entries.created_at",
+	ID:          "quantity_entries.id",
+	ThingID:     "quantity_entries.thing_id",
+	DeltaValue:  "quantity_entries.delta_value",
+	CreatedAt:   "quantity_entries.created_at",
+	Reason:      "quantity_entries.reason",
+	Note:        "quantity_entries.note",
+	CreatedByID: "quantity_entries.created_by_id",
 }
 
 // Generated where
@@ -81,28 +97,77 @@ func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
+type whereHelperNullQuantityReason struct{ field string }
+
+func (w whereHelperNullQuantityReason) EQ(x NullQuantityReason) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelperNullQuantityReason) NEQ(x NullQuantityReason) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelperNullQuantityReason) LT(x NullQuantityReason) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperNullQuantityReason) LTE(x NullQuantityReason) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperNullQuantityReason) GT(x NullQuantityReason) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperNullQuantityReason) GTE(x NullQuantityReason) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperNullQuantityReason) IN(slice []NullQuantityReason) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperNullQuantityReason) NIN(slice []NullQuantityReason) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelperNullQuantityReason) IsNull() qm.QueryMod { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelperNullQuantityReason) IsNotNull() qm.QueryMod {
+	return qmhelper.WhereIsNotNull(w.field)
+}
+
 var QuantityEntryWhere = struct {
-	ID         whereHelperstring
-	ThingID    whereHelperstring
-	DeltaValue whereHelperint64
-	CreatedAt  whereHelpertime_Time
+	ID          whereHelperstring
+	ThingID     whereHelperstring
+	DeltaValue  whereHelperint64
+	CreatedAt   whereHelpertime_Time
+	Reason      whereHelperNullQuantityReason
+	Note        whereHelperstring
+	CreatedByID whereHelpernull_String
 }{
-	ID:         whereHelperstring{field: "\"quantity_entries\".\"id\""},
-	ThingID:    whereHelperstring{field: "\"quantity_entries\".\"thing_id\""},
-	DeltaValue: whereHelperint64{field: "\"quantity_entries\".\"delta_value\""},
-	CreatedAt:  whereHelpertime_Time{field: "\"quantity_entries\".\"created_at\""},
+	ID:          whereHelperstring{field: "\"quantity_entries\".\"id\""},
+	ThingID:     whereHelperstring{field: "\"quantity_entries\".\"thing_id\""},
+	DeltaValue:  whereHelperint64{field: "\"quantity_entries\".\"delta_value\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"quantity_entries\".\"created_at\""},
+	Reason:      whereHelperNullQuantityReason{field: "\"quantity_entries\".\"reason\""},
+	Note:        whereHelperstring{field: "\"quantity_entries\".\"note\""},
+	CreatedByID: whereHelpernull_String{field: "\"quantity_entries\".\"created_by_id\""},
 }
 
 // QuantityEntryRels is where relationship names are stored.
 var QuantityEntryRels = struct {
-	Thing string
+	CreatedBy string
+	Thing     string
 }{
-	Thing: "Thing",
+	CreatedBy: "CreatedBy",
+	Thing:     "Thing",
 }
 
 // quantityEntryR is where relationships are stored.
 type quantityEntryR struct {
-	Thing *Thing `boil:"Thing" json:"Thing" toml:"Thing" yaml:"Thing"`
+	CreatedBy *User  `boil:"CreatedBy" json:"CreatedBy" toml:"CreatedBy" yaml:"CreatedBy"`
+	Thing     *Thing `boil:"Thing" json:"Thing" toml:"Thing" yaml:"Thing"`
 }
 
 // NewStruct creates a new relationship struct
@@ -110,6 +175,22 @@ func (*quantityEntryR) NewStruct() *quantityEntryR {
 	return &quantityEntryR{}
 }
 
+func (o *QuantityEntry) GetCreatedBy() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedBy()
+}
+
+func (r *quantityEntryR) GetCreatedBy() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedBy
+}
+
 func (o *QuantityEntry) GetThing() *Thing {
 	if o == nil {
 		return nil
@@ -130,9 +211,9 @@ func (r *quantityEntryR) GetThing() *Thing {
 type quantityEntryL struct{}
 
 var (
-	quantityEntryAllColumns            = []string{"id", "thing_id", "delta_value", "created_at"}
+	quantityEntryAllColumns            = []string{"id", "thing_id", "delta_value", "created_at", "reason", "note", "created_by_id"}
 	quantityEntryColumnsWithoutDefault = []string{"id", "thing_id", "delta_value"}
-	quantityEntryColumnsWithDefault    = []string{"created_at"}
+	quantityEntryColumnsWithDefault    = []string{"created_at", "reason", "note", "created_by_id"}
 	quantityEntryPrimaryKeyColumns     = []string{"id"}
 	quantityEntryGeneratedColumns      = []string{}
 )
@@ -442,6 +523,17 @@ func (q quantityEntryQuery) Exists(ctx context.Context, exec boil.ContextExecuto
 	return count > 0, nil
 }
 
+// CreatedBy pointed to by the foreign key.
+func (o *QuantityEntry) CreatedBy(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatedByID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
 // Thing pointed to by the foreign key.
 func (o *QuantityEntry) Thing(mods ...qm.QueryMod) thingQuery {
 	queryMods := []qm.QueryMod{
@@ -453,6 +545,130 @@ func (o *QuantityEntry) Thing(mods ...qm.QueryMod) thingQuery {
 	return Things(queryMods...)
 }
 
+// LoadCreatedBy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (quantityEntryL) LoadCreatedBy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeQuantityEntry interface{}, mods queries.Applicator) error {
+	var slice []*QuantityEntry
+	var object *QuantityEntry
+
+	if singular {
+		var ok bool
+		object, ok = maybeQuantityEntry.(*QuantityEntry)
+		if !ok {
+			object = new(QuantityEntry)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeQuantityEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeQuantityEntry))
+			}
+		}
+	} else {
+		s, ok := maybeQuantityEntry.(*[]*QuantityEntry)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeQuantityEntry)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeQuantityEntry))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &quantityEntryR{}
+		}
+		if !queries.IsNil(object.CreatedByID) {
+			args[object.CreatedByID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &quantityEntryR{}
+			}
+
+			if !queries.IsNil(obj.CreatedByID) {
+				args[obj.CreatedByID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreatedBy = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatedByQuantityEntries = append(foreign.R.CreatedByQuantityEntries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CreatedByID, foreign.ID) {
+				local.R.CreatedBy = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatedByQuantityEntries = append(foreign.R.CreatedByQuantityEntries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadThing allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (quantityEntryL) LoadThing(ctx context.Context, e boil.ContextExecutor, singular bool, maybeQuantityEntry interface{}, mods queries.Applicator) error {
@@ -573,6 +789,86 @@ func (quantityEntryL) LoadThing(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
+// SetCreatedBy of the quantityEntry to the related item.
+// Sets o.R.CreatedBy to related.
+// Adds o to related.R.CreatedByQuantityEntries.
+func (o *QuantityEntry) SetCreatedBy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"quantity_entries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+		strmangle.WhereClause("\"", "\"", 2, quantityEntryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CreatedByID, related.ID)
+	if o.R == nil {
+		o.R = &quantityEntryR{
+			CreatedBy: related,
+		}
+	} else {
+		o.R.CreatedBy = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByQuantityEntries: QuantityEntrySlice{o},
+		}
+	} else {
+		related.R.CreatedByQuantityEntries = append(related.R.CreatedByQuantityEntries, o)
+	}
+
+	return nil
+}
+
+// RemoveCreatedBy relationship.
+// Sets o.R.CreatedBy to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *QuantityEntry) RemoveCreatedBy(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.CreatedByID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("created_by_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.CreatedBy = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CreatedByQuantityEntries {
+		if queries.Equal(o.CreatedByID, ri.CreatedByID) {
+			continue
+		}
+
+		ln := len(related.R.CreatedByQuantityEntries)
+		if ln > 1 && i < ln-1 {
+			related.R.CreatedByQuantityEntries[i] = related.R.CreatedByQuantityEntries[ln-1]
+		}
+		related.R.CreatedByQuantityEntries = related.R.CreatedByQuantityEntries[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetThing of the quantityEntry to the related item.
 // Sets o.R.Thing to related.
 // Adds o to related.R.QuantityEntries.
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,95 +24,160 @@ import (
 
 // Thing is an object representing the database table.
 type Thing struct {
-	ID           string       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name         string       `boil:"name" json:"name" toml:"name" yaml:"name"`
-	CreatedAt    time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	OwnerID      string       `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
-	Description  string       `boil:"description" json:"description" toml:"description" yaml:"description"`
-	PrivateNote  string       `boil:"private_note" json:"private_note" toml:"private_note" yaml:"private_note"`
-	QuantityUnit string       `boil:"quantity_unit" json:"quantity_unit" toml:"quantity_unit" yaml:"quantity_unit"`
-	SharingState SharingState `boil:"sharing_state" json:"sharing_state" toml:"sharing_state" yaml:"sharing_state"`
+	ID                string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name              string       `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt         time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	OwnerID           string       `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	Description       string       `boil:"description" json:"description" toml:"description" yaml:"description"`
+	PrivateNote       string       `boil:"private_note" json:"private_note" toml:"private_note" yaml:"private_note"`
+	QuantityUnit      string       `boil:"quantity_unit" json:"quantity_unit" toml:"quantity_unit" yaml:"quantity_unit"`
+	SharingState      SharingState `boil:"sharing_state" json:"sharing_state" toml:"sharing_state" yaml:"sharing_state"`
+	LowStockThreshold null.Int64   `boil:"low_stock_threshold" json:"low_stock_threshold,omitempty" toml:"low_stock_threshold" yaml:"low_stock_threshold,omitempty"`
+	LocationID        null.String  `boil:"location_id" json:"location_id,omitempty" toml:"location_id" yaml:"location_id,omitempty"`
+	UpdatedAt         time.Time    `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *thingR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L thingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ThingColumns = struct {
-	ID           string
-	Name         string
-	CreatedAt    string
-	OwnerID      string
-	Description  string
-	PrivateNote  string
-	QuantityUnit string
-	SharingState string
+	ID                string
+	Name              string
+	CreatedAt         string
+	OwnerID           string
+	Description       string
+	PrivateNote       string
+	QuantityUnit      string
+	SharingState      string
+	LowStockThreshold string
+	LocationID        string
+	UpdatedAt         string
 }{
-	ID:           "id",
-	Name:         "name",
-	CreatedAt:    "created_at",
-	OwnerID:      "owner_id",
-	Description:  "description",
-	PrivateNote:  "private_note",
-	QuantityUnit: "quantity_unit",
-	SharingState: "sharing_state",
+	ID:                "id",
+	Name:              "name",
+	CreatedAt:         "created_at",
+	OwnerID:           "owner_id",
+	Description:       "description",
+	PrivateNote:       "private_note",
+	QuantityUnit:      "quantity_unit",
+	SharingState:      "sharing_state",
+	LowStockThreshold: "low_stock_threshold",
+	LocationID:        "location_id",
+	UpdatedAt:         "updated_at",
 }
 
 var ThingTableColumns = struct {
-	ID           string
-	Name         string
-	CreatedAt    string
-	OwnerID      string
-	Description  string
-	PrivateNote  string
-	QuantityUnit string
-	SharingState string
+	ID                string
+	Name              string
+	CreatedAt         string
+	OwnerID           string
+	Description       string
+	PrivateNote       string
+	QuantityUnit      string
+	SharingState      string
+	LowStockThreshold string
+	LocationID        string
+	UpdatedAt         string
 }{
-	ID:           "things.id",
-	Name:         "things.name",
-	CreatedAt:    "things.created_at",
-	OwnerID:      "things.owner_id",
-	Description:  "things.description",
-	PrivateNote:  "things.private_note",
-	QuantityUnit: "things.quantity_unit",
-	SharingState: "things.sharing_state",
+	ID:                "things.id",
+	Name:              "things.name",
+	CreatedAt:         "things.created_at",
+	OwnerID:           "things.owner_id",
+	Description:       "things.description",
+	PrivateNote:       "things.private_note",
+	QuantityUnit:      "things.quantity_unit",
+	SharingState:      "things.sharing_state",
+	LowStockThreshold: "things.low_stock_threshold",
+	LocationID:        "things.location_id",
+	UpdatedAt:         "things.updated_at",
 }
 
 // Generated where
 
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var ThingWhere = struct {
-	ID           whereHelperstring
-	Name         whereHelperstring
-	CreatedAt    whereHelpertime_Time
-	OwnerID      whereHelperstring
-	Description  whereHelperstring
-	PrivateNote  whereHelperstring
-	QuantityUnit whereHelperstring
-	SharingState whereHelperSharingState
+	ID                whereHelperstring
+	Name              whereHelperstring
+	CreatedAt         whereHelpertime_Time
+	OwnerID           whereHelperstring
+	Description       whereHelperstring
+	PrivateNote       whereHelperstring
+	QuantityUnit      whereHelperstring
+	SharingState      whereHelperSharingState
+	LowStockThreshold whereHelpernull_Int64
+	LocationID        whereHelpernull_String
+	UpdatedAt         whereHelpertime_Time
 }{
-	ID:           whereHelperstring{field: "\"things\".\"id\""},
-	Name:         whereHelperstring{field: "\"things\".\"name\""},
-	CreatedAt:    whereHelpertime_Time{field: "\"things\".\"created_at\""},
-	OwnerID:      whereHelperstring{field: "\"things\".\"owner_id\""},
-	Description:  whereHelperstring{field: "\"things\".\"description\""},
-	PrivateNote:  whereHelperstring{field: "\"things\".\"private_note\""},
-	QuantityUnit: whereHelperstring{field: "\"things\".\"quantity_unit\""},
-	SharingState: whereHelperSharingState{field: "\"things\".\"sharing_state\""},
+	ID:                whereHelperstring{field: "\"things\".\"id\""},
+	Name:              whereHelperstring{field: "\"things\".\"name\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"things\".\"created_at\""},
+	OwnerID:           whereHelperstring{field: "\"things\".\"owner_id\""},
+	Description:       whereHelperstring{field: "\"things\".\"description\""},
+	PrivateNote:       whereHelperstring{field: "\"things\".\"private_note\""},
+	QuantityUnit:      whereHelperstring{field: "\"things\".\"quantity_unit\""},
+	SharingState:      whereHelperSharingState{field: "\"things\".\"sharing_state\""},
+	LowStockThreshold: whereHelpernull_Int64{field: "\"things\".\"low_stock_threshold\""},
+	LocationID:        whereHelpernull_String{field: "\"things\".\"location_id\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"things\".\"updated_at\""},
 }
 
 // ThingRels is where relationship names are stored.
 var ThingRels = struct {
+	Location        string
 	Owner           string
+	BorrowRequests  string
 	CartEntries     string
 	ImagesThings    string
 	Lists           string
+	Loans           string
 	Properties      string
 	QuantityEntries string
 	Shares          string
 }{
+	Location:        "Location",
 	Owner:           "Owner",
+	BorrowRequests:  "BorrowRequests",
 	CartEntries:     "CartEntries",
 	ImagesThings:    "ImagesThings",
 	Lists:           "Lists",
+	Loans:           "Loans",
 	Properties:      "Properties",
 	QuantityEntries: "QuantityEntries",
 	Shares:          "Shares",
@@ -119,10 +185,13 @@ var ThingRels = struct {
 
 // thingR is where relationships are stored.
 type thingR struct {
+	Location        *Location          `boil:"Location" json:"Location" toml:"Location" yaml:"Location"`
 	Owner           *User              `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
+	BorrowRequests  BorrowRequestSlice `boil:"BorrowRequests" json:"BorrowRequests" toml:"BorrowRequests" yaml:"BorrowRequests"`
 	CartEntries     CartEntrySlice     `boil:"CartEntries" json:"CartEntries" toml:"CartEntries" yaml:"CartEntries"`
 	ImagesThings    ImagesThingSlice   `boil:"ImagesThings" json:"ImagesThings" toml:"ImagesThings" yaml:"ImagesThings"`
 	Lists           ListSlice          `boil:"Lists" json:"Lists" toml:"Lists" yaml:"Lists"`
+	Loans           LoanSlice          `boil:"Loans" json:"Loans" toml:"Loans" yaml:"Loans"`
 	Properties      PropertySlice      `boil:"Properties" json:"Properties" toml:"Properties" yaml:"Properties"`
 	QuantityEntries QuantityEntrySlice `boil:"QuantityEntries" json:"QuantityEntries" toml:"QuantityEntries" yaml:"QuantityEntries"`
 	Shares          ShareSlice         `boil:"Shares" json:"Shares" toml:"Shares" yaml:"Shares"`
@@ -133,6 +202,22 @@ func (*thingR) NewStruct() *thingR {
 	return &thingR{}
 }
 
+func (o *Thing) GetLocation() *Location {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLocation()
+}
+
+func (r *thingR) GetLocation() *Location {
+	if r == nil {
+		return nil
+	}
+
+	return r.Location
+}
+
 func (o *Thing) GetOwner() *User {
 	if o == nil {
 		return nil
@@ -149,6 +234,22 @@ func (r *thingR) GetOwner() *User {
 	return r.Owner
 }
 
+func (o *Thing) GetBorrowRequests() BorrowRequestSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBorrowRequests()
+}
+
+func (r *thingR) GetBorrowRequests() BorrowRequestSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.BorrowRequests
+}
+
 func (o *Thing) GetCartEntries() CartEntrySlice {
 	if o == nil {
 		return nil
@@ -197,6 +298,22 @@ func (r *thingR) GetLists() ListSlice {
 	return r.Lists
 }
 
+func (o *Thing) GetLoans() LoanSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLoans()
+}
+
+func (r *thingR) GetLoans() LoanSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Loans
+}
+
 func (o *Thing) GetProperties() PropertySlice {
 	if o == nil {
 		return nil
@@ -249,9 +366,9 @@ func (r *thingR) GetShares() ShareSlice {
 type thingL struct{}
 
 var (
-	thingAllColumns            = []string{"id", "name", "created_at", "owner_id", "description", "private_note", "quantity_unit", "sharing_state"}
+	thingAllColumns            = []string{"id", "name", "created_at", "owner_id", "description", "private_note", "quantity_unit", "sharing_state", "low_stock_threshold", "location_id", "updated_at"}
 	thingColumnsWithoutDefault = []string{"id", "name", "owner_id"}
-	thingColumnsWithDefault    = []string{"created_at", "description", "private_note", "quantity_unit", "sharing_state"}
+	thingColumnsWithDefault    = []string{"created_at", "description", "private_note", "quantity_unit", "sharing_state", "low_stock_threshold", "location_id", "updated_at"}
 	thingPrimaryKeyColumns     = []string{"id"}
 	thingGeneratedColumns      = []string{}
 )
@@ -561,6 +678,17 @@ func (q thingQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool
 	return count > 0, nil
 }
 
+// Location pointed to by the foreign key.
+func (o *Thing) Location(mods ...qm.QueryMod) locationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LocationID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Locations(queryMods...)
+}
+
 // Owner pointed to by the foreign key.
 func (o *Thing) Owner(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -572,6 +700,20 @@ func (o *Thing) Owner(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// BorrowRequests retrieves all the borrow_request's BorrowRequests with an executor.
+func (o *Thing) BorrowRequests(mods ...qm.QueryMod) borrowRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"borrow_requests\".\"thing_id\"=?", o.ID),
+	)
+
+	return BorrowRequests(queryMods...)
+}
+
 // CartEntries retrieves all the cart_entry's CartEntries with an executor.
 func (o *Thing) CartEntries(mods ...qm.QueryMod) cartEntryQuery {
 	var queryMods []qm.QueryMod
@@ -615,6 +757,20 @@ func (o *Thing) Lists(mods ...qm.QueryMod) listQuery {
 	return Lists(queryMods...)
 }
 
+// Loans retrieves all the loan's Loans with an executor.
+func (o *Thing) Loans(mods ...qm.QueryMod) loanQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"loans\".\"thing_id\"=?", o.ID),
+	)
+
+	return Loans(queryMods...)
+}
+
 // Properties retrieves all the property's Properties with an executor.
 func (o *Thing) Properties(mods ...qm.QueryMod) propertyQuery {
 	var queryMods []qm.QueryMod
@@ -658,6 +814,130 @@ func (o *Thing) Shares(mods ...qm.QueryMod) shareQuery {
 	return Shares(queryMods...)
 }
 
+// LoadLocation allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (thingL) LoadLocation(ctx context.Context, e boil.ContextExecutor, singular bool, maybeThing interface{}, mods queries.Applicator) error {
+	var slice []*Thing
+	var object *Thing
+
+	if singular {
+		var ok bool
+		object, ok = maybeThing.(*Thing)
+		if !ok {
+			object = new(Thing)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeThing)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeThing))
+			}
+		}
+	} else {
+		s, ok := maybeThing.(*[]*Thing)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeThing)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeThing))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &thingR{}
+		}
+		if !queries.IsNil(object.LocationID) {
+			args[object.LocationID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &thingR{}
+			}
+
+			if !queries.IsNil(obj.LocationID) {
+				args[obj.LocationID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`locations`),
+		qm.WhereIn(`locations.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Location")
+	}
+
+	var resultSlice []*Location
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Location")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for locations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for locations")
+	}
+
+	if len(locationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Location = foreign
+		if foreign.R == nil {
+			foreign.R = &locationR{}
+		}
+		foreign.R.Things = append(foreign.R.Things, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.LocationID, foreign.ID) {
+				local.R.Location = foreign
+				if foreign.R == nil {
+					foreign.R = &locationR{}
+				}
+				foreign.R.Things = append(foreign.R.Things, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadOwner allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (thingL) LoadOwner(ctx context.Context, e boil.ContextExecutor, singular bool, maybeThing interface{}, mods queries.Applicator) error {
@@ -767,10 +1047,122 @@ func (thingL) LoadOwner(ctx context.Context, e boil.ContextExecutor, singular bo
 			if local.OwnerID == foreign.ID {
 				local.R.Owner = foreign
 				if foreign.R == nil {
-					foreign.R = &userR{}
+					foreign.R = &userR{}
+				}
+				foreign.R.OwnerThings = append(foreign.R.OwnerThings, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBorrowRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (thingL) LoadBorrowRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeThing interface{}, mods queries.Applicator) error {
+	var slice []*Thing
+	var object *Thing
+
+	if singular {
+		var ok bool
+		object, ok = maybeThing.(*Thing)
+		if !ok {
+			object = new(Thing)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeThing)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeThing))
+			}
+		}
+	} else {
+		s, ok := maybeThing.(*[]*Thing)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeThing)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeThing))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &thingR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &thingR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`borrow_requests`),
+		qm.WhereIn(`borrow_requests.thing_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load borrow_requests")
+	}
+
+	var resultSlice []*BorrowRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice borrow_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on borrow_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for borrow_requests")
+	}
+
+	if len(borrowRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BorrowRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &borrowRequestR{}
+			}
+			foreign.R.Thing = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ThingID {
+				local.R.BorrowRequests = append(local.R.BorrowRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &borrowRequestR{}
 				}
-				foreign.R.OwnerThings = append(foreign.R.OwnerThings, local)
-				break
+				foreign.R.Thing = local
 			}
 		}
 	}
@@ -1057,7 +1449,7 @@ func (thingL) LoadLists(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.Select("\"lists\".\"id\", \"lists\".\"name\", \"lists\".\"created_at\", \"lists\".\"owner_id\", \"lists\".\"sharing_state\", \"a\".\"thing_id\""),
+		qm.Select("\"lists\".\"id\", \"lists\".\"name\", \"lists\".\"created_at\", \"lists\".\"owner_id\", \"lists\".\"sharing_state\", \"lists\".\"updated_at\", \"a\".\"thing_id\""),
 		qm.From("\"lists\""),
 		qm.InnerJoin("\"lists_things\" as \"a\" on \"lists\".\"id\" = \"a\".\"list_id\""),
 		qm.WhereIn("\"a\".\"thing_id\" in ?", argsSlice...),
@@ -1078,7 +1470,7 @@ func (thingL) LoadLists(ctx context.Context, e boil.ContextExecutor, singular bo
 		one := new(List)
 		var localJoinCol string
 
-		err = results.Scan(&one.ID, &one.Name, &one.CreatedAt, &one.OwnerID, &one.SharingState, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Name, &one.CreatedAt, &one.OwnerID, &one.SharingState, &one.UpdatedAt, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for lists")
 		}
@@ -1131,6 +1523,118 @@ func (thingL) LoadLists(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
+// LoadLoans allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (thingL) LoadLoans(ctx context.Context, e boil.ContextExecutor, singular bool, maybeThing interface{}, mods queries.Applicator) error {
+	var slice []*Thing
+	var object *Thing
+
+	if singular {
+		var ok bool
+		object, ok = maybeThing.(*Thing)
+		if !ok {
+			object = new(Thing)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeThing)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeThing))
+			}
+		}
+	} else {
+		s, ok := maybeThing.(*[]*Thing)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeThing)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeThing))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &thingR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &thingR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`loans`),
+		qm.WhereIn(`loans.thing_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load loans")
+	}
+
+	var resultSlice []*Loan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice loans")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on loans")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loans")
+	}
+
+	if len(loanAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Loans = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loanR{}
+			}
+			foreign.R.Thing = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ThingID {
+				local.R.Loans = append(local.R.Loans, foreign)
+				if foreign.R == nil {
+					foreign.R = &loanR{}
+				}
+				foreign.R.Thing = local
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadProperties allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (thingL) LoadProperties(ctx context.Context, e boil.ContextExecutor, singular bool, maybeThing interface{}, mods queries.Applicator) error {
@@ -1484,6 +1988,86 @@ func (thingL) LoadShares(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
+// SetLocation of the thing to the related item.
+// Sets o.R.Location to related.
+// Adds o to related.R.Things.
+func (o *Thing) SetLocation(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Location) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"things\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"location_id"}),
+		strmangle.WhereClause("\"", "\"", 2, thingPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LocationID, related.ID)
+	if o.R == nil {
+		o.R = &thingR{
+			Location: related,
+		}
+	} else {
+		o.R.Location = related
+	}
+
+	if related.R == nil {
+		related.R = &locationR{
+			Things: ThingSlice{o},
+		}
+	} else {
+		related.R.Things = append(related.R.Things, o)
+	}
+
+	return nil
+}
+
+// RemoveLocation relationship.
+// Sets o.R.Location to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Thing) RemoveLocation(ctx context.Context, exec boil.ContextExecutor, related *Location) error {
+	var err error
+
+	queries.SetScanner(&o.LocationID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("location_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Location = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Things {
+		if queries.Equal(o.LocationID, ri.LocationID) {
+			continue
+		}
+
+		ln := len(related.R.Things)
+		if ln > 1 && i < ln-1 {
+			related.R.Things[i] = related.R.Things[ln-1]
+		}
+		related.R.Things = related.R.Things[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetOwner of the thing to the related item.
 // Sets o.R.Owner to related.
 // Adds o to related.R.OwnerThings.
@@ -1531,6 +2115,59 @@ func (o *Thing) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert
 	return nil
 }
 
+// AddBorrowRequests adds the given related objects to the existing relationships
+// of the thing, optionally inserting them as new records.
+// Appends related to o.R.BorrowRequests.
+// Sets related.R.Thing appropriately.
+func (o *Thing) AddBorrowRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BorrowRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ThingID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"borrow_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"thing_id"}),
+				strmangle.WhereClause("\"", "\"", 2, borrowRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ThingID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &thingR{
+			BorrowRequests: related,
+		}
+	} else {
+		o.R.BorrowRequests = append(o.R.BorrowRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &borrowRequestR{
+				Thing: o,
+			}
+		} else {
+			rel.R.Thing = o
+		}
+	}
+	return nil
+}
+
 // AddCartEntries adds the given related objects to the existing relationships
 // of the thing, optionally inserting them as new records.
 // Appends related to o.R.CartEntries.
@@ -1782,6 +2419,59 @@ func removeListsFromThingsSlice(o *Thing, related []*List) {
 	}
 }
 
+// AddLoans adds the given related objects to the existing relationships
+// of the thing, optionally inserting them as new records.
+// Appends related to o.R.Loans.
+// Sets related.R.Thing appropriately.
+func (o *Thing) AddLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ThingID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"loans\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"thing_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ThingID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &thingR{
+			Loans: related,
+		}
+	} else {
+		o.R.Loans = append(o.R.Loans, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loanR{
+				Thing: o,
+			}
+		} else {
+			rel.R.Thing = o
+		}
+	}
+	return nil
+}
+
 // AddProperties adds the given related objects to the existing relationships
 // of the thing, optionally inserting them as new records.
 // Appends related to o.R.Properties.
@@ -2088,6 +2778,9 @@ func (o *Thing) Insert(ctx context.Context, exec boil.ContextExecutor, columns b
 		if o.CreatedAt.IsZero() {
 			o.CreatedAt = currTime
 		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
 	}
 
 	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
@@ -2164,6 +2857,12 @@ func (o *Thing) Insert(ctx context.Context, exec boil.ContextExecutor, columns b
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
 func (o *Thing) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
 	var err error
 	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
 		return 0, err
@@ -2300,6 +2999,7 @@ func (o *Thing) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnC
 		if o.CreatedAt.IsZero() {
 			o.CreatedAt = currTime
 		}
+		o.UpdatedAt = currTime
 	}
 
 	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
@@ -24,109 +24,265 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID           string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name         string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	Email        string    `boil:"email" json:"email" toml:"email" yaml:"email"`
-	PasswordHash string    `boil:"password_hash" json:"password_hash" toml:"password_hash" yaml:"password_hash"`
-	PurgeAt      null.Time `boil:"purge_at" json:"purge_at,omitempty" toml:"purge_at" yaml:"purge_at,omitempty"`
+	ID               string          `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name             string          `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Email            string          `boil:"email" json:"email" toml:"email" yaml:"email"`
+	PasswordHash     string          `boil:"password_hash" json:"password_hash" toml:"password_hash" yaml:"password_hash"`
+	PurgeAt          null.Time       `boil:"purge_at" json:"purge_at,omitempty" toml:"purge_at" yaml:"purge_at,omitempty"`
+	DigestFrequency  DigestFrequency `boil:"digest_frequency" json:"digest_frequency" toml:"digest_frequency" yaml:"digest_frequency"`
+	LastDigestAt     null.Time       `boil:"last_digest_at" json:"last_digest_at,omitempty" toml:"last_digest_at" yaml:"last_digest_at,omitempty"`
+	Locale           string          `boil:"locale" json:"locale" toml:"locale" yaml:"locale"`
+	UnsubscribeToken null.String     `boil:"unsubscribe_token" json:"unsubscribe_token,omitempty" toml:"unsubscribe_token" yaml:"unsubscribe_token,omitempty"`
+	TotpSecret       null.String     `boil:"totp_secret" json:"totp_secret,omitempty" toml:"totp_secret" yaml:"totp_secret,omitempty"`
+	TotpEnabledAt    null.Time       `boil:"totp_enabled_at" json:"totp_enabled_at,omitempty" toml:"totp_enabled_at" yaml:"totp_enabled_at,omitempty"`
+	TotpLastStep     int64           `boil:"totp_last_step" json:"totp_last_step" toml:"totp_last_step" yaml:"totp_last_step"`
+	PendingEmail     null.String     `boil:"pending_email" json:"pending_email,omitempty" toml:"pending_email" yaml:"pending_email,omitempty"`
+	OidcSubject      null.String     `boil:"oidc_subject" json:"oidc_subject,omitempty" toml:"oidc_subject" yaml:"oidc_subject,omitempty"`
+	InviteID         null.String     `boil:"invite_id" json:"invite_id,omitempty" toml:"invite_id" yaml:"invite_id,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID           string
-	Name         string
-	Email        string
-	PasswordHash string
-	PurgeAt      string
+	ID               string
+	Name             string
+	Email            string
+	PasswordHash     string
+	PurgeAt          string
+	DigestFrequency  string
+	LastDigestAt     string
+	Locale           string
+	UnsubscribeToken string
+	TotpSecret       string
+	TotpEnabledAt    string
+	TotpLastStep     string
+	PendingEmail     string
+	OidcSubject      string
+	InviteID         string
 }{
-	ID:           "id",
-	Name:         "name",
-	Email:        "email",
-	PasswordHash: "password_hash",
-	PurgeAt:      "purge_at",
+	ID:               "id",
+	Name:             "name",
+	Email:            "email",
+	PasswordHash:     "password_hash",
+	PurgeAt:          "purge_at",
+	DigestFrequency:  "digest_frequency",
+	LastDigestAt:     "last_digest_at",
+	Locale:           "locale",
+	UnsubscribeToken: "unsubscribe_token",
+	TotpSecret:       "totp_secret",
+	TotpEnabledAt:    "totp_enabled_at",
+	TotpLastStep:     "totp_last_step",
+	PendingEmail:     "pending_email",
+	OidcSubject:      "oidc_subject",
+	InviteID:         "invite_id",
 }
 
 var UserTableColumns = struct {
-	ID           string
-	Name         string
-	Email        string
-	PasswordHash string
-	PurgeAt      string
+	ID               string
+	Name             string
+	Email            string
+	PasswordHash     string
+	PurgeAt          string
+	DigestFrequency  string
+	LastDigestAt     string
+	Locale           string
+	UnsubscribeToken string
+	TotpSecret       string
+	TotpEnabledAt    string
+	TotpLastStep     string
+	PendingEmail     string
+	OidcSubject      string
+	InviteID         string
 }{
-	ID:           "users.id",
-	Name:         "users.name",
-	Email:        "users.email",
-	PasswordHash: "users.password_hash",
-	PurgeAt:      "users.purge_at",
+	ID:               "users.id",
+	Name:             "users.name",
+	Email:            "users.email",
+	PasswordHash:     "users.password_hash",
+	PurgeAt:          "users.purge_at",
+	DigestFrequency:  "users.digest_frequency",
+	LastDigestAt:     "users.last_digest_at",
+	Locale:           "users.locale",
+	UnsubscribeToken: "users.unsubscribe_token",
+	TotpSecret:       "users.totp_secret",
+	TotpEnabledAt:    "users.totp_enabled_at",
+	TotpLastStep:     "users.totp_last_step",
+	PendingEmail:     "users.pending_email",
+	OidcSubject:      "users.oidc_subject",
+	InviteID:         "users.invite_id",
 }
 
 // Generated where
 
+type whereHelperDigestFrequency struct{ field string }
+
+func (w whereHelperDigestFrequency) EQ(x DigestFrequency) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperDigestFrequency) NEQ(x DigestFrequency) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperDigestFrequency) LT(x DigestFrequency) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperDigestFrequency) LTE(x DigestFrequency) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperDigestFrequency) GT(x DigestFrequency) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperDigestFrequency) GTE(x DigestFrequency) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperDigestFrequency) IN(slice []DigestFrequency) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperDigestFrequency) NIN(slice []DigestFrequency) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var UserWhere = struct {
-	ID           whereHelperstring
-	Name         whereHelperstring
-	Email        whereHelperstring
-	PasswordHash whereHelperstring
-	PurgeAt      whereHelpernull_Time
+	ID               whereHelperstring
+	Name             whereHelperstring
+	Email            whereHelperstring
+	PasswordHash     whereHelperstring
+	PurgeAt          whereHelpernull_Time
+	DigestFrequency  whereHelperDigestFrequency
+	LastDigestAt     whereHelpernull_Time
+	Locale           whereHelperstring
+	UnsubscribeToken whereHelpernull_String
+	TotpSecret       whereHelpernull_String
+	TotpEnabledAt    whereHelpernull_Time
+	TotpLastStep     whereHelperint64
+	PendingEmail     whereHelpernull_String
+	OidcSubject      whereHelpernull_String
+	InviteID         whereHelpernull_String
 }{
-	ID:           whereHelperstring{field: "\"users\".\"id\""},
-	Name:         whereHelperstring{field: "\"users\".\"name\""},
-	Email:        whereHelperstring{field: "\"users\".\"email\""},
-	PasswordHash: whereHelperstring{field: "\"users\".\"password_hash\""},
-	PurgeAt:      whereHelpernull_Time{field: "\"users\".\"purge_at\""},
+	ID:               whereHelperstring{field: "\"users\".\"id\""},
+	Name:             whereHelperstring{field: "\"users\".\"name\""},
+	Email:            whereHelperstring{field: "\"users\".\"email\""},
+	PasswordHash:     whereHelperstring{field: "\"users\".\"password_hash\""},
+	PurgeAt:          whereHelpernull_Time{field: "\"users\".\"purge_at\""},
+	DigestFrequency:  whereHelperDigestFrequency{field: "\"users\".\"digest_frequency\""},
+	LastDigestAt:     whereHelpernull_Time{field: "\"users\".\"last_digest_at\""},
+	Locale:           whereHelperstring{field: "\"users\".\"locale\""},
+	UnsubscribeToken: whereHelpernull_String{field: "\"users\".\"unsubscribe_token\""},
+	TotpSecret:       whereHelpernull_String{field: "\"users\".\"totp_secret\""},
+	TotpEnabledAt:    whereHelpernull_Time{field: "\"users\".\"totp_enabled_at\""},
+	TotpLastStep:     whereHelperint64{field: "\"users\".\"totp_last_step\""},
+	PendingEmail:     whereHelpernull_String{field: "\"users\".\"pending_email\""},
+	OidcSubject:      whereHelpernull_String{field: "\"users\".\"oidc_subject\""},
+	InviteID:         whereHelpernull_String{field: "\"users\".\"invite_id\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Profile                string
-	CartEntries            string
-	EmailVerificationCodes string
-	EmailVerifications     string
-	ReceiverFriendRequests string
-	SenderFriendRequests   string
-	Friend1Friendships     string
-	Friend2Friendships     string
-	OwnerImages            string
-	OwnerLists             string
-	RecipientNotifications string
-	OwnerShares            string
-	TargetUserShares       string
-	OwnerThings            string
+	Invite                   string
+	Profile                  string
+	OwnerAPITokens           string
+	BorrowerBorrowRequests   string
+	OwnerBorrowRequests      string
+	CartEntries              string
+	EmailVerificationCodes   string
+	EmailVerifications       string
+	ReceiverFriendRequests   string
+	SenderFriendRequests     string
+	Friend1Friendships       string
+	Friend2Friendships       string
+	OwnerImages              string
+	OwnerInvites             string
+	OwnerLists               string
+	BorrowerLoans            string
+	OwnerLoans               string
+	OwnerLocations           string
+	NotificationPreferences  string
+	RecipientNotifications   string
+	LinkUserOidcLogins       string
+	OwnerPasskeys            string
+	PasswordResetTokens      string
+	CreatedByQuantityEntries string
+	OwnerRecoveryCodes       string
+	OwnerSessions            string
+	OwnerShares              string
+	TargetUserShares         string
+	OwnerThings              string
+	WebauthnCeremonies       string
+	OwnerWebhooks            string
 }{
-	Profile:                "Profile",
-	CartEntries:            "CartEntries",
-	EmailVerificationCodes: "EmailVerificationCodes",
-	EmailVerifications:     "EmailVerifications",
-	ReceiverFriendRequests: "ReceiverFriendRequests",
-	SenderFriendRequests:   "SenderFriendRequests",
-	Friend1Friendships:     "Friend1Friendships",
-	Friend2Friendships:     "Friend2Friendships",
-	OwnerImages:            "OwnerImages",
-	OwnerLists:             "OwnerLists",
-	RecipientNotifications: "RecipientNotifications",
-	OwnerShares:            "OwnerShares",
-	TargetUserShares:       "TargetUserShares",
-	OwnerThings:            "OwnerThings",
+	Invite:                   "Invite",
+	Profile:                  "Profile",
+	OwnerAPITokens:           "OwnerAPITokens",
+	BorrowerBorrowRequests:   "BorrowerBorrowRequests",
+	OwnerBorrowRequests:      "OwnerBorrowRequests",
+	CartEntries:              "CartEntries",
+	EmailVerificationCodes:   "EmailVerificationCodes",
+	EmailVerifications:       "EmailVerifications",
+	ReceiverFriendRequests:   "ReceiverFriendRequests",
+	SenderFriendRequests:     "SenderFriendRequests",
+	Friend1Friendships:       "Friend1Friendships",
+	Friend2Friendships:       "Friend2Friendships",
+	OwnerImages:              "OwnerImages",
+	OwnerInvites:             "OwnerInvites",
+	OwnerLists:               "OwnerLists",
+	BorrowerLoans:            "BorrowerLoans",
+	OwnerLoans:               "OwnerLoans",
+	OwnerLocations:           "OwnerLocations",
+	NotificationPreferences:  "NotificationPreferences",
+	RecipientNotifications:   "RecipientNotifications",
+	LinkUserOidcLogins:       "LinkUserOidcLogins",
+	OwnerPasskeys:            "OwnerPasskeys",
+	PasswordResetTokens:      "PasswordResetTokens",
+	CreatedByQuantityEntries: "CreatedByQuantityEntries",
+	OwnerRecoveryCodes:       "OwnerRecoveryCodes",
+	OwnerSessions:            "OwnerSessions",
+	OwnerShares:              "OwnerShares",
+	TargetUserShares:         "TargetUserShares",
+	OwnerThings:              "OwnerThings",
+	WebauthnCeremonies:       "WebauthnCeremonies",
+	OwnerWebhooks:            "OwnerWebhooks",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Profile                *Profile                   `boil:"Profile" json:"Profile" toml:"Profile" yaml:"Profile"`
-	CartEntries            CartEntrySlice             `boil:"CartEntries" json:"CartEntries" toml:"CartEntries" yaml:"CartEntries"`
-	EmailVerificationCodes EmailVerificationCodeSlice `boil:"EmailVerificationCodes" json:"EmailVerificationCodes" toml:"EmailVerificationCodes" yaml:"EmailVerificationCodes"`
-	EmailVerifications     EmailVerificationSlice     `boil:"EmailVerifications" json:"EmailVerifications" toml:"EmailVerifications" yaml:"EmailVerifications"`
-	ReceiverFriendRequests FriendRequestSlice         `boil:"ReceiverFriendRequests" json:"ReceiverFriendRequests" toml:"ReceiverFriendRequests" yaml:"ReceiverFriendRequests"`
-	SenderFriendRequests   FriendRequestSlice         `boil:"SenderFriendRequests" json:"SenderFriendRequests" toml:"SenderFriendRequests" yaml:"SenderFriendRequests"`
-	Friend1Friendships     FriendshipSlice            `boil:"Friend1Friendships" json:"Friend1Friendships" toml:"Friend1Friendships" yaml:"Friend1Friendships"`
-	Friend2Friendships     FriendshipSlice            `boil:"Friend2Friendships" json:"Friend2Friendships" toml:"Friend2Friendships" yaml:"Friend2Friendships"`
-	OwnerImages            ImageSlice                 `boil:"OwnerImages" json:"OwnerImages" toml:"OwnerImages" yaml:"OwnerImages"`
-	OwnerLists             ListSlice                  `boil:"OwnerLists" json:"OwnerLists" toml:"OwnerLists" yaml:"OwnerLists"`
-	RecipientNotifications NotificationSlice          `boil:"RecipientNotifications" json:"RecipientNotifications" toml:"RecipientNotifications" yaml:"RecipientNotifications"`
-	OwnerShares            ShareSlice                 `boil:"OwnerShares" json:"OwnerShares" toml:"OwnerShares" yaml:"OwnerShares"`
-	TargetUserShares       ShareSlice                 `boil:"TargetUserShares" json:"TargetUserShares" toml:"TargetUserShares" yaml:"TargetUserShares"`
-	OwnerThings            ThingSlice                 `boil:"OwnerThings" json:"OwnerThings" toml:"OwnerThings" yaml:"OwnerThings"`
+	Invite                   *Invite                     `boil:"Invite" json:"Invite" toml:"Invite" yaml:"Invite"`
+	Profile                  *Profile                    `boil:"Profile" json:"Profile" toml:"Profile" yaml:"Profile"`
+	OwnerAPITokens           APITokenSlice               `boil:"OwnerAPITokens" json:"OwnerAPITokens" toml:"OwnerAPITokens" yaml:"OwnerAPITokens"`
+	BorrowerBorrowRequests   BorrowRequestSlice          `boil:"BorrowerBorrowRequests" json:"BorrowerBorrowRequests" toml:"BorrowerBorrowRequests" yaml:"BorrowerBorrowRequests"`
+	OwnerBorrowRequests      BorrowRequestSlice          `boil:"OwnerBorrowRequests" json:"OwnerBorrowRequests" toml:"OwnerBorrowRequests" yaml:"OwnerBorrowRequests"`
+	CartEntries              CartEntrySlice              `boil:"CartEntries" json:"CartEntries" toml:"CartEntries" yaml:"CartEntries"`
+	EmailVerificationCodes   EmailVerificationCodeSlice  `boil:"EmailVerificationCodes" json:"EmailVerificationCodes" toml:"EmailVerificationCodes" yaml:"EmailVerificationCodes"`
+	EmailVerifications       EmailVerificationSlice      `boil:"EmailVerifications" json:"EmailVerifications" toml:"EmailVerifications" yaml:"EmailVerifications"`
+	ReceiverFriendRequests   FriendRequestSlice          `boil:"ReceiverFriendRequests" json:"ReceiverFriendRequests" toml:"ReceiverFriendRequests" yaml:"ReceiverFriendRequests"`
+	SenderFriendRequests     FriendRequestSlice          `boil:"SenderFriendRequests" json:"SenderFriendRequests" toml:"SenderFriendRequests" yaml:"SenderFriendRequests"`
+	Friend1Friendships       FriendshipSlice             `boil:"Friend1Friendships" json:"Friend1Friendships" toml:"Friend1Friendships" yaml:"Friend1Friendships"`
+	Friend2Friendships       FriendshipSlice             `boil:"Friend2Friendships" json:"Friend2Friendships" toml:"Friend2Friendships" yaml:"Friend2Friendships"`
+	OwnerImages              ImageSlice                  `boil:"OwnerImages" json:"OwnerImages" toml:"OwnerImages" yaml:"OwnerImages"`
+	OwnerInvites             InviteSlice                 `boil:"OwnerInvites" json:"OwnerInvites" toml:"OwnerInvites" yaml:"OwnerInvites"`
+	OwnerLists               ListSlice                   `boil:"OwnerLists" json:"OwnerLists" toml:"OwnerLists" yaml:"OwnerLists"`
+	BorrowerLoans            LoanSlice                   `boil:"BorrowerLoans" json:"BorrowerLoans" toml:"BorrowerLoans" yaml:"BorrowerLoans"`
+	OwnerLoans               LoanSlice                   `boil:"OwnerLoans" json:"OwnerLoans" toml:"OwnerLoans" yaml:"OwnerLoans"`
+	OwnerLocations           LocationSlice               `boil:"OwnerLocations" json:"OwnerLocations" toml:"OwnerLocations" yaml:"OwnerLocations"`
+	NotificationPreferences  NotificationPreferenceSlice `boil:"NotificationPreferences" json:"NotificationPreferences" toml:"NotificationPreferences" yaml:"NotificationPreferences"`
+	RecipientNotifications   NotificationSlice           `boil:"RecipientNotifications" json:"RecipientNotifications" toml:"RecipientNotifications" yaml:"RecipientNotifications"`
+	LinkUserOidcLogins       OidcLoginSlice              `boil:"LinkUserOidcLogins" json:"LinkUserOidcLogins" toml:"LinkUserOidcLogins" yaml:"LinkUserOidcLogins"`
+	OwnerPasskeys            PasskeySlice                `boil:"OwnerPasskeys" json:"OwnerPasskeys" toml:"OwnerPasskeys" yaml:"OwnerPasskeys"`
+	PasswordResetTokens      PasswordResetTokenSlice     `boil:"PasswordResetTokens" json:"PasswordResetTokens" toml:"PasswordResetTokens" yaml:"PasswordResetTokens"`
+	CreatedByQuantityEntries QuantityEntrySlice          `boil:"CreatedByQuantityEntries" json:"CreatedByQuantityEntries" toml:"CreatedByQuantityEntries" yaml:"CreatedByQuantityEntries"`
+	OwnerRecoveryCodes       RecoveryCodeSlice           `boil:"OwnerRecoveryCodes" json:"OwnerRecoveryCodes" toml:"OwnerRecoveryCodes" yaml:"OwnerRecoveryCodes"`
+	OwnerSessions            SessionSlice                `boil:"OwnerSessions" json:"OwnerSessions" toml:"OwnerSessions" yaml:"OwnerSessions"`
+	OwnerShares              ShareSlice                  `boil:"OwnerShares" json:"OwnerShares" toml:"OwnerShares" yaml:"OwnerShares"`
+	TargetUserShares         ShareSlice                  `boil:"TargetUserShares" json:"TargetUserShares" toml:"TargetUserShares" yaml:"TargetUserShares"`
+	OwnerThings              ThingSlice                  `boil:"OwnerThings" json:"OwnerThings" toml:"OwnerThings" yaml:"OwnerThings"`
+	WebauthnCeremonies       WebauthnCeremonySlice       `boil:"WebauthnCeremonies" json:"WebauthnCeremonies" toml:"WebauthnCeremonies" yaml:"WebauthnCeremonies"`
+	OwnerWebhooks            WebhookSlice                `boil:"OwnerWebhooks" json:"OwnerWebhooks" toml:"OwnerWebhooks" yaml:"OwnerWebhooks"`
 }
 
 // NewStruct creates a new relationship struct
@@ -134,6 +290,22 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (o *User) GetInvite() *Invite {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetInvite()
+}
+
+func (r *userR) GetInvite() *Invite {
+	if r == nil {
+		return nil
+	}
+
+	return r.Invite
+}
+
 func (o *User) GetProfile() *Profile {
 	if o == nil {
 		return nil
@@ -150,6 +322,54 @@ func (r *userR) GetProfile() *Profile {
 	return r.Profile
 }
 
+func (o *User) GetOwnerAPITokens() APITokenSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwnerAPITokens()
+}
+
+func (r *userR) GetOwnerAPITokens() APITokenSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OwnerAPITokens
+}
+
+func (o *User) GetBorrowerBorrowRequests() BorrowRequestSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBorrowerBorrowRequests()
+}
+
+func (r *userR) GetBorrowerBorrowRequests() BorrowRequestSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.BorrowerBorrowRequests
+}
+
+func (o *User) GetOwnerBorrowRequests() BorrowRequestSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwnerBorrowRequests()
+}
+
+func (r *userR) GetOwnerBorrowRequests() BorrowRequestSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OwnerBorrowRequests
+}
+
 func (o *User) GetCartEntries() CartEntrySlice {
 	if o == nil {
 		return nil
@@ -278,6 +498,22 @@ func (r *userR) GetOwnerImages() ImageSlice {
 	return r.OwnerImages
 }
 
+func (o *User) GetOwnerInvites() InviteSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwnerInvites()
+}
+
+func (r *userR) GetOwnerInvites() InviteSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OwnerInvites
+}
+
 func (o *User) GetOwnerLists() ListSlice {
 	if o == nil {
 		return nil
@@ -294,6 +530,70 @@ func (r *userR) GetOwnerLists() ListSlice {
 	return r.OwnerLists
 }
 
+func (o *User) GetBorrowerLoans() LoanSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBorrowerLoans()
+}
+
+func (r *userR) GetBorrowerLoans() LoanSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.BorrowerLoans
+}
+
+func (o *User) GetOwnerLoans() LoanSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwnerLoans()
+}
+
+func (r *userR) GetOwnerLoans() LoanSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OwnerLoans
+}
+
+func (o *User) GetOwnerLocations() LocationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwnerLocations()
+}
+
+func (r *userR) GetOwnerLocations() LocationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OwnerLocations
+}
+
+func (o *User) GetNotificationPreferences() NotificationPreferenceSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetNotificationPreferences()
+}
+
+func (r *userR) GetNotificationPreferences() NotificationPreferenceSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.NotificationPreferences
+}
+
 func (o *User) GetRecipientNotifications() NotificationSlice {
 	if o == nil {
 		return nil
@@ -310,6 +610,102 @@ func (r *userR) GetRecipientNotifications() NotificationSlice {
 	return r.RecipientNotifications
 }
 
+func (o *User) GetLinkUserOidcLogins() OidcLoginSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetLinkUserOidcLogins()
+}
+
+func (r *userR) GetLinkUserOidcLogins() OidcLoginSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.LinkUserOidcLogins
+}
+
+func (o *User) GetOwnerPasskeys() PasskeySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwnerPasskeys()
+}
+
+func (r *userR) GetOwnerPasskeys() PasskeySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OwnerPasskeys
+}
+
+func (o *User) GetPasswordResetTokens() PasswordResetTokenSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPasswordResetTokens()
+}
+
+func (r *userR) GetPasswordResetTokens() PasswordResetTokenSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PasswordResetTokens
+}
+
+func (o *User) GetCreatedByQuantityEntries() QuantityEntrySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedByQuantityEntries()
+}
+
+func (r *userR) GetCreatedByQuantityEntries() QuantityEntrySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedByQuantityEntries
+}
+
+func (o *User) GetOwnerRecoveryCodes() RecoveryCodeSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwnerRecoveryCodes()
+}
+
+func (r *userR) GetOwnerRecoveryCodes() RecoveryCodeSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OwnerRecoveryCodes
+}
+
+func (o *User) GetOwnerSessions() SessionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwnerSessions()
+}
+
+func (r *userR) GetOwnerSessions() SessionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OwnerSessions
+}
+
 func (o *User) GetOwnerShares() ShareSlice {
 	if o == nil {
 		return nil
@@ -358,13 +754,45 @@ func (r *userR) GetOwnerThings() ThingSlice {
 	return r.OwnerThings
 }
 
+func (o *User) GetWebauthnCeremonies() WebauthnCeremonySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebauthnCeremonies()
+}
+
+func (r *userR) GetWebauthnCeremonies() WebauthnCeremonySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebauthnCeremonies
+}
+
+func (o *User) GetOwnerWebhooks() WebhookSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetOwnerWebhooks()
+}
+
+func (r *userR) GetOwnerWebhooks() WebhookSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.OwnerWebhooks
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "name", "email", "password_hash", "purge_at"}
+	userAllColumns            = []string{"id", "name", "email", "password_hash", "purge_at", "digest_frequency", "last_digest_at", "locale", "unsubscribe_token", "totp_secret", "totp_enabled_at", "totp_last_step", "pending_email", "oidc_subject", "invite_id"}
 	userColumnsWithoutDefault = []string{"id", "name", "email", "password_hash"}
-	userColumnsWithDefault    = []string{"purge_at"}
+	userColumnsWithDefault    = []string{"purge_at", "digest_frequency", "last_digest_at", "locale", "unsubscribe_token", "totp_secret", "totp_enabled_at", "totp_last_step", "pending_email", "oidc_subject", "invite_id"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -674,6 +1102,17 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// Invite pointed to by the foreign key.
+func (o *User) Invite(mods ...qm.QueryMod) inviteQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.InviteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Invites(queryMods...)
+}
+
 // Profile pointed to by the foreign key.
 func (o *User) Profile(mods ...qm.QueryMod) profileQuery {
 	queryMods := []qm.QueryMod{
@@ -685,6 +1124,48 @@ func (o *User) Profile(mods ...qm.QueryMod) profileQuery {
 	return Profiles(queryMods...)
 }
 
+// OwnerAPITokens retrieves all the api_token's APITokens with an executor via owner_id column.
+func (o *User) OwnerAPITokens(mods ...qm.QueryMod) apiTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"api_tokens\".\"owner_id\"=?", o.ID),
+	)
+
+	return APITokens(queryMods...)
+}
+
+// BorrowerBorrowRequests retrieves all the borrow_request's BorrowRequests with an executor via borrower_id column.
+func (o *User) BorrowerBorrowRequests(mods ...qm.QueryMod) borrowRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"borrow_requests\".\"borrower_id\"=?", o.ID),
+	)
+
+	return BorrowRequests(queryMods...)
+}
+
+// OwnerBorrowRequests retrieves all the borrow_request's BorrowRequests with an executor via owner_id column.
+func (o *User) OwnerBorrowRequests(mods ...qm.QueryMod) borrowRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"borrow_requests\".\"owner_id\"=?", o.ID),
+	)
+
+	return BorrowRequests(queryMods...)
+}
+
 // CartEntries retrieves all the cart_entry's CartEntries with an executor.
 func (o *User) CartEntries(mods ...qm.QueryMod) cartEntryQuery {
 	var queryMods []qm.QueryMod
@@ -797,20 +1278,90 @@ func (o *User) OwnerImages(mods ...qm.QueryMod) imageQuery {
 	return Images(queryMods...)
 }
 
-// OwnerLists retrieves all the list's Lists with an executor via owner_id column.
-func (o *User) OwnerLists(mods ...qm.QueryMod) listQuery {
+// OwnerInvites retrieves all the invite's Invites with an executor via owner_id column.
+func (o *User) OwnerInvites(mods ...qm.QueryMod) inviteQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"lists\".\"owner_id\"=?", o.ID),
+		qm.Where("\"invites\".\"owner_id\"=?", o.ID),
+	)
+
+	return Invites(queryMods...)
+}
+
+// OwnerLists retrieves all the list's Lists with an executor via owner_id column.
+func (o *User) OwnerLists(mods ...qm.QueryMod) listQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"lists\".\"owner_id\"=?", o.ID),
 	)
 
 	return Lists(queryMods...)
 }
 
+// BorrowerLoans retrieves all the loan's Loans with an executor via borrower_id column.
+func (o *User) BorrowerLoans(mods ...qm.QueryMod) loanQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"loans\".\"borrower_id\"=?", o.ID),
+	)
+
+	return Loans(queryMods...)
+}
+
+// OwnerLoans retrieves all the loan's Loans with an executor via owner_id column.
+func (o *User) OwnerLoans(mods ...qm.QueryMod) loanQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"loans\".\"owner_id\"=?", o.ID),
+	)
+
+	return Loans(queryMods...)
+}
+
+// OwnerLocations retrieves all the location's Locations with an executor via owner_id column.
+func (o *User) OwnerLocations(mods ...qm.QueryMod) locationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"locations\".\"owner_id\"=?", o.ID),
+	)
+
+	return Locations(queryMods...)
+}
+
+// NotificationPreferences retrieves all the notification_preference's NotificationPreferences with an executor.
+func (o *User) NotificationPreferences(mods ...qm.QueryMod) notificationPreferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"notification_preferences\".\"user_id\"=?", o.ID),
+	)
+
+	return NotificationPreferences(queryMods...)
+}
+
 // RecipientNotifications retrieves all the notification's Notifications with an executor via recipient_id column.
 func (o *User) RecipientNotifications(mods ...qm.QueryMod) notificationQuery {
 	var queryMods []qm.QueryMod
@@ -825,6 +1376,90 @@ func (o *User) RecipientNotifications(mods ...qm.QueryMod) notificationQuery {
 	return Notifications(queryMods...)
 }
 
+// LinkUserOidcLogins retrieves all the oidc_login's OidcLogins with an executor via link_user_id column.
+func (o *User) LinkUserOidcLogins(mods ...qm.QueryMod) oidcLoginQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oidc_logins\".\"link_user_id\"=?", o.ID),
+	)
+
+	return OidcLogins(queryMods...)
+}
+
+// OwnerPasskeys retrieves all the passkey's Passkeys with an executor via owner_id column.
+func (o *User) OwnerPasskeys(mods ...qm.QueryMod) passkeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"passkeys\".\"owner_id\"=?", o.ID),
+	)
+
+	return Passkeys(queryMods...)
+}
+
+// PasswordResetTokens retrieves all the password_reset_token's PasswordResetTokens with an executor.
+func (o *User) PasswordResetTokens(mods ...qm.QueryMod) passwordResetTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"password_reset_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return PasswordResetTokens(queryMods...)
+}
+
+// CreatedByQuantityEntries retrieves all the quantity_entry's QuantityEntries with an executor via created_by_id column.
+func (o *User) CreatedByQuantityEntries(mods ...qm.QueryMod) quantityEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"quantity_entries\".\"created_by_id\"=?", o.ID),
+	)
+
+	return QuantityEntries(queryMods...)
+}
+
+// OwnerRecoveryCodes retrieves all the recovery_code's RecoveryCodes with an executor via owner_id column.
+func (o *User) OwnerRecoveryCodes(mods ...qm.QueryMod) recoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"recovery_codes\".\"owner_id\"=?", o.ID),
+	)
+
+	return RecoveryCodes(queryMods...)
+}
+
+// OwnerSessions retrieves all the session's Sessions with an executor via owner_id column.
+func (o *User) OwnerSessions(mods ...qm.QueryMod) sessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"sessions\".\"owner_id\"=?", o.ID),
+	)
+
+	return Sessions(queryMods...)
+}
+
 // OwnerShares retrieves all the share's Shares with an executor via owner_id column.
 func (o *User) OwnerShares(mods ...qm.QueryMod) shareQuery {
 	var queryMods []qm.QueryMod
@@ -867,9 +1502,37 @@ func (o *User) OwnerThings(mods ...qm.QueryMod) thingQuery {
 	return Things(queryMods...)
 }
 
-// LoadProfile allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-1 relationship.
-func (userL) LoadProfile(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// WebauthnCeremonies retrieves all the webauthn_ceremony's WebauthnCeremonies with an executor.
+func (o *User) WebauthnCeremonies(mods ...qm.QueryMod) webauthnCeremonyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webauthn_ceremonies\".\"user_id\"=?", o.ID),
+	)
+
+	return WebauthnCeremonies(queryMods...)
+}
+
+// OwnerWebhooks retrieves all the webhook's Webhooks with an executor via owner_id column.
+func (o *User) OwnerWebhooks(mods ...qm.QueryMod) webhookQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhooks\".\"owner_id\"=?", o.ID),
+	)
+
+	return Webhooks(queryMods...)
+}
+
+// LoadInvite allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userL) LoadInvite(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -900,14 +1563,20 @@ func (userL) LoadProfile(ctx context.Context, e boil.ContextExecutor, singular b
 		if object.R == nil {
 			object.R = &userR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.InviteID) {
+			args[object.InviteID] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &userR{}
 			}
 
-			args[obj.ID] = struct{}{}
+			if !queries.IsNil(obj.InviteID) {
+				args[obj.InviteID] = struct{}{}
+			}
+
 		}
 	}
 
@@ -923,8 +1592,8 @@ func (userL) LoadProfile(ctx context.Context, e boil.ContextExecutor, singular b
 	}
 
 	query := NewQuery(
-		qm.From(`profiles`),
-		qm.WhereIn(`profiles.user_id in ?`, argsSlice...),
+		qm.From(`invites`),
+		qm.WhereIn(`invites.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -932,22 +1601,22 @@ func (userL) LoadProfile(ctx context.Context, e boil.ContextExecutor, singular b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load Profile")
+		return errors.Wrap(err, "failed to eager load Invite")
 	}
 
-	var resultSlice []*Profile
+	var resultSlice []*Invite
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Profile")
+		return errors.Wrap(err, "failed to bind eager loaded slice Invite")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for profiles")
+		return errors.Wrap(err, "failed to close results of eager load for invites")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for profiles")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for invites")
 	}
 
-	if len(profileAfterSelectHooks) != 0 {
+	if len(inviteAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -961,21 +1630,22 @@ func (userL) LoadProfile(ctx context.Context, e boil.ContextExecutor, singular b
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.Profile = foreign
+		object.R.Invite = foreign
 		if foreign.R == nil {
-			foreign.R = &profileR{}
+			foreign.R = &inviteR{}
 		}
-		foreign.R.User = object
+		foreign.R.Users = append(foreign.R.Users, object)
+		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if queries.Equal(local.ID, foreign.UserID) {
-				local.R.Profile = foreign
+			if queries.Equal(local.InviteID, foreign.ID) {
+				local.R.Invite = foreign
 				if foreign.R == nil {
-					foreign.R = &profileR{}
+					foreign.R = &inviteR{}
 				}
-				foreign.R.User = local
+				foreign.R.Users = append(foreign.R.Users, local)
 				break
 			}
 		}
@@ -984,9 +1654,9 @@ func (userL) LoadProfile(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
-// LoadCartEntries allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCartEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// LoadProfile allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadProfile(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1023,6 +1693,7 @@ func (userL) LoadCartEntries(ctx context.Context, e boil.ContextExecutor, singul
 			if obj.R == nil {
 				obj.R = &userR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -1039,8 +1710,8 @@ func (userL) LoadCartEntries(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.From(`cart_entries`),
-		qm.WhereIn(`cart_entries.user_id in ?`, argsSlice...),
+		qm.From(`profiles`),
+		qm.WhereIn(`profiles.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1048,47 +1719,51 @@ func (userL) LoadCartEntries(ctx context.Context, e boil.ContextExecutor, singul
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load cart_entries")
+		return errors.Wrap(err, "failed to eager load Profile")
 	}
 
-	var resultSlice []*CartEntry
+	var resultSlice []*Profile
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice cart_entries")
+		return errors.Wrap(err, "failed to bind eager loaded slice Profile")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on cart_entries")
+		return errors.Wrap(err, "failed to close results of eager load for profiles")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for cart_entries")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for profiles")
 	}
 
-	if len(cartEntryAfterSelectHooks) != 0 {
+	if len(profileAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.CartEntries = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &cartEntryR{}
-			}
-			foreign.R.User = object
+		foreign := resultSlice[0]
+		object.R.Profile = foreign
+		if foreign.R == nil {
+			foreign.R = &profileR{}
 		}
-		return nil
+		foreign.R.User = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.CartEntries = append(local.R.CartEntries, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.Profile = foreign
 				if foreign.R == nil {
-					foreign.R = &cartEntryR{}
+					foreign.R = &profileR{}
 				}
 				foreign.R.User = local
+				break
 			}
 		}
 	}
@@ -1096,9 +1771,9 @@ func (userL) LoadCartEntries(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// LoadEmailVerificationCodes allows an eager lookup of values, cached into the
+// LoadOwnerAPITokens allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadEmailVerificationCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadOwnerAPITokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1151,8 +1826,8 @@ func (userL) LoadEmailVerificationCodes(ctx context.Context, e boil.ContextExecu
 	}
 
 	query := NewQuery(
-		qm.From(`email_verification_codes`),
-		qm.WhereIn(`email_verification_codes.user_id in ?`, argsSlice...),
+		qm.From(`api_tokens`),
+		qm.WhereIn(`api_tokens.owner_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1160,22 +1835,22 @@ func (userL) LoadEmailVerificationCodes(ctx context.Context, e boil.ContextExecu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load email_verification_codes")
+		return errors.Wrap(err, "failed to eager load api_tokens")
 	}
 
-	var resultSlice []*EmailVerificationCode
+	var resultSlice []*APIToken
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice email_verification_codes")
+		return errors.Wrap(err, "failed to bind eager loaded slice api_tokens")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on email_verification_codes")
+		return errors.Wrap(err, "failed to close results in eager load on api_tokens")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for email_verification_codes")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for api_tokens")
 	}
 
-	if len(emailVerificationCodeAfterSelectHooks) != 0 {
+	if len(apiTokenAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1183,24 +1858,24 @@ func (userL) LoadEmailVerificationCodes(ctx context.Context, e boil.ContextExecu
 		}
 	}
 	if singular {
-		object.R.EmailVerificationCodes = resultSlice
+		object.R.OwnerAPITokens = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &emailVerificationCodeR{}
+				foreign.R = &apiTokenR{}
 			}
-			foreign.R.User = object
+			foreign.R.Owner = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.EmailVerificationCodes = append(local.R.EmailVerificationCodes, foreign)
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerAPITokens = append(local.R.OwnerAPITokens, foreign)
 				if foreign.R == nil {
-					foreign.R = &emailVerificationCodeR{}
+					foreign.R = &apiTokenR{}
 				}
-				foreign.R.User = local
+				foreign.R.Owner = local
 			}
 		}
 	}
@@ -1208,9 +1883,9 @@ func (userL) LoadEmailVerificationCodes(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadEmailVerifications allows an eager lookup of values, cached into the
+// LoadBorrowerBorrowRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadEmailVerifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadBorrowerBorrowRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1263,8 +1938,8 @@ func (userL) LoadEmailVerifications(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`email_verifications`),
-		qm.WhereIn(`email_verifications.user_id in ?`, argsSlice...),
+		qm.From(`borrow_requests`),
+		qm.WhereIn(`borrow_requests.borrower_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1272,22 +1947,22 @@ func (userL) LoadEmailVerifications(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load email_verifications")
+		return errors.Wrap(err, "failed to eager load borrow_requests")
 	}
 
-	var resultSlice []*EmailVerification
+	var resultSlice []*BorrowRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice email_verifications")
+		return errors.Wrap(err, "failed to bind eager loaded slice borrow_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on email_verifications")
+		return errors.Wrap(err, "failed to close results in eager load on borrow_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for email_verifications")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for borrow_requests")
 	}
 
-	if len(emailVerificationAfterSelectHooks) != 0 {
+	if len(borrowRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1295,24 +1970,24 @@ func (userL) LoadEmailVerifications(ctx context.Context, e boil.ContextExecutor,
 		}
 	}
 	if singular {
-		object.R.EmailVerifications = resultSlice
+		object.R.BorrowerBorrowRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &emailVerificationR{}
+				foreign.R = &borrowRequestR{}
 			}
-			foreign.R.User = object
+			foreign.R.Borrower = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.EmailVerifications = append(local.R.EmailVerifications, foreign)
+			if local.ID == foreign.BorrowerID {
+				local.R.BorrowerBorrowRequests = append(local.R.BorrowerBorrowRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &emailVerificationR{}
+					foreign.R = &borrowRequestR{}
 				}
-				foreign.R.User = local
+				foreign.R.Borrower = local
 			}
 		}
 	}
@@ -1320,9 +1995,9 @@ func (userL) LoadEmailVerifications(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadReceiverFriendRequests allows an eager lookup of values, cached into the
+// LoadOwnerBorrowRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadReceiverFriendRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadOwnerBorrowRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1375,8 +2050,8 @@ func (userL) LoadReceiverFriendRequests(ctx context.Context, e boil.ContextExecu
 	}
 
 	query := NewQuery(
-		qm.From(`friend_requests`),
-		qm.WhereIn(`friend_requests.receiver_id in ?`, argsSlice...),
+		qm.From(`borrow_requests`),
+		qm.WhereIn(`borrow_requests.owner_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1384,22 +2059,22 @@ func (userL) LoadReceiverFriendRequests(ctx context.Context, e boil.ContextExecu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load friend_requests")
+		return errors.Wrap(err, "failed to eager load borrow_requests")
 	}
 
-	var resultSlice []*FriendRequest
+	var resultSlice []*BorrowRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice friend_requests")
+		return errors.Wrap(err, "failed to bind eager loaded slice borrow_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on friend_requests")
+		return errors.Wrap(err, "failed to close results in eager load on borrow_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friend_requests")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for borrow_requests")
 	}
 
-	if len(friendRequestAfterSelectHooks) != 0 {
+	if len(borrowRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1407,24 +2082,24 @@ func (userL) LoadReceiverFriendRequests(ctx context.Context, e boil.ContextExecu
 		}
 	}
 	if singular {
-		object.R.ReceiverFriendRequests = resultSlice
+		object.R.OwnerBorrowRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &friendRequestR{}
+				foreign.R = &borrowRequestR{}
 			}
-			foreign.R.Receiver = object
+			foreign.R.Owner = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.ReceiverID {
-				local.R.ReceiverFriendRequests = append(local.R.ReceiverFriendRequests, foreign)
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerBorrowRequests = append(local.R.OwnerBorrowRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &friendRequestR{}
+					foreign.R = &borrowRequestR{}
 				}
-				foreign.R.Receiver = local
+				foreign.R.Owner = local
 			}
 		}
 	}
@@ -1432,9 +2107,9 @@ func (userL) LoadReceiverFriendRequests(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadSenderFriendRequests allows an eager lookup of values, cached into the
+// LoadCartEntries allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadSenderFriendRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadCartEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1487,8 +2162,8 @@ func (userL) LoadSenderFriendRequests(ctx context.Context, e boil.ContextExecuto
 	}
 
 	query := NewQuery(
-		qm.From(`friend_requests`),
-		qm.WhereIn(`friend_requests.sender_id in ?`, argsSlice...),
+		qm.From(`cart_entries`),
+		qm.WhereIn(`cart_entries.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1496,22 +2171,22 @@ func (userL) LoadSenderFriendRequests(ctx context.Context, e boil.ContextExecuto
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load friend_requests")
+		return errors.Wrap(err, "failed to eager load cart_entries")
 	}
 
-	var resultSlice []*FriendRequest
+	var resultSlice []*CartEntry
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice friend_requests")
+		return errors.Wrap(err, "failed to bind eager loaded slice cart_entries")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on friend_requests")
+		return errors.Wrap(err, "failed to close results in eager load on cart_entries")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friend_requests")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for cart_entries")
 	}
 
-	if len(friendRequestAfterSelectHooks) != 0 {
+	if len(cartEntryAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1519,24 +2194,24 @@ func (userL) LoadSenderFriendRequests(ctx context.Context, e boil.ContextExecuto
 		}
 	}
 	if singular {
-		object.R.SenderFriendRequests = resultSlice
+		object.R.CartEntries = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &friendRequestR{}
+				foreign.R = &cartEntryR{}
 			}
-			foreign.R.Sender = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.SenderID {
-				local.R.SenderFriendRequests = append(local.R.SenderFriendRequests, foreign)
+			if local.ID == foreign.UserID {
+				local.R.CartEntries = append(local.R.CartEntries, foreign)
 				if foreign.R == nil {
-					foreign.R = &friendRequestR{}
+					foreign.R = &cartEntryR{}
 				}
-				foreign.R.Sender = local
+				foreign.R.User = local
 			}
 		}
 	}
@@ -1544,9 +2219,9 @@ func (userL) LoadSenderFriendRequests(ctx context.Context, e boil.ContextExecuto
 	return nil
 }
 
-// LoadFriend1Friendships allows an eager lookup of values, cached into the
+// LoadEmailVerificationCodes allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadFriend1Friendships(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadEmailVerificationCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1599,8 +2274,8 @@ func (userL) LoadFriend1Friendships(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`friendships`),
-		qm.WhereIn(`friendships.friend1_id in ?`, argsSlice...),
+		qm.From(`email_verification_codes`),
+		qm.WhereIn(`email_verification_codes.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1608,22 +2283,22 @@ func (userL) LoadFriend1Friendships(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load friendships")
+		return errors.Wrap(err, "failed to eager load email_verification_codes")
 	}
 
-	var resultSlice []*Friendship
+	var resultSlice []*EmailVerificationCode
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice friendships")
+		return errors.Wrap(err, "failed to bind eager loaded slice email_verification_codes")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on friendships")
+		return errors.Wrap(err, "failed to close results in eager load on email_verification_codes")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friendships")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for email_verification_codes")
 	}
 
-	if len(friendshipAfterSelectHooks) != 0 {
+	if len(emailVerificationCodeAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1631,24 +2306,24 @@ func (userL) LoadFriend1Friendships(ctx context.Context, e boil.ContextExecutor,
 		}
 	}
 	if singular {
-		object.R.Friend1Friendships = resultSlice
+		object.R.EmailVerificationCodes = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &friendshipR{}
+				foreign.R = &emailVerificationCodeR{}
 			}
-			foreign.R.Friend1 = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.Friend1ID {
-				local.R.Friend1Friendships = append(local.R.Friend1Friendships, foreign)
+			if local.ID == foreign.UserID {
+				local.R.EmailVerificationCodes = append(local.R.EmailVerificationCodes, foreign)
 				if foreign.R == nil {
-					foreign.R = &friendshipR{}
+					foreign.R = &emailVerificationCodeR{}
 				}
-				foreign.R.Friend1 = local
+				foreign.R.User = local
 			}
 		}
 	}
@@ -1656,9 +2331,9 @@ func (userL) LoadFriend1Friendships(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadFriend2Friendships allows an eager lookup of values, cached into the
+// LoadEmailVerifications allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadFriend2Friendships(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadEmailVerifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1711,8 +2386,8 @@ func (userL) LoadFriend2Friendships(ctx context.Context, e boil.ContextExecutor,
 	}
 
 	query := NewQuery(
-		qm.From(`friendships`),
-		qm.WhereIn(`friendships.friend2_id in ?`, argsSlice...),
+		qm.From(`email_verifications`),
+		qm.WhereIn(`email_verifications.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1720,22 +2395,22 @@ func (userL) LoadFriend2Friendships(ctx context.Context, e boil.ContextExecutor,
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load friendships")
+		return errors.Wrap(err, "failed to eager load email_verifications")
 	}
 
-	var resultSlice []*Friendship
+	var resultSlice []*EmailVerification
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice friendships")
+		return errors.Wrap(err, "failed to bind eager loaded slice email_verifications")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on friendships")
+		return errors.Wrap(err, "failed to close results in eager load on email_verifications")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friendships")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for email_verifications")
 	}
 
-	if len(friendshipAfterSelectHooks) != 0 {
+	if len(emailVerificationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1743,24 +2418,24 @@ func (userL) LoadFriend2Friendships(ctx context.Context, e boil.ContextExecutor,
 		}
 	}
 	if singular {
-		object.R.Friend2Friendships = resultSlice
+		object.R.EmailVerifications = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &friendshipR{}
+				foreign.R = &emailVerificationR{}
 			}
-			foreign.R.Friend2 = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.Friend2ID {
-				local.R.Friend2Friendships = append(local.R.Friend2Friendships, foreign)
+			if local.ID == foreign.UserID {
+				local.R.EmailVerifications = append(local.R.EmailVerifications, foreign)
 				if foreign.R == nil {
-					foreign.R = &friendshipR{}
+					foreign.R = &emailVerificationR{}
 				}
-				foreign.R.Friend2 = local
+				foreign.R.User = local
 			}
 		}
 	}
@@ -1768,9 +2443,9 @@ func (userL) LoadFriend2Friendships(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
-// LoadOwnerImages allows an eager lookup of values, cached into the
+// LoadReceiverFriendRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerImages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadReceiverFriendRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1823,8 +2498,8 @@ func (userL) LoadOwnerImages(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.From(`images`),
-		qm.WhereIn(`images.owner_id in ?`, argsSlice...),
+		qm.From(`friend_requests`),
+		qm.WhereIn(`friend_requests.receiver_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1832,22 +2507,22 @@ func (userL) LoadOwnerImages(ctx context.Context, e boil.ContextExecutor, singul
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load images")
+		return errors.Wrap(err, "failed to eager load friend_requests")
 	}
 
-	var resultSlice []*Image
+	var resultSlice []*FriendRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice images")
+		return errors.Wrap(err, "failed to bind eager loaded slice friend_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on images")
+		return errors.Wrap(err, "failed to close results in eager load on friend_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for images")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friend_requests")
 	}
 
-	if len(imageAfterSelectHooks) != 0 {
+	if len(friendRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1855,24 +2530,24 @@ func (userL) LoadOwnerImages(ctx context.Context, e boil.ContextExecutor, singul
 		}
 	}
 	if singular {
-		object.R.OwnerImages = resultSlice
+		object.R.ReceiverFriendRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &imageR{}
+				foreign.R = &friendRequestR{}
 			}
-			foreign.R.Owner = object
+			foreign.R.Receiver = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.OwnerID {
-				local.R.OwnerImages = append(local.R.OwnerImages, foreign)
+			if local.ID == foreign.ReceiverID {
+				local.R.ReceiverFriendRequests = append(local.R.ReceiverFriendRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &imageR{}
+					foreign.R = &friendRequestR{}
 				}
-				foreign.R.Owner = local
+				foreign.R.Receiver = local
 			}
 		}
 	}
@@ -1880,9 +2555,9 @@ func (userL) LoadOwnerImages(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// LoadOwnerLists allows an eager lookup of values, cached into the
+// LoadSenderFriendRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerLists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadSenderFriendRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1935,8 +2610,8 @@ func (userL) LoadOwnerLists(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`lists`),
-		qm.WhereIn(`lists.owner_id in ?`, argsSlice...),
+		qm.From(`friend_requests`),
+		qm.WhereIn(`friend_requests.sender_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1944,22 +2619,22 @@ func (userL) LoadOwnerLists(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load lists")
+		return errors.Wrap(err, "failed to eager load friend_requests")
 	}
 
-	var resultSlice []*List
+	var resultSlice []*FriendRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice lists")
+		return errors.Wrap(err, "failed to bind eager loaded slice friend_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on lists")
+		return errors.Wrap(err, "failed to close results in eager load on friend_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friend_requests")
 	}
 
-	if len(listAfterSelectHooks) != 0 {
+	if len(friendRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1967,24 +2642,24 @@ func (userL) LoadOwnerLists(ctx context.Context, e boil.ContextExecutor, singula
 		}
 	}
 	if singular {
-		object.R.OwnerLists = resultSlice
+		object.R.SenderFriendRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &listR{}
+				foreign.R = &friendRequestR{}
 			}
-			foreign.R.Owner = object
+			foreign.R.Sender = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.OwnerID {
-				local.R.OwnerLists = append(local.R.OwnerLists, foreign)
+			if local.ID == foreign.SenderID {
+				local.R.SenderFriendRequests = append(local.R.SenderFriendRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &listR{}
+					foreign.R = &friendRequestR{}
 				}
-				foreign.R.Owner = local
+				foreign.R.Sender = local
 			}
 		}
 	}
@@ -1992,9 +2667,9 @@ func (userL) LoadOwnerLists(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// LoadRecipientNotifications allows an eager lookup of values, cached into the
+// LoadFriend1Friendships allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadRecipientNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadFriend1Friendships(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2047,8 +2722,8 @@ func (userL) LoadRecipientNotifications(ctx context.Context, e boil.ContextExecu
 	}
 
 	query := NewQuery(
-		qm.From(`notifications`),
-		qm.WhereIn(`notifications.recipient_id in ?`, argsSlice...),
+		qm.From(`friendships`),
+		qm.WhereIn(`friendships.friend1_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2056,22 +2731,22 @@ func (userL) LoadRecipientNotifications(ctx context.Context, e boil.ContextExecu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load notifications")
+		return errors.Wrap(err, "failed to eager load friendships")
 	}
 
-	var resultSlice []*Notification
+	var resultSlice []*Friendship
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
+		return errors.Wrap(err, "failed to bind eager loaded slice friendships")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on notifications")
+		return errors.Wrap(err, "failed to close results in eager load on friendships")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friendships")
 	}
 
-	if len(notificationAfterSelectHooks) != 0 {
+	if len(friendshipAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -2079,24 +2754,24 @@ func (userL) LoadRecipientNotifications(ctx context.Context, e boil.ContextExecu
 		}
 	}
 	if singular {
-		object.R.RecipientNotifications = resultSlice
+		object.R.Friend1Friendships = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &notificationR{}
+				foreign.R = &friendshipR{}
 			}
-			foreign.R.Recipient = object
+			foreign.R.Friend1 = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.RecipientID {
-				local.R.RecipientNotifications = append(local.R.RecipientNotifications, foreign)
+			if local.ID == foreign.Friend1ID {
+				local.R.Friend1Friendships = append(local.R.Friend1Friendships, foreign)
 				if foreign.R == nil {
-					foreign.R = &notificationR{}
+					foreign.R = &friendshipR{}
 				}
-				foreign.R.Recipient = local
+				foreign.R.Friend1 = local
 			}
 		}
 	}
@@ -2104,9 +2779,9 @@ func (userL) LoadRecipientNotifications(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadOwnerShares allows an eager lookup of values, cached into the
+// LoadFriend2Friendships allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerShares(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadFriend2Friendships(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2159,8 +2834,8 @@ func (userL) LoadOwnerShares(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.From(`shares`),
-		qm.WhereIn(`shares.owner_id in ?`, argsSlice...),
+		qm.From(`friendships`),
+		qm.WhereIn(`friendships.friend2_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2168,22 +2843,22 @@ func (userL) LoadOwnerShares(ctx context.Context, e boil.ContextExecutor, singul
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load shares")
+		return errors.Wrap(err, "failed to eager load friendships")
 	}
 
-	var resultSlice []*Share
+	var resultSlice []*Friendship
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice shares")
+		return errors.Wrap(err, "failed to bind eager loaded slice friendships")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on shares")
+		return errors.Wrap(err, "failed to close results in eager load on friendships")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for shares")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for friendships")
 	}
 
-	if len(shareAfterSelectHooks) != 0 {
+	if len(friendshipAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -2191,24 +2866,24 @@ func (userL) LoadOwnerShares(ctx context.Context, e boil.ContextExecutor, singul
 		}
 	}
 	if singular {
-		object.R.OwnerShares = resultSlice
+		object.R.Friend2Friendships = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &shareR{}
+				foreign.R = &friendshipR{}
 			}
-			foreign.R.Owner = object
+			foreign.R.Friend2 = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.OwnerID {
-				local.R.OwnerShares = append(local.R.OwnerShares, foreign)
+			if local.ID == foreign.Friend2ID {
+				local.R.Friend2Friendships = append(local.R.Friend2Friendships, foreign)
 				if foreign.R == nil {
-					foreign.R = &shareR{}
+					foreign.R = &friendshipR{}
 				}
-				foreign.R.Owner = local
+				foreign.R.Friend2 = local
 			}
 		}
 	}
@@ -2216,9 +2891,9 @@ func (userL) LoadOwnerShares(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// LoadTargetUserShares allows an eager lookup of values, cached into the
+// LoadOwnerImages allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadTargetUserShares(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadOwnerImages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2271,8 +2946,8 @@ func (userL) LoadTargetUserShares(ctx context.Context, e boil.ContextExecutor, s
 	}
 
 	query := NewQuery(
-		qm.From(`shares`),
-		qm.WhereIn(`shares.target_user_id in ?`, argsSlice...),
+		qm.From(`images`),
+		qm.WhereIn(`images.owner_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2280,22 +2955,22 @@ func (userL) LoadTargetUserShares(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load shares")
+		return errors.Wrap(err, "failed to eager load images")
 	}
 
-	var resultSlice []*Share
+	var resultSlice []*Image
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice shares")
+		return errors.Wrap(err, "failed to bind eager loaded slice images")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on shares")
+		return errors.Wrap(err, "failed to close results in eager load on images")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for shares")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for images")
 	}
 
-	if len(shareAfterSelectHooks) != 0 {
+	if len(imageAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -2303,24 +2978,24 @@ func (userL) LoadTargetUserShares(ctx context.Context, e boil.ContextExecutor, s
 		}
 	}
 	if singular {
-		object.R.TargetUserShares = resultSlice
+		object.R.OwnerImages = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &shareR{}
+				foreign.R = &imageR{}
 			}
-			foreign.R.TargetUser = object
+			foreign.R.Owner = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.TargetUserID {
-				local.R.TargetUserShares = append(local.R.TargetUserShares, foreign)
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerImages = append(local.R.OwnerImages, foreign)
 				if foreign.R == nil {
-					foreign.R = &shareR{}
+					foreign.R = &imageR{}
 				}
-				foreign.R.TargetUser = local
+				foreign.R.Owner = local
 			}
 		}
 	}
@@ -2328,9 +3003,9 @@ func (userL) LoadTargetUserShares(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// LoadOwnerThings allows an eager lookup of values, cached into the
+// LoadOwnerInvites allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerThings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadOwnerInvites(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -2383,8 +3058,8 @@ func (userL) LoadOwnerThings(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.From(`things`),
-		qm.WhereIn(`things.owner_id in ?`, argsSlice...),
+		qm.From(`invites`),
+		qm.WhereIn(`invites.owner_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -2392,22 +3067,22 @@ func (userL) LoadOwnerThings(ctx context.Context, e boil.ContextExecutor, singul
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load things")
+		return errors.Wrap(err, "failed to eager load invites")
 	}
 
-	var resultSlice []*Thing
+	var resultSlice []*Invite
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice things")
+		return errors.Wrap(err, "failed to bind eager loaded slice invites")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on things")
+		return errors.Wrap(err, "failed to close results in eager load on invites")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for things")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for invites")
 	}
 
-	if len(thingAfterSelectHooks) != 0 {
+	if len(inviteAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -2415,10 +3090,10 @@ func (userL) LoadOwnerThings(ctx context.Context, e boil.ContextExecutor, singul
 		}
 	}
 	if singular {
-		object.R.OwnerThings = resultSlice
+		object.R.OwnerInvites = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &thingR{}
+				foreign.R = &inviteR{}
 			}
 			foreign.R.Owner = object
 		}
@@ -2427,10 +3102,10 @@ func (userL) LoadOwnerThings(ctx context.Context, e boil.ContextExecutor, singul
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.OwnerID {
-				local.R.OwnerThings = append(local.R.OwnerThings, foreign)
+			if queries.Equal(local.ID, foreign.OwnerID) {
+				local.R.OwnerInvites = append(local.R.OwnerInvites, foreign)
 				if foreign.R == nil {
-					foreign.R = &thingR{}
+					foreign.R = &inviteR{}
 				}
 				foreign.R.Owner = local
 			}
@@ -2440,152 +3115,2846 @@ func (userL) LoadOwnerThings(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// SetProfile of the user to the related item.
-// Sets o.R.Profile to related.
-// Adds o to related.R.User.
-func (o *User) SetProfile(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Profile) error {
-	var err error
-
-	if insert {
-		queries.Assign(&related.UserID, o.ID)
+// LoadOwnerLists allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerLists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
 		}
 	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"profiles\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-			strmangle.WhereClause("\"", "\"", 2, profilePrimaryKeyColumns),
-		)
-		values := []interface{}{o.ID, related.ID}
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
 
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, updateQuery)
-			fmt.Fprintln(writer, values)
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
 		}
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
 		}
+	}
 
-		queries.Assign(&related.UserID, o.ID)
+	if len(args) == 0 {
+		return nil
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			Profile: related,
-		}
-	} else {
-		o.R.Profile = related
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	if related.R == nil {
-		related.R = &profileR{
-			User: o,
-		}
-	} else {
-		related.R.User = o
+	query := NewQuery(
+		qm.From(`lists`),
+		qm.WhereIn(`lists.owner_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
-	return nil
-}
 
-// RemoveProfile relationship.
-// Sets o.R.Profile to nil.
-// Removes o from all passed in related items' relationships struct.
-func (o *User) RemoveProfile(ctx context.Context, exec boil.ContextExecutor, related *Profile) error {
-	var err error
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load lists")
+	}
 
-	queries.SetScanner(&related.UserID, nil)
-	if _, err = related.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	var resultSlice []*List
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice lists")
 	}
 
-	if o.R != nil {
-		o.R.Profile = nil
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on lists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
 	}
 
-	if related == nil || related.R == nil {
+	if len(listAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerLists = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listR{}
+			}
+			foreign.R.Owner = object
+		}
 		return nil
 	}
 
-	related.R.User = nil
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerLists = append(local.R.OwnerLists, foreign)
+				if foreign.R == nil {
+					foreign.R = &listR{}
+				}
+				foreign.R.Owner = local
+			}
+		}
+	}
 
 	return nil
 }
 
-// AddCartEntries adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.CartEntries.
-// Sets related.R.User appropriately.
-func (o *User) AddCartEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CartEntry) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.UserID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"cart_entries\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, cartEntryPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.UserID, rel.ThingID}
+// LoadBorrowerLoans allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBorrowerLoans(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
 			}
-
-			rel.UserID = o.ID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			CartEntries: related,
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		o.R.CartEntries = append(o.R.CartEntries, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &cartEntryR{
-				User: o,
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
 			}
-		} else {
-			rel.R.User = o
+			args[obj.ID] = struct{}{}
 		}
 	}
-	return nil
-}
 
-// AddEmailVerificationCodes adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.EmailVerificationCodes.
-// Sets related.R.User appropriately.
-func (o *User) AddEmailVerificationCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EmailVerificationCode) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.UserID = o.ID
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`loans`),
+		qm.WhereIn(`loans.borrower_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load loans")
+	}
+
+	var resultSlice []*Loan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice loans")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on loans")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loans")
+	}
+
+	if len(loanAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BorrowerLoans = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loanR{}
+			}
+			foreign.R.Borrower = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BorrowerID {
+				local.R.BorrowerLoans = append(local.R.BorrowerLoans, foreign)
+				if foreign.R == nil {
+					foreign.R = &loanR{}
+				}
+				foreign.R.Borrower = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerLoans allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerLoans(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`loans`),
+		qm.WhereIn(`loans.owner_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load loans")
+	}
+
+	var resultSlice []*Loan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice loans")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on loans")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loans")
+	}
+
+	if len(loanAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerLoans = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loanR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerLoans = append(local.R.OwnerLoans, foreign)
+				if foreign.R == nil {
+					foreign.R = &loanR{}
+				}
+				foreign.R.Owner = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerLocations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerLocations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`locations`),
+		qm.WhereIn(`locations.owner_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load locations")
+	}
+
+	var resultSlice []*Location
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice locations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on locations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for locations")
+	}
+
+	if len(locationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerLocations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &locationR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerLocations = append(local.R.OwnerLocations, foreign)
+				if foreign.R == nil {
+					foreign.R = &locationR{}
+				}
+				foreign.R.Owner = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotificationPreferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notification_preferences`),
+		qm.WhereIn(`notification_preferences.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notification_preferences")
+	}
+
+	var resultSlice []*NotificationPreference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_preferences")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_preferences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_preferences")
+	}
+
+	if len(notificationPreferenceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationPreferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationPreferenceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.NotificationPreferences = append(local.R.NotificationPreferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationPreferenceR{}
+				}
+				foreign.R.User = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecipientNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRecipientNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notifications`),
+		qm.WhereIn(`notifications.recipient_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notifications")
+	}
+
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
+	}
+
+	if len(notificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RecipientNotifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationR{}
+			}
+			foreign.R.Recipient = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RecipientID {
+				local.R.RecipientNotifications = append(local.R.RecipientNotifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationR{}
+				}
+				foreign.R.Recipient = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLinkUserOidcLogins allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadLinkUserOidcLogins(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`oidc_logins`),
+		qm.WhereIn(`oidc_logins.link_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oidc_logins")
+	}
+
+	var resultSlice []*OidcLogin
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oidc_logins")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oidc_logins")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oidc_logins")
+	}
+
+	if len(oidcLoginAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.LinkUserOidcLogins = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oidcLoginR{}
+			}
+			foreign.R.LinkUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.LinkUserID) {
+				local.R.LinkUserOidcLogins = append(local.R.LinkUserOidcLogins, foreign)
+				if foreign.R == nil {
+					foreign.R = &oidcLoginR{}
+				}
+				foreign.R.LinkUser = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerPasskeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerPasskeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`passkeys`),
+		qm.WhereIn(`passkeys.owner_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load passkeys")
+	}
+
+	var resultSlice []*Passkey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice passkeys")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on passkeys")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for passkeys")
+	}
+
+	if len(passkeyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerPasskeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &passkeyR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerPasskeys = append(local.R.OwnerPasskeys, foreign)
+				if foreign.R == nil {
+					foreign.R = &passkeyR{}
+				}
+				foreign.R.Owner = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPasswordResetTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`password_reset_tokens`),
+		qm.WhereIn(`password_reset_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load password_reset_tokens")
+	}
+
+	var resultSlice []*PasswordResetToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice password_reset_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on password_reset_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_reset_tokens")
+	}
+
+	if len(passwordResetTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PasswordResetTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &passwordResetTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PasswordResetTokens = append(local.R.PasswordResetTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &passwordResetTokenR{}
+				}
+				foreign.R.User = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByQuantityEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByQuantityEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`quantity_entries`),
+		qm.WhereIn(`quantity_entries.created_by_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load quantity_entries")
+	}
+
+	var resultSlice []*QuantityEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice quantity_entries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on quantity_entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for quantity_entries")
+	}
+
+	if len(quantityEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByQuantityEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &quantityEntryR{}
+			}
+			foreign.R.CreatedBy = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedByID) {
+				local.R.CreatedByQuantityEntries = append(local.R.CreatedByQuantityEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &quantityEntryR{}
+				}
+				foreign.R.CreatedBy = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerRecoveryCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`recovery_codes`),
+		qm.WhereIn(`recovery_codes.owner_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load recovery_codes")
+	}
+
+	var resultSlice []*RecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice recovery_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on recovery_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for recovery_codes")
+	}
+
+	if len(recoveryCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerRecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &recoveryCodeR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerRecoveryCodes = append(local.R.OwnerRecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &recoveryCodeR{}
+				}
+				foreign.R.Owner = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`sessions`),
+		qm.WhereIn(`sessions.owner_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sessions")
+	}
+
+	var resultSlice []*Session
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sessions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sessions")
+	}
+
+	if len(sessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &sessionR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerSessions = append(local.R.OwnerSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &sessionR{}
+				}
+				foreign.R.Owner = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerShares allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerShares(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`shares`),
+		qm.WhereIn(`shares.owner_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load shares")
+	}
+
+	var resultSlice []*Share
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice shares")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on shares")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for shares")
+	}
+
+	if len(shareAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerShares = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &shareR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerShares = append(local.R.OwnerShares, foreign)
+				if foreign.R == nil {
+					foreign.R = &shareR{}
+				}
+				foreign.R.Owner = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTargetUserShares allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTargetUserShares(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`shares`),
+		qm.WhereIn(`shares.target_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load shares")
+	}
+
+	var resultSlice []*Share
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice shares")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on shares")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for shares")
+	}
+
+	if len(shareAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TargetUserShares = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &shareR{}
+			}
+			foreign.R.TargetUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TargetUserID {
+				local.R.TargetUserShares = append(local.R.TargetUserShares, foreign)
+				if foreign.R == nil {
+					foreign.R = &shareR{}
+				}
+				foreign.R.TargetUser = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerThings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerThings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`things`),
+		qm.WhereIn(`things.owner_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load things")
+	}
+
+	var resultSlice []*Thing
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice things")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on things")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for things")
+	}
+
+	if len(thingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerThings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &thingR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerThings = append(local.R.OwnerThings, foreign)
+				if foreign.R == nil {
+					foreign.R = &thingR{}
+				}
+				foreign.R.Owner = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebauthnCeremonies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWebauthnCeremonies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webauthn_ceremonies`),
+		qm.WhereIn(`webauthn_ceremonies.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webauthn_ceremonies")
+	}
+
+	var resultSlice []*WebauthnCeremony
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webauthn_ceremonies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webauthn_ceremonies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webauthn_ceremonies")
+	}
+
+	if len(webauthnCeremonyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebauthnCeremonies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webauthnCeremonyR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.WebauthnCeremonies = append(local.R.WebauthnCeremonies, foreign)
+				if foreign.R == nil {
+					foreign.R = &webauthnCeremonyR{}
+				}
+				foreign.R.User = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerWebhooks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerWebhooks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webhooks`),
+		qm.WhereIn(`webhooks.owner_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhooks")
+	}
+
+	var resultSlice []*Webhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhooks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhooks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhooks")
+	}
+
+	if len(webhookAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerWebhooks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerWebhooks = append(local.R.OwnerWebhooks, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookR{}
+				}
+				foreign.R.Owner = local
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetInvite of the user to the related item.
+// Sets o.R.Invite to related.
+// Adds o to related.R.Users.
+func (o *User) SetInvite(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Invite) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"invite_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.InviteID, related.ID)
+	if o.R == nil {
+		o.R = &userR{
+			Invite: related,
+		}
+	} else {
+		o.R.Invite = related
+	}
+
+	if related.R == nil {
+		related.R = &inviteR{
+			Users: UserSlice{o},
+		}
+	} else {
+		related.R.Users = append(related.R.Users, o)
+	}
+
+	return nil
+}
+
+// RemoveInvite relationship.
+// Sets o.R.Invite to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *User) RemoveInvite(ctx context.Context, exec boil.ContextExecutor, related *Invite) error {
+	var err error
+
+	queries.SetScanner(&o.InviteID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("invite_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Invite = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Users {
+		if queries.Equal(o.InviteID, ri.InviteID) {
+			continue
+		}
+
+		ln := len(related.R.Users)
+		if ln > 1 && i < ln-1 {
+			related.R.Users[i] = related.R.Users[ln-1]
+		}
+		related.R.Users = related.R.Users[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetProfile of the user to the related item.
+// Sets o.R.Profile to related.
+// Adds o to related.R.User.
+func (o *User) SetProfile(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Profile) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.UserID, o.ID)
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"profiles\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, profilePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.UserID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Profile: related,
+		}
+	} else {
+		o.R.Profile = related
+	}
+
+	if related.R == nil {
+		related.R = &profileR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// RemoveProfile relationship.
+// Sets o.R.Profile to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *User) RemoveProfile(ctx context.Context, exec boil.ContextExecutor, related *Profile) error {
+	var err error
+
+	queries.SetScanner(&related.UserID, nil)
+	if _, err = related.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Profile = nil
+	}
+
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	related.R.User = nil
+
+	return nil
+}
+
+// AddOwnerAPITokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerAPITokens.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerAPITokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*APIToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OwnerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"api_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, apiTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OwnerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerAPITokens: related,
+		}
+	} else {
+		o.R.OwnerAPITokens = append(o.R.OwnerAPITokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &apiTokenR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// AddBorrowerBorrowRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BorrowerBorrowRequests.
+// Sets related.R.Borrower appropriately.
+func (o *User) AddBorrowerBorrowRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BorrowRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BorrowerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"borrow_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"borrower_id"}),
+				strmangle.WhereClause("\"", "\"", 2, borrowRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BorrowerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			BorrowerBorrowRequests: related,
+		}
+	} else {
+		o.R.BorrowerBorrowRequests = append(o.R.BorrowerBorrowRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &borrowRequestR{
+				Borrower: o,
+			}
+		} else {
+			rel.R.Borrower = o
+		}
+	}
+	return nil
+}
+
+// AddOwnerBorrowRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerBorrowRequests.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerBorrowRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BorrowRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OwnerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"borrow_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, borrowRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OwnerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerBorrowRequests: related,
+		}
+	} else {
+		o.R.OwnerBorrowRequests = append(o.R.OwnerBorrowRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &borrowRequestR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// AddCartEntries adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CartEntries.
+// Sets related.R.User appropriately.
+func (o *User) AddCartEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CartEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"cart_entries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, cartEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.ThingID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CartEntries: related,
+		}
+	} else {
+		o.R.CartEntries = append(o.R.CartEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &cartEntryR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddEmailVerificationCodes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.EmailVerificationCodes.
+// Sets related.R.User appropriately.
+func (o *User) AddEmailVerificationCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EmailVerificationCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"email_verification_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, emailVerificationCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.Email, rel.DigitCode}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			EmailVerificationCodes: related,
+		}
+	} else {
+		o.R.EmailVerificationCodes = append(o.R.EmailVerificationCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &emailVerificationCodeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddEmailVerifications adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.EmailVerifications.
+// Sets related.R.User appropriately.
+func (o *User) AddEmailVerifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EmailVerification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"email_verifications\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, emailVerificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.Email}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			EmailVerifications: related,
+		}
+	} else {
+		o.R.EmailVerifications = append(o.R.EmailVerifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &emailVerificationR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddReceiverFriendRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ReceiverFriendRequests.
+// Sets related.R.Receiver appropriately.
+func (o *User) AddReceiverFriendRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FriendRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ReceiverID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"friend_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"receiver_id"}),
+				strmangle.WhereClause("\"", "\"", 2, friendRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ReceiverID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ReceiverFriendRequests: related,
+		}
+	} else {
+		o.R.ReceiverFriendRequests = append(o.R.ReceiverFriendRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &friendRequestR{
+				Receiver: o,
+			}
+		} else {
+			rel.R.Receiver = o
+		}
+	}
+	return nil
+}
+
+// AddSenderFriendRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SenderFriendRequests.
+// Sets related.R.Sender appropriately.
+func (o *User) AddSenderFriendRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FriendRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.SenderID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"friend_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"sender_id"}),
+				strmangle.WhereClause("\"", "\"", 2, friendRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.SenderID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			SenderFriendRequests: related,
+		}
+	} else {
+		o.R.SenderFriendRequests = append(o.R.SenderFriendRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &friendRequestR{
+				Sender: o,
+			}
+		} else {
+			rel.R.Sender = o
+		}
+	}
+	return nil
+}
+
+// AddFriend1Friendships adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Friend1Friendships.
+// Sets related.R.Friend1 appropriately.
+func (o *User) AddFriend1Friendships(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Friendship) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Friend1ID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"friendships\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"friend1_id"}),
+				strmangle.WhereClause("\"", "\"", 2, friendshipPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Friend1ID, rel.Friend2ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Friend1ID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Friend1Friendships: related,
+		}
+	} else {
+		o.R.Friend1Friendships = append(o.R.Friend1Friendships, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &friendshipR{
+				Friend1: o,
+			}
+		} else {
+			rel.R.Friend1 = o
+		}
+	}
+	return nil
+}
+
+// AddFriend2Friendships adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Friend2Friendships.
+// Sets related.R.Friend2 appropriately.
+func (o *User) AddFriend2Friendships(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Friendship) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Friend2ID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"friendships\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"friend2_id"}),
+				strmangle.WhereClause("\"", "\"", 2, friendshipPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Friend1ID, rel.Friend2ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Friend2ID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Friend2Friendships: related,
+		}
+	} else {
+		o.R.Friend2Friendships = append(o.R.Friend2Friendships, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &friendshipR{
+				Friend2: o,
+			}
+		} else {
+			rel.R.Friend2 = o
+		}
+	}
+	return nil
+}
+
+// AddOwnerImages adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerImages.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerImages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Image) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OwnerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"images\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, imagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OwnerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerImages: related,
+		}
+	} else {
+		o.R.OwnerImages = append(o.R.OwnerImages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &imageR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// AddOwnerInvites adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerInvites.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerInvites(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invite) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.OwnerID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"invites\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, invitePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.OwnerID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerInvites: related,
+		}
+	} else {
+		o.R.OwnerInvites = append(o.R.OwnerInvites, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &inviteR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// SetOwnerInvites removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Owner's OwnerInvites accordingly.
+// Replaces o.R.OwnerInvites with related.
+// Sets related.R.Owner's OwnerInvites accordingly.
+func (o *User) SetOwnerInvites(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invite) error {
+	query := "update \"invites\" set \"owner_id\" = null where \"owner_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.OwnerInvites {
+			queries.SetScanner(&rel.OwnerID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Owner = nil
+		}
+		o.R.OwnerInvites = nil
+	}
+
+	return o.AddOwnerInvites(ctx, exec, insert, related...)
+}
+
+// RemoveOwnerInvites relationships from objects passed in.
+// Removes related items from R.OwnerInvites (uses pointer comparison, removal does not keep order)
+// Sets related.R.Owner.
+func (o *User) RemoveOwnerInvites(ctx context.Context, exec boil.ContextExecutor, related ...*Invite) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.OwnerID, nil)
+		if rel.R != nil {
+			rel.R.Owner = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("owner_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.OwnerInvites {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.OwnerInvites)
+			if ln > 1 && i < ln-1 {
+				o.R.OwnerInvites[i] = o.R.OwnerInvites[ln-1]
+			}
+			o.R.OwnerInvites = o.R.OwnerInvites[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddOwnerLists adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerLists.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerLists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*List) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OwnerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"lists\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, listPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OwnerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerLists: related,
+		}
+	} else {
+		o.R.OwnerLists = append(o.R.OwnerLists, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// AddBorrowerLoans adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BorrowerLoans.
+// Sets related.R.Borrower appropriately.
+func (o *User) AddBorrowerLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BorrowerID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"email_verification_codes\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, emailVerificationCodePrimaryKeyColumns),
+				"UPDATE \"loans\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"borrower_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.UserID, rel.Email, rel.DigitCode}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2596,35 +5965,141 @@ func (o *User) AddEmailVerificationCodes(ctx context.Context, exec boil.ContextE
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			rel.BorrowerID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			EmailVerificationCodes: related,
+			BorrowerLoans: related,
 		}
 	} else {
-		o.R.EmailVerificationCodes = append(o.R.EmailVerificationCodes, related...)
+		o.R.BorrowerLoans = append(o.R.BorrowerLoans, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &emailVerificationCodeR{
-				User: o,
+			rel.R = &loanR{
+				Borrower: o,
 			}
 		} else {
-			rel.R.User = o
+			rel.R.Borrower = o
 		}
 	}
 	return nil
 }
 
-// AddEmailVerifications adds the given related objects to the existing relationships
+// AddOwnerLoans adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.EmailVerifications.
+// Appends related to o.R.OwnerLoans.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerLoans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Loan) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OwnerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"loans\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loanPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OwnerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerLoans: related,
+		}
+	} else {
+		o.R.OwnerLoans = append(o.R.OwnerLoans, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loanR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// AddOwnerLocations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerLocations.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerLocations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Location) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OwnerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"locations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, locationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OwnerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerLocations: related,
+		}
+	} else {
+		o.R.OwnerLocations = append(o.R.OwnerLocations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &locationR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// AddNotificationPreferences adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.NotificationPreferences.
 // Sets related.R.User appropriately.
-func (o *User) AddEmailVerifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EmailVerification) error {
+func (o *User) AddNotificationPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationPreference) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2634,11 +6109,11 @@ func (o *User) AddEmailVerifications(ctx context.Context, exec boil.ContextExecu
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"email_verifications\" SET %s WHERE %s",
+				"UPDATE \"notification_preferences\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, emailVerificationPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, notificationPreferencePrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.UserID, rel.Email}
+			values := []interface{}{o.ID, rel.UserID, rel.ContentType}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2655,41 +6130,221 @@ func (o *User) AddEmailVerifications(ctx context.Context, exec boil.ContextExecu
 
 	if o.R == nil {
 		o.R = &userR{
-			EmailVerifications: related,
+			NotificationPreferences: related,
 		}
 	} else {
-		o.R.EmailVerifications = append(o.R.EmailVerifications, related...)
+		o.R.NotificationPreferences = append(o.R.NotificationPreferences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationPreferenceR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddRecipientNotifications adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RecipientNotifications.
+// Sets related.R.Recipient appropriately.
+func (o *User) AddRecipientNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RecipientID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"notifications\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"recipient_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RecipientID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			RecipientNotifications: related,
+		}
+	} else {
+		o.R.RecipientNotifications = append(o.R.RecipientNotifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				Recipient: o,
+			}
+		} else {
+			rel.R.Recipient = o
+		}
+	}
+	return nil
+}
+
+// AddLinkUserOidcLogins adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.LinkUserOidcLogins.
+// Sets related.R.LinkUser appropriately.
+func (o *User) AddLinkUserOidcLogins(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OidcLogin) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.LinkUserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oidc_logins\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"link_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, oidcLoginPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.LinkUserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			LinkUserOidcLogins: related,
+		}
+	} else {
+		o.R.LinkUserOidcLogins = append(o.R.LinkUserOidcLogins, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oidcLoginR{
+				LinkUser: o,
+			}
+		} else {
+			rel.R.LinkUser = o
+		}
+	}
+	return nil
+}
+
+// SetLinkUserOidcLogins removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.LinkUser's LinkUserOidcLogins accordingly.
+// Replaces o.R.LinkUserOidcLogins with related.
+// Sets related.R.LinkUser's LinkUserOidcLogins accordingly.
+func (o *User) SetLinkUserOidcLogins(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OidcLogin) error {
+	query := "update \"oidc_logins\" set \"link_user_id\" = null where \"link_user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.LinkUserOidcLogins {
+			queries.SetScanner(&rel.LinkUserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.LinkUser = nil
+		}
+		o.R.LinkUserOidcLogins = nil
+	}
+
+	return o.AddLinkUserOidcLogins(ctx, exec, insert, related...)
+}
+
+// RemoveLinkUserOidcLogins relationships from objects passed in.
+// Removes related items from R.LinkUserOidcLogins (uses pointer comparison, removal does not keep order)
+// Sets related.R.LinkUser.
+func (o *User) RemoveLinkUserOidcLogins(ctx context.Context, exec boil.ContextExecutor, related ...*OidcLogin) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.LinkUserID, nil)
+		if rel.R != nil {
+			rel.R.LinkUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("link_user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
 	}
 
 	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &emailVerificationR{
-				User: o,
+		for i, ri := range o.R.LinkUserOidcLogins {
+			if rel != ri {
+				continue
 			}
-		} else {
-			rel.R.User = o
+
+			ln := len(o.R.LinkUserOidcLogins)
+			if ln > 1 && i < ln-1 {
+				o.R.LinkUserOidcLogins[i] = o.R.LinkUserOidcLogins[ln-1]
+			}
+			o.R.LinkUserOidcLogins = o.R.LinkUserOidcLogins[:ln-1]
+			break
 		}
 	}
+
 	return nil
 }
 
-// AddReceiverFriendRequests adds the given related objects to the existing relationships
+// AddOwnerPasskeys adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.ReceiverFriendRequests.
-// Sets related.R.Receiver appropriately.
-func (o *User) AddReceiverFriendRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FriendRequest) error {
+// Appends related to o.R.OwnerPasskeys.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerPasskeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Passkey) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.ReceiverID = o.ID
+			rel.OwnerID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"friend_requests\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"receiver_id"}),
-				strmangle.WhereClause("\"", "\"", 2, friendRequestPrimaryKeyColumns),
+				"UPDATE \"passkeys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, passkeyPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2702,47 +6357,47 @@ func (o *User) AddReceiverFriendRequests(ctx context.Context, exec boil.ContextE
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.ReceiverID = o.ID
+			rel.OwnerID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			ReceiverFriendRequests: related,
+			OwnerPasskeys: related,
 		}
 	} else {
-		o.R.ReceiverFriendRequests = append(o.R.ReceiverFriendRequests, related...)
+		o.R.OwnerPasskeys = append(o.R.OwnerPasskeys, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &friendRequestR{
-				Receiver: o,
+			rel.R = &passkeyR{
+				Owner: o,
 			}
 		} else {
-			rel.R.Receiver = o
+			rel.R.Owner = o
 		}
 	}
 	return nil
 }
 
-// AddSenderFriendRequests adds the given related objects to the existing relationships
+// AddPasswordResetTokens adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.SenderFriendRequests.
-// Sets related.R.Sender appropriately.
-func (o *User) AddSenderFriendRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FriendRequest) error {
+// Appends related to o.R.PasswordResetTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetToken) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.SenderID = o.ID
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"friend_requests\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"sender_id"}),
-				strmangle.WhereClause("\"", "\"", 2, friendRequestPrimaryKeyColumns),
+				"UPDATE \"password_reset_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, passwordResetTokenPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2755,49 +6410,49 @@ func (o *User) AddSenderFriendRequests(ctx context.Context, exec boil.ContextExe
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.SenderID = o.ID
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			SenderFriendRequests: related,
+			PasswordResetTokens: related,
 		}
 	} else {
-		o.R.SenderFriendRequests = append(o.R.SenderFriendRequests, related...)
+		o.R.PasswordResetTokens = append(o.R.PasswordResetTokens, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &friendRequestR{
-				Sender: o,
+			rel.R = &passwordResetTokenR{
+				User: o,
 			}
 		} else {
-			rel.R.Sender = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// AddFriend1Friendships adds the given related objects to the existing relationships
+// AddCreatedByQuantityEntries adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Friend1Friendships.
-// Sets related.R.Friend1 appropriately.
-func (o *User) AddFriend1Friendships(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Friendship) error {
+// Appends related to o.R.CreatedByQuantityEntries.
+// Sets related.R.CreatedBy appropriately.
+func (o *User) AddCreatedByQuantityEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*QuantityEntry) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.Friend1ID = o.ID
+			queries.Assign(&rel.CreatedByID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"friendships\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"friend1_id"}),
-				strmangle.WhereClause("\"", "\"", 2, friendshipPrimaryKeyColumns),
+				"UPDATE \"quantity_entries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by_id"}),
+				strmangle.WhereClause("\"", "\"", 2, quantityEntryPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.Friend1ID, rel.Friend2ID}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2808,49 +6463,123 @@ func (o *User) AddFriend1Friendships(ctx context.Context, exec boil.ContextExecu
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.Friend1ID = o.ID
+			queries.Assign(&rel.CreatedByID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			Friend1Friendships: related,
+			CreatedByQuantityEntries: related,
 		}
 	} else {
-		o.R.Friend1Friendships = append(o.R.Friend1Friendships, related...)
+		o.R.CreatedByQuantityEntries = append(o.R.CreatedByQuantityEntries, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &friendshipR{
-				Friend1: o,
+			rel.R = &quantityEntryR{
+				CreatedBy: o,
 			}
 		} else {
-			rel.R.Friend1 = o
+			rel.R.CreatedBy = o
 		}
 	}
 	return nil
 }
 
-// AddFriend2Friendships adds the given related objects to the existing relationships
+// SetCreatedByQuantityEntries removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedBy's CreatedByQuantityEntries accordingly.
+// Replaces o.R.CreatedByQuantityEntries with related.
+// Sets related.R.CreatedBy's CreatedByQuantityEntries accordingly.
+func (o *User) SetCreatedByQuantityEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*QuantityEntry) error {
+	query := "update \"quantity_entries\" set \"created_by_id\" = null where \"created_by_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByQuantityEntries {
+			queries.SetScanner(&rel.CreatedByID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedBy = nil
+		}
+		o.R.CreatedByQuantityEntries = nil
+	}
+
+	return o.AddCreatedByQuantityEntries(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByQuantityEntries relationships from objects passed in.
+// Removes related items from R.CreatedByQuantityEntries (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedBy.
+func (o *User) RemoveCreatedByQuantityEntries(ctx context.Context, exec boil.ContextExecutor, related ...*QuantityEntry) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedByID, nil)
+		if rel.R != nil {
+			rel.R.CreatedBy = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByQuantityEntries {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByQuantityEntries)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByQuantityEntries[i] = o.R.CreatedByQuantityEntries[ln-1]
+			}
+			o.R.CreatedByQuantityEntries = o.R.CreatedByQuantityEntries[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddOwnerRecoveryCodes adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Friend2Friendships.
-// Sets related.R.Friend2 appropriately.
-func (o *User) AddFriend2Friendships(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Friendship) error {
+// Appends related to o.R.OwnerRecoveryCodes.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RecoveryCode) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.Friend2ID = o.ID
+			rel.OwnerID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"friendships\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"friend2_id"}),
-				strmangle.WhereClause("\"", "\"", 2, friendshipPrimaryKeyColumns),
+				"UPDATE \"recovery_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, recoveryCodePrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.Friend1ID, rel.Friend2ID}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2861,35 +6590,35 @@ func (o *User) AddFriend2Friendships(ctx context.Context, exec boil.ContextExecu
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.Friend2ID = o.ID
+			rel.OwnerID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			Friend2Friendships: related,
+			OwnerRecoveryCodes: related,
 		}
 	} else {
-		o.R.Friend2Friendships = append(o.R.Friend2Friendships, related...)
+		o.R.OwnerRecoveryCodes = append(o.R.OwnerRecoveryCodes, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &friendshipR{
-				Friend2: o,
+			rel.R = &recoveryCodeR{
+				Owner: o,
 			}
 		} else {
-			rel.R.Friend2 = o
+			rel.R.Owner = o
 		}
 	}
 	return nil
 }
 
-// AddOwnerImages adds the given related objects to the existing relationships
+// AddOwnerSessions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.OwnerImages.
+// Appends related to o.R.OwnerSessions.
 // Sets related.R.Owner appropriately.
-func (o *User) AddOwnerImages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Image) error {
+func (o *User) AddOwnerSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Session) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2899,9 +6628,9 @@ func (o *User) AddOwnerImages(ctx context.Context, exec boil.ContextExecutor, in
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"images\" SET %s WHERE %s",
+				"UPDATE \"sessions\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-				strmangle.WhereClause("\"", "\"", 2, imagePrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, sessionPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2920,15 +6649,15 @@ func (o *User) AddOwnerImages(ctx context.Context, exec boil.ContextExecutor, in
 
 	if o.R == nil {
 		o.R = &userR{
-			OwnerImages: related,
+			OwnerSessions: related,
 		}
 	} else {
-		o.R.OwnerImages = append(o.R.OwnerImages, related...)
+		o.R.OwnerSessions = append(o.R.OwnerSessions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &imageR{
+			rel.R = &sessionR{
 				Owner: o,
 			}
 		} else {
@@ -2938,11 +6667,11 @@ func (o *User) AddOwnerImages(ctx context.Context, exec boil.ContextExecutor, in
 	return nil
 }
 
-// AddOwnerLists adds the given related objects to the existing relationships
+// AddOwnerShares adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.OwnerLists.
+// Appends related to o.R.OwnerShares.
 // Sets related.R.Owner appropriately.
-func (o *User) AddOwnerLists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*List) error {
+func (o *User) AddOwnerShares(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Share) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2952,9 +6681,9 @@ func (o *User) AddOwnerLists(ctx context.Context, exec boil.ContextExecutor, ins
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"lists\" SET %s WHERE %s",
+				"UPDATE \"shares\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-				strmangle.WhereClause("\"", "\"", 2, listPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, sharePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2973,15 +6702,15 @@ func (o *User) AddOwnerLists(ctx context.Context, exec boil.ContextExecutor, ins
 
 	if o.R == nil {
 		o.R = &userR{
-			OwnerLists: related,
+			OwnerShares: related,
 		}
 	} else {
-		o.R.OwnerLists = append(o.R.OwnerLists, related...)
+		o.R.OwnerShares = append(o.R.OwnerShares, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &listR{
+			rel.R = &shareR{
 				Owner: o,
 			}
 		} else {
@@ -2991,23 +6720,23 @@ func (o *User) AddOwnerLists(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
-// AddRecipientNotifications adds the given related objects to the existing relationships
+// AddTargetUserShares adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.RecipientNotifications.
-// Sets related.R.Recipient appropriately.
-func (o *User) AddRecipientNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+// Appends related to o.R.TargetUserShares.
+// Sets related.R.TargetUser appropriately.
+func (o *User) AddTargetUserShares(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Share) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.RecipientID = o.ID
+			rel.TargetUserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"notifications\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"recipient_id"}),
-				strmangle.WhereClause("\"", "\"", 2, notificationPrimaryKeyColumns),
+				"UPDATE \"shares\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"target_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, sharePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3020,35 +6749,35 @@ func (o *User) AddRecipientNotifications(ctx context.Context, exec boil.ContextE
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.RecipientID = o.ID
+			rel.TargetUserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			RecipientNotifications: related,
+			TargetUserShares: related,
 		}
 	} else {
-		o.R.RecipientNotifications = append(o.R.RecipientNotifications, related...)
+		o.R.TargetUserShares = append(o.R.TargetUserShares, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &notificationR{
-				Recipient: o,
+			rel.R = &shareR{
+				TargetUser: o,
 			}
 		} else {
-			rel.R.Recipient = o
+			rel.R.TargetUser = o
 		}
 	}
 	return nil
 }
 
-// AddOwnerShares adds the given related objects to the existing relationships
+// AddOwnerThings adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.OwnerShares.
+// Appends related to o.R.OwnerThings.
 // Sets related.R.Owner appropriately.
-func (o *User) AddOwnerShares(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Share) error {
+func (o *User) AddOwnerThings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Thing) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -3058,9 +6787,9 @@ func (o *User) AddOwnerShares(ctx context.Context, exec boil.ContextExecutor, in
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"shares\" SET %s WHERE %s",
+				"UPDATE \"things\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-				strmangle.WhereClause("\"", "\"", 2, sharePrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, thingPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3079,15 +6808,15 @@ func (o *User) AddOwnerShares(ctx context.Context, exec boil.ContextExecutor, in
 
 	if o.R == nil {
 		o.R = &userR{
-			OwnerShares: related,
+			OwnerThings: related,
 		}
 	} else {
-		o.R.OwnerShares = append(o.R.OwnerShares, related...)
+		o.R.OwnerThings = append(o.R.OwnerThings, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &shareR{
+			rel.R = &thingR{
 				Owner: o,
 			}
 		} else {
@@ -3097,23 +6826,23 @@ func (o *User) AddOwnerShares(ctx context.Context, exec boil.ContextExecutor, in
 	return nil
 }
 
-// AddTargetUserShares adds the given related objects to the existing relationships
+// AddWebauthnCeremonies adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.TargetUserShares.
-// Sets related.R.TargetUser appropriately.
-func (o *User) AddTargetUserShares(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Share) error {
+// Appends related to o.R.WebauthnCeremonies.
+// Sets related.R.User appropriately.
+func (o *User) AddWebauthnCeremonies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnCeremony) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.TargetUserID = o.ID
+			queries.Assign(&rel.UserID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"shares\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"target_user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, sharePrimaryKeyColumns),
+				"UPDATE \"webauthn_ceremonies\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webauthnCeremonyPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3126,35 +6855,109 @@ func (o *User) AddTargetUserShares(ctx context.Context, exec boil.ContextExecuto
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.TargetUserID = o.ID
+			queries.Assign(&rel.UserID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			TargetUserShares: related,
+			WebauthnCeremonies: related,
 		}
 	} else {
-		o.R.TargetUserShares = append(o.R.TargetUserShares, related...)
+		o.R.WebauthnCeremonies = append(o.R.WebauthnCeremonies, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &shareR{
-				TargetUser: o,
+			rel.R = &webauthnCeremonyR{
+				User: o,
 			}
 		} else {
-			rel.R.TargetUser = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// AddOwnerThings adds the given related objects to the existing relationships
+// SetWebauthnCeremonies removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's WebauthnCeremonies accordingly.
+// Replaces o.R.WebauthnCeremonies with related.
+// Sets related.R.User's WebauthnCeremonies accordingly.
+func (o *User) SetWebauthnCeremonies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnCeremony) error {
+	query := "update \"webauthn_ceremonies\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.WebauthnCeremonies {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.WebauthnCeremonies = nil
+	}
+
+	return o.AddWebauthnCeremonies(ctx, exec, insert, related...)
+}
+
+// RemoveWebauthnCeremonies relationships from objects passed in.
+// Removes related items from R.WebauthnCeremonies (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveWebauthnCeremonies(ctx context.Context, exec boil.ContextExecutor, related ...*WebauthnCeremony) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.WebauthnCeremonies {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.WebauthnCeremonies)
+			if ln > 1 && i < ln-1 {
+				o.R.WebauthnCeremonies[i] = o.R.WebauthnCeremonies[ln-1]
+			}
+			o.R.WebauthnCeremonies = o.R.WebauthnCeremonies[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddOwnerWebhooks adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.OwnerThings.
+// Appends related to o.R.OwnerWebhooks.
 // Sets related.R.Owner appropriately.
-func (o *User) AddOwnerThings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Thing) error {
+func (o *User) AddOwnerWebhooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Webhook) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -3164,9 +6967,9 @@ func (o *User) AddOwnerThings(ctx context.Context, exec boil.ContextExecutor, in
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"things\" SET %s WHERE %s",
+				"UPDATE \"webhooks\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-				strmangle.WhereClause("\"", "\"", 2, thingPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, webhookPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3185,15 +6988,15 @@ func (o *User) AddOwnerThings(ctx context.Context, exec boil.ContextExecutor, in
 
 	if o.R == nil {
 		o.R = &userR{
-			OwnerThings: related,
+			OwnerWebhooks: related,
 		}
 	} else {
-		o.R.OwnerThings = append(o.R.OwnerThings, related...)
+		o.R.OwnerWebhooks = append(o.R.OwnerWebhooks, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &thingR{
+			rel.R = &webhookR{
 				Owner: o,
 			}
 		} else {
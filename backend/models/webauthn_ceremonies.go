@@ -0,0 +1,1242 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WebauthnCeremony is an object representing the database table.
+type WebauthnCeremony struct {
+	ID          string               `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Kind        WebauthnCeremonyKind `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	UserID      null.String          `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	SessionData types.JSON           `boil:"session_data" json:"session_data" toml:"session_data" yaml:"session_data"`
+	ExpiresAt   time.Time            `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt   time.Time            `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *webauthnCeremonyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webauthnCeremonyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebauthnCeremonyColumns = struct {
+	ID          string
+	Kind        string
+	UserID      string
+	SessionData string
+	ExpiresAt   string
+	CreatedAt   string
+}{
+	ID:          "id",
+	Kind:        "kind",
+	UserID:      "user_id",
+	SessionData: "session_data",
+	ExpiresAt:   "expires_at",
+	CreatedAt:   "created_at",
+}
+
+var WebauthnCeremonyTableColumns = struct {
+	ID          string
+	Kind        string
+	UserID      string
+	SessionData string
+	ExpiresAt   string
+	CreatedAt   string
+}{
+	ID:          "webauthn_ceremonies.id",
+	Kind:        "webauthn_ceremonies.kind",
+	UserID:      "webauthn_ceremonies.user_id",
+	SessionData: "webauthn_ceremonies.session_data",
+	ExpiresAt:   "webauthn_ceremonies.expires_at",
+	CreatedAt:   "webauthn_ceremonies.created_at",
+}
+
+// Generated where
+
+type whereHelperWebauthnCeremonyKind struct{ field string }
+
+func (w whereHelperWebauthnCeremonyKind) EQ(x WebauthnCeremonyKind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperWebauthnCeremonyKind) NEQ(x WebauthnCeremonyKind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperWebauthnCeremonyKind) LT(x WebauthnCeremonyKind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperWebauthnCeremonyKind) LTE(x WebauthnCeremonyKind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperWebauthnCeremonyKind) GT(x WebauthnCeremonyKind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperWebauthnCeremonyKind) GTE(x WebauthnCeremonyKind) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperWebauthnCeremonyKind) IN(slice []WebauthnCeremonyKind) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperWebauthnCeremonyKind) NIN(slice []WebauthnCeremonyKind) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var WebauthnCeremonyWhere = struct {
+	ID          whereHelperstring
+	Kind        whereHelperWebauthnCeremonyKind
+	UserID      whereHelpernull_String
+	SessionData whereHelpertypes_JSON
+	ExpiresAt   whereHelpertime_Time
+	CreatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "\"webauthn_ceremonies\".\"id\""},
+	Kind:        whereHelperWebauthnCeremonyKind{field: "\"webauthn_ceremonies\".\"kind\""},
+	UserID:      whereHelpernull_String{field: "\"webauthn_ceremonies\".\"user_id\""},
+	SessionData: whereHelpertypes_JSON{field: "\"webauthn_ceremonies\".\"session_data\""},
+	ExpiresAt:   whereHelpertime_Time{field: "\"webauthn_ceremonies\".\"expires_at\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"webauthn_ceremonies\".\"created_at\""},
+}
+
+// WebauthnCeremonyRels is where relationship names are stored.
+var WebauthnCeremonyRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// webauthnCeremonyR is where relationships are stored.
+type webauthnCeremonyR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*webauthnCeremonyR) NewStruct() *webauthnCeremonyR {
+	return &webauthnCeremonyR{}
+}
+
+func (o *WebauthnCeremony) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *webauthnCeremonyR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// webauthnCeremonyL is where Load methods for each relationship are stored.
+type webauthnCeremonyL struct{}
+
+var (
+	webauthnCeremonyAllColumns            = []string{"id", "kind", "user_id", "session_data", "expires_at", "created_at"}
+	webauthnCeremonyColumnsWithoutDefault = []string{"id", "kind", "session_data", "expires_at"}
+	webauthnCeremonyColumnsWithDefault    = []string{"user_id", "created_at"}
+	webauthnCeremonyPrimaryKeyColumns     = []string{"id"}
+	webauthnCeremonyGeneratedColumns      = []string{}
+)
+
+type (
+	// WebauthnCeremonySlice is an alias for a slice of pointers to WebauthnCeremony.
+	// This should almost always be used instead of []WebauthnCeremony.
+	WebauthnCeremonySlice []*WebauthnCeremony
+	// WebauthnCeremonyHook is the signature for custom WebauthnCeremony hook methods
+	WebauthnCeremonyHook func(context.Context, boil.ContextExecutor, *WebauthnCeremony) error
+
+	webauthnCeremonyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webauthnCeremonyType                 = reflect.TypeOf(&WebauthnCeremony{})
+	webauthnCeremonyMapping              = queries.MakeStructMapping(webauthnCeremonyType)
+	webauthnCeremonyPrimaryKeyMapping, _ = queries.BindMapping(webauthnCeremonyType, webauthnCeremonyMapping, webauthnCeremonyPrimaryKeyColumns)
+	webauthnCeremonyInsertCacheMut       sync.RWMutex
+	webauthnCeremonyInsertCache          = make(map[string]insertCache)
+	webauthnCeremonyUpdateCacheMut       sync.RWMutex
+	webauthnCeremonyUpdateCache          = make(map[string]updateCache)
+	webauthnCeremonyUpsertCacheMut       sync.RWMutex
+	webauthnCeremonyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var webauthnCeremonyAfterSelectMu sync.Mutex
+var webauthnCeremonyAfterSelectHooks []WebauthnCeremonyHook
+
+var webauthnCeremonyBeforeInsertMu sync.Mutex
+var webauthnCeremonyBeforeInsertHooks []WebauthnCeremonyHook
+var webauthnCeremonyAfterInsertMu sync.Mutex
+var webauthnCeremonyAfterInsertHooks []WebauthnCeremonyHook
+
+var webauthnCeremonyBeforeUpdateMu sync.Mutex
+var webauthnCeremonyBeforeUpdateHooks []WebauthnCeremonyHook
+var webauthnCeremonyAfterUpdateMu sync.Mutex
+var webauthnCeremonyAfterUpdateHooks []WebauthnCeremonyHook
+
+var webauthnCeremonyBeforeDeleteMu sync.Mutex
+var webauthnCeremonyBeforeDeleteHooks []WebauthnCeremonyHook
+var webauthnCeremonyAfterDeleteMu sync.Mutex
+var webauthnCeremonyAfterDeleteHooks []WebauthnCeremonyHook
+
+var webauthnCeremonyBeforeUpsertMu sync.Mutex
+var webauthnCeremonyBeforeUpsertHooks []WebauthnCeremonyHook
+var webauthnCeremonyAfterUpsertMu sync.Mutex
+var webauthnCeremonyAfterUpsertHooks []WebauthnCeremonyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebauthnCeremony) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCeremonyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebauthnCeremony) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCeremonyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebauthnCeremony) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCeremonyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebauthnCeremony) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCeremonyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebauthnCeremony) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCeremonyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebauthnCeremony) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCeremonyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebauthnCeremony) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCeremonyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebauthnCeremony) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCeremonyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebauthnCeremony) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range webauthnCeremonyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebauthnCeremonyHook registers your hook function for all future operations.
+func AddWebauthnCeremonyHook(hookPoint boil.HookPoint, webauthnCeremonyHook WebauthnCeremonyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		webauthnCeremonyAfterSelectMu.Lock()
+		webauthnCeremonyAfterSelectHooks = append(webauthnCeremonyAfterSelectHooks, webauthnCeremonyHook)
+		webauthnCeremonyAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		webauthnCeremonyBeforeInsertMu.Lock()
+		webauthnCeremonyBeforeInsertHooks = append(webauthnCeremonyBeforeInsertHooks, webauthnCeremonyHook)
+		webauthnCeremonyBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		webauthnCeremonyAfterInsertMu.Lock()
+		webauthnCeremonyAfterInsertHooks = append(webauthnCeremonyAfterInsertHooks, webauthnCeremonyHook)
+		webauthnCeremonyAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		webauthnCeremonyBeforeUpdateMu.Lock()
+		webauthnCeremonyBeforeUpdateHooks = append(webauthnCeremonyBeforeUpdateHooks, webauthnCeremonyHook)
+		webauthnCeremonyBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		webauthnCeremonyAfterUpdateMu.Lock()
+		webauthnCeremonyAfterUpdateHooks = append(webauthnCeremonyAfterUpdateHooks, webauthnCeremonyHook)
+		webauthnCeremonyAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		webauthnCeremonyBeforeDeleteMu.Lock()
+		webauthnCeremonyBeforeDeleteHooks = append(webauthnCeremonyBeforeDeleteHooks, webauthnCeremonyHook)
+		webauthnCeremonyBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		webauthnCeremonyAfterDeleteMu.Lock()
+		webauthnCeremonyAfterDeleteHooks = append(webauthnCeremonyAfterDeleteHooks, webauthnCeremonyHook)
+		webauthnCeremonyAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		webauthnCeremonyBeforeUpsertMu.Lock()
+		webauthnCeremonyBeforeUpsertHooks = append(webauthnCeremonyBeforeUpsertHooks, webauthnCeremonyHook)
+		webauthnCeremonyBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		webauthnCeremonyAfterUpsertMu.Lock()
+		webauthnCeremonyAfterUpsertHooks = append(webauthnCeremonyAfterUpsertHooks, webauthnCeremonyHook)
+		webauthnCeremonyAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single webauthnCeremony record from the query.
+func (q webauthnCeremonyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WebauthnCeremony, error) {
+	o := &WebauthnCeremony{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for webauthn_ceremonies")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebauthnCeremony records from the query.
+func (q webauthnCeremonyQuery) All(ctx context.Context, exec boil.ContextExecutor) (WebauthnCeremonySlice, error) {
+	var o []*WebauthnCeremony
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to WebauthnCeremony slice")
+	}
+
+	if len(webauthnCeremonyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebauthnCeremony records in the query.
+func (q webauthnCeremonyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count webauthn_ceremonies rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q webauthnCeremonyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if webauthn_ceremonies exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *WebauthnCeremony) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (webauthnCeremonyL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWebauthnCeremony interface{}, mods queries.Applicator) error {
+	var slice []*WebauthnCeremony
+	var object *WebauthnCeremony
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebauthnCeremony.(*WebauthnCeremony)
+		if !ok {
+			object = new(WebauthnCeremony)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebauthnCeremony)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebauthnCeremony))
+			}
+		}
+	} else {
+		s, ok := maybeWebauthnCeremony.(*[]*WebauthnCeremony)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebauthnCeremony)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebauthnCeremony))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &webauthnCeremonyR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args[object.UserID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &webauthnCeremonyR{}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args[obj.UserID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.WebauthnCeremonies = append(foreign.R.WebauthnCeremonies, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.WebauthnCeremonies = append(foreign.R.WebauthnCeremonies, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the webauthnCeremony to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.WebauthnCeremonies.
+func (o *WebauthnCeremony) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"webauthn_ceremonies\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, webauthnCeremonyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &webauthnCeremonyR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			WebauthnCeremonies: WebauthnCeremonySlice{o},
+		}
+	} else {
+		related.R.WebauthnCeremonies = append(related.R.WebauthnCeremonies, o)
+	}
+
+	return nil
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *WebauthnCeremony) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.WebauthnCeremonies {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.WebauthnCeremonies)
+		if ln > 1 && i < ln-1 {
+			related.R.WebauthnCeremonies[i] = related.R.WebauthnCeremonies[ln-1]
+		}
+		related.R.WebauthnCeremonies = related.R.WebauthnCeremonies[:ln-1]
+		break
+	}
+	return nil
+}
+
+// WebauthnCeremonies retrieves all the records using an executor.
+func WebauthnCeremonies(mods ...qm.QueryMod) webauthnCeremonyQuery {
+	mods = append(mods, qm.From("\"webauthn_ceremonies\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webauthn_ceremonies\".*"})
+	}
+
+	return webauthnCeremonyQuery{q}
+}
+
+// FindWebauthnCeremony retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebauthnCeremony(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WebauthnCeremony, error) {
+	webauthnCeremonyObj := &WebauthnCeremony{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webauthn_ceremonies\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, webauthnCeremonyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from webauthn_ceremonies")
+	}
+
+	if err = webauthnCeremonyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return webauthnCeremonyObj, err
+	}
+
+	return webauthnCeremonyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebauthnCeremony) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no webauthn_ceremonies provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webauthnCeremonyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webauthnCeremonyInsertCacheMut.RLock()
+	cache, cached := webauthnCeremonyInsertCache[key]
+	webauthnCeremonyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webauthnCeremonyAllColumns,
+			webauthnCeremonyColumnsWithDefault,
+			webauthnCeremonyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webauthnCeremonyType, webauthnCeremonyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webauthnCeremonyType, webauthnCeremonyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webauthn_ceremonies\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webauthn_ceremonies\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into webauthn_ceremonies")
+	}
+
+	if !cached {
+		webauthnCeremonyInsertCacheMut.Lock()
+		webauthnCeremonyInsertCache[key] = cache
+		webauthnCeremonyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WebauthnCeremony.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebauthnCeremony) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	webauthnCeremonyUpdateCacheMut.RLock()
+	cache, cached := webauthnCeremonyUpdateCache[key]
+	webauthnCeremonyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webauthnCeremonyAllColumns,
+			webauthnCeremonyPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update webauthn_ceremonies, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webauthn_ceremonies\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webauthnCeremonyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webauthnCeremonyType, webauthnCeremonyMapping, append(wl, webauthnCeremonyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update webauthn_ceremonies row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for webauthn_ceremonies")
+	}
+
+	if !cached {
+		webauthnCeremonyUpdateCacheMut.Lock()
+		webauthnCeremonyUpdateCache[key] = cache
+		webauthnCeremonyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webauthnCeremonyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for webauthn_ceremonies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for webauthn_ceremonies")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebauthnCeremonySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCeremonyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webauthn_ceremonies\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webauthnCeremonyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in webauthnCeremony slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all webauthnCeremony")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebauthnCeremony) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("models: no webauthn_ceremonies provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webauthnCeremonyColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webauthnCeremonyUpsertCacheMut.RLock()
+	cache, cached := webauthnCeremonyUpsertCache[key]
+	webauthnCeremonyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			webauthnCeremonyAllColumns,
+			webauthnCeremonyColumnsWithDefault,
+			webauthnCeremonyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webauthnCeremonyAllColumns,
+			webauthnCeremonyPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert webauthn_ceremonies, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(webauthnCeremonyAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(webauthnCeremonyPrimaryKeyColumns) == 0 {
+				return errors.New("models: unable to upsert webauthn_ceremonies, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(webauthnCeremonyPrimaryKeyColumns))
+			copy(conflict, webauthnCeremonyPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"webauthn_ceremonies\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(webauthnCeremonyType, webauthnCeremonyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webauthnCeremonyType, webauthnCeremonyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert webauthn_ceremonies")
+	}
+
+	if !cached {
+		webauthnCeremonyUpsertCacheMut.Lock()
+		webauthnCeremonyUpsertCache[key] = cache
+		webauthnCeremonyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WebauthnCeremony record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebauthnCeremony) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no WebauthnCeremony provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webauthnCeremonyPrimaryKeyMapping)
+	sql := "DELETE FROM \"webauthn_ceremonies\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from webauthn_ceremonies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for webauthn_ceremonies")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q webauthnCeremonyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no webauthnCeremonyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webauthn_ceremonies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webauthn_ceremonies")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebauthnCeremonySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(webauthnCeremonyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCeremonyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"webauthn_ceremonies\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webauthnCeremonyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from webauthnCeremony slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for webauthn_ceremonies")
+	}
+
+	if len(webauthnCeremonyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebauthnCeremony) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWebauthnCeremony(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebauthnCeremonySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebauthnCeremonySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webauthnCeremonyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webauthn_ceremonies\".* FROM \"webauthn_ceremonies\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webauthnCeremonyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in WebauthnCeremonySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebauthnCeremonyExists checks if the WebauthnCeremony row exists.
+func WebauthnCeremonyExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webauthn_ceremonies\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if webauthn_ceremonies exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WebauthnCeremony row exists.
+func (o *WebauthnCeremony) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WebauthnCeremonyExists(ctx, exec, o.ID)
+}
@@ -1,13 +1,34 @@
 package notifications
 
+import "time"
+
 const (
 	NotifyFriendRequestSent     = "FRIEND_REQUEST"
 	NotifyThingShared           = "THING_SHARED"
 	NotifyListShared            = "LIST_SHARED"
 	NotifyFriendRequestReaction = "FRIEND_REQUEST_REACTION"
 	NotifyThingsAddedToList     = "THINGS_ADDED_TO_LIST"
+	NotifyBorrowRequested       = "BORROW_REQUESTED"
+	NotifyBorrowRequestReaction = "BORROW_REQUEST_REACTION"
+	NotifyLoanReturned          = "LOAN_RETURNED"
+	NotifyLoanOverdue           = "LOAN_OVERDUE"
+	NotifyLowStock              = "LOW_STOCK"
 )
 
+// ContentTypes lists all content types users can set preferences for
+var ContentTypes = []string{
+	NotifyFriendRequestSent,
+	NotifyFriendRequestReaction,
+	NotifyThingShared,
+	NotifyListShared,
+	NotifyThingsAddedToList,
+	NotifyBorrowRequested,
+	NotifyBorrowRequestReaction,
+	NotifyLoanReturned,
+	NotifyLoanOverdue,
+	NotifyLowStock,
+}
+
 type StashsphereNotification interface {
 	ContentType() string
 }
@@ -57,3 +78,52 @@ type ThingsAddedToList struct {
 func (n ThingsAddedToList) ContentType() string {
 	return NotifyThingsAddedToList
 }
+
+type BorrowRequested struct {
+	RequestIds []string `json:"requestIds"`
+	BorrowerId string   `json:"borrowerId"`
+}
+
+func (n BorrowRequested) ContentType() string {
+	return NotifyBorrowRequested
+}
+
+type BorrowRequestReaction struct {
+	RequestId string  `json:"requestId"`
+	ThingId   string  `json:"thingId"`
+	Accepted  bool    `json:"accepted"`
+	LoanId    *string `json:"loanId"`
+}
+
+func (n BorrowRequestReaction) ContentType() string {
+	return NotifyBorrowRequestReaction
+}
+
+type LoanReturned struct {
+	LoanId  string `json:"loanId"`
+	ThingId string `json:"thingId"`
+}
+
+func (n LoanReturned) ContentType() string {
+	return NotifyLoanReturned
+}
+
+type LoanOverdue struct {
+	LoanId  string    `json:"loanId"`
+	ThingId string    `json:"thingId"`
+	DueAt   time.Time `json:"dueAt"`
+}
+
+func (n LoanOverdue) ContentType() string {
+	return NotifyLoanOverdue
+}
+
+type LowStock struct {
+	ThingId   string `json:"thingId"`
+	Quantity  int64  `json:"quantity"`
+	Threshold int64  `json:"threshold"`
+}
+
+func (n LowStock) ContentType() string {
+	return NotifyLowStock
+}
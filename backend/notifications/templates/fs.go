@@ -1,6 +1,36 @@
 package templates
 
-import "embed"
+import (
+	"embed"
+	"html/template"
+	"io/fs"
+	"path"
+	texttemplate "text/template"
+)
 
-//go:embed *.txt
+// English templates live at the top level, translations in a directory named
+// after their locale. Every email has a subject, a plain text body and an HTML
+// body, which is embedded into layout.html.
+//
+//go:embed *.txt *.html de/*.txt de/*.html fr/*.txt fr/*.html
 var FS embed.FS
+
+// localizedName returns the path of the template in the given locale.
+// Templates without a translation fall back to English.
+func localizedName(locale string, name string) string {
+	localized := path.Join(locale, name)
+	if _, err := fs.Stat(FS, localized); err == nil {
+		return localized
+	}
+	return name
+}
+
+// ParseText parses a subject or plain text body template
+func ParseText(locale string, name string) (*texttemplate.Template, error) {
+	return texttemplate.ParseFS(FS, localizedName(locale, name))
+}
+
+// ParseHTML parses an HTML body template, escaping the data it is executed with
+func ParseHTML(locale string, name string) (*template.Template, error) {
+	return template.ParseFS(FS, localizedName(locale, name))
+}
@@ -0,0 +1,171 @@
+package operations
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/stashsphere/backend/models"
+)
+
+// ApiTokenPrefix marks personal access tokens in the Authorization header
+const ApiTokenPrefix = "ssp_"
+
+// characters of the token stored to tell tokens apart, including the prefix
+const apiTokenDisplayLength = len(ApiTokenPrefix) + 6
+
+// last used timestamps are only written if older, to avoid a write per request
+const apiTokenLastUsedResolution = time.Minute
+
+const (
+	// allows all reading requests
+	ApiTokenScopeRead               = "read"
+	ApiTokenScopeThingsWrite        = "things:write"
+	ApiTokenScopeListsWrite         = "lists:write"
+	ApiTokenScopeImagesWrite        = "images:write"
+	ApiTokenScopeSharesWrite        = "shares:write"
+	ApiTokenScopeLocationsWrite     = "locations:write"
+	ApiTokenScopeFriendsWrite       = "friends:write"
+	ApiTokenScopeLendingWrite       = "lending:write"
+	ApiTokenScopeNotificationsWrite = "notifications:write"
+	ApiTokenScopeWebhooksWrite      = "webhooks:write"
+)
+
+var ApiTokenScopes = []string{
+	ApiTokenScopeRead,
+	ApiTokenScopeThingsWrite,
+	ApiTokenScopeListsWrite,
+	ApiTokenScopeImagesWrite,
+	ApiTokenScopeSharesWrite,
+	ApiTokenScopeLocationsWrite,
+	ApiTokenScopeFriendsWrite,
+	ApiTokenScopeLendingWrite,
+	ApiTokenScopeNotificationsWrite,
+	ApiTokenScopeWebhooksWrite,
+}
+
+// scopes required for changing requests by route prefix. Changing requests to
+// other routes, like the account settings or the token management, are not
+// allowed with tokens at all.
+var apiTokenWriteScopes = []struct {
+	prefix string
+	scope  string
+}{
+	{"/api/things", ApiTokenScopeThingsWrite},
+	{"/api/labels", ApiTokenScopeThingsWrite},
+	{"/api/lists", ApiTokenScopeListsWrite},
+	{"/api/images", ApiTokenScopeImagesWrite},
+	{"/api/shares", ApiTokenScopeSharesWrite},
+	{"/api/locations", ApiTokenScopeLocationsWrite},
+	{"/api/friends", ApiTokenScopeFriendsWrite},
+	{"/api/friend_requests", ApiTokenScopeFriendsWrite},
+	{"/api/cart", ApiTokenScopeLendingWrite},
+	{"/api/borrow_requests", ApiTokenScopeLendingWrite},
+	{"/api/loans", ApiTokenScopeLendingWrite},
+	{"/api/notifications", ApiTokenScopeNotificationsWrite},
+	{"/api/user/notification-preferences", ApiTokenScopeNotificationsWrite},
+	{"/api/webhooks", ApiTokenScopeWebhooksWrite},
+}
+
+func IsApiTokenScope(scope string) bool {
+	return slices.Contains(ApiTokenScopes, scope)
+}
+
+// RequiredApiTokenScope returns the scope a token needs for the request.
+// It returns false if the request is not allowed with tokens.
+func RequiredApiTokenScope(method string, path string) (string, bool) {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ApiTokenScopeRead, true
+	}
+	for _, route := range apiTokenWriteScopes {
+		if path == route.prefix || strings.HasPrefix(path, route.prefix+"/") {
+			return route.scope, true
+		}
+	}
+	return "", false
+}
+
+// GenerateApiToken returns a new token and the hash under which it is stored
+func GenerateApiToken() (string, string, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", "", err
+	}
+	token := ApiTokenPrefix + hex.EncodeToString(secret)
+	return token, HashApiToken(token), nil
+}
+
+func HashApiToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+type CreateApiTokenParams struct {
+	OwnerId   string
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
+}
+
+// CreateApiToken stores a new token and returns it together with the token
+// value, which can not be retrieved later
+func CreateApiToken(ctx context.Context, exec boil.ContextExecutor, id string, params CreateApiTokenParams) (*models.APIToken, string, error) {
+	token, hash, err := GenerateApiToken()
+	if err != nil {
+		return nil, "", err
+	}
+	apiToken := models.APIToken{
+		ID:          id,
+		OwnerID:     params.OwnerId,
+		Name:        params.Name,
+		TokenHash:   hash,
+		TokenPrefix: token[:apiTokenDisplayLength],
+		Scopes:      params.Scopes,
+		ExpiresAt:   null.TimeFromPtr(params.ExpiresAt),
+	}
+	err = apiToken.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return nil, "", err
+	}
+	return &apiToken, token, nil
+}
+
+// FindValidApiToken returns the unexpired token with the given value and
+// loads its owner
+func FindValidApiToken(ctx context.Context, exec boil.ContextExecutor, token string, now time.Time) (*models.APIToken, error) {
+	return models.APITokens(
+		models.APITokenWhere.TokenHash.EQ(HashApiToken(token)),
+		qm.Expr(
+			models.APITokenWhere.ExpiresAt.IsNull(),
+			qm.Or2(models.APITokenWhere.ExpiresAt.GT(null.TimeFrom(now))),
+		),
+		qm.Load(models.APITokenRels.Owner),
+	).One(ctx, exec)
+}
+
+// TouchApiToken records the use of the token
+func TouchApiToken(ctx context.Context, exec boil.ContextExecutor, apiToken *models.APIToken, now time.Time) error {
+	if apiToken.LastUsedAt.Valid && now.Sub(apiToken.LastUsedAt.Time) < apiTokenLastUsedResolution {
+		return nil
+	}
+	apiToken.LastUsedAt = null.TimeFrom(now)
+	_, err := models.APITokens(models.APITokenWhere.ID.EQ(apiToken.ID)).UpdateAll(ctx, exec, models.M{
+		models.APITokenColumns.LastUsedAt: now,
+	})
+	return err
+}
+
+// DeleteApiTokensForUser revokes all tokens of the user
+func DeleteApiTokensForUser(ctx context.Context, exec boil.ContextExecutor, ownerId string) (int64, error) {
+	return models.APITokens(models.APITokenWhere.OwnerID.EQ(ownerId)).DeleteAll(ctx, exec)
+}
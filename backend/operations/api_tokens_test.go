@@ -0,0 +1,47 @@
+package operations_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stashsphere/backend/operations"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequiredApiTokenScope(t *testing.T) {
+	cases := []struct {
+		method  string
+		path    string
+		scope   string
+		allowed bool
+	}{
+		{http.MethodGet, "/api/things/:thingId", operations.ApiTokenScopeRead, true},
+		{http.MethodGet, "/api/user/profile", operations.ApiTokenScopeRead, true},
+		{http.MethodPost, "/api/things", operations.ApiTokenScopeThingsWrite, true},
+		{http.MethodPatch, "/api/things/:thingId", operations.ApiTokenScopeThingsWrite, true},
+		{http.MethodDelete, "/api/labels/:labelId", operations.ApiTokenScopeThingsWrite, true},
+		{http.MethodPost, "/api/images", operations.ApiTokenScopeImagesWrite, true},
+		{http.MethodPost, "/api/loans/:loanId/return", operations.ApiTokenScopeLendingWrite, true},
+		{http.MethodPatch, "/api/user/notification-preferences", operations.ApiTokenScopeNotificationsWrite, true},
+		{http.MethodPost, "/api/thingsandmore", "", false},
+		{http.MethodPost, "/api/user/api-tokens", "", false},
+		{http.MethodPatch, "/api/user/password", "", false},
+	}
+	for _, c := range cases {
+		scope, allowed := operations.RequiredApiTokenScope(c.method, c.path)
+		assert.Equal(t, c.allowed, allowed, c.method+" "+c.path)
+		assert.Equal(t, c.scope, scope, c.method+" "+c.path)
+	}
+}
+
+func TestGenerateApiToken(t *testing.T) {
+	token, hash, err := operations.GenerateApiToken()
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(token, operations.ApiTokenPrefix))
+	assert.Equal(t, operations.HashApiToken(token), hash)
+
+	other, _, err := operations.GenerateApiToken()
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
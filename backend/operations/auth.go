@@ -50,14 +50,17 @@ type AccessClaims struct {
 	UserId string `json:"userId"`
 	Email  string `json:"email"`
 	Name   string `json:"name"`
+	// session the token was issued for
+	SessionId string `json:"sessionId,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func CreateJWTAccessTokenForUser(user *models.User, privateKey ed25519.PrivateKey, issuedAt time.Time, lifetime time.Duration) (string, string, error) {
+func CreateJWTAccessTokenForUser(user *models.User, sessionId string, privateKey ed25519.PrivateKey, issuedAt time.Time, lifetime time.Duration) (string, string, error) {
 	claims := AccessClaims{
-		UserId: user.ID,
-		Email:  user.Email,
-		Name:   user.Name,
+		UserId:    user.ID,
+		Email:     user.Email,
+		Name:      user.Name,
+		SessionId: sessionId,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(lifetime)),
 			IssuedAt:  jwt.NewNumericDate(issuedAt),
@@ -82,15 +85,20 @@ func CreateJWTAccessTokenForUser(user *models.User, privateKey ed25519.PrivateKe
 }
 
 type RefreshClaims struct {
-	UserId string `json:"userId"`
+	UserId    string `json:"userId"`
+	SessionId string `json:"sessionId"`
 	jwt.RegisteredClaims
 }
 
-func CreateJWTRefreshTokenForUser(user *models.User, privateKey ed25519.PrivateKey, issuedAt time.Time, lifetime time.Duration) (string, string, error) {
+// CreateJWTRefreshTokenForUser creates a refresh token for the session. The
+// token id must match the current refresh token id of the session.
+func CreateJWTRefreshTokenForUser(user *models.User, session *models.Session, privateKey ed25519.PrivateKey, issuedAt time.Time) (string, string, error) {
 	claims := RefreshClaims{
-		UserId: user.ID,
+		UserId:    user.ID,
+		SessionId: session.ID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(lifetime)),
+			ID:        session.RefreshTokenID,
+			ExpiresAt: jwt.NewNumericDate(session.ExpiresAt),
 			IssuedAt:  jwt.NewNumericDate(issuedAt),
 			NotBefore: jwt.NewNumericDate(issuedAt),
 			Issuer:    "inventory",
@@ -112,6 +120,40 @@ func CreateJWTRefreshTokenForUser(user *models.User, privateKey ed25519.PrivateK
 	return refreshToken, infoToken, err
 }
 
+// ChallengeClaims identify a user who logged in with their password and still
+// has to provide a second factor
+type ChallengeClaims struct {
+	UserId string `json:"userId"`
+	jwt.RegisteredClaims
+}
+
+const challengeSubject = "2fa-challenge"
+
+func CreateJWTChallengeTokenForUser(user *models.User, privateKey ed25519.PrivateKey, issuedAt time.Time, lifetime time.Duration) (string, error) {
+	claims := ChallengeClaims{
+		UserId: user.ID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(lifetime)),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			NotBefore: jwt.NewNumericDate(issuedAt),
+			Issuer:    "inventory",
+			Subject:   challengeSubject,
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims).SignedString(privateKey)
+}
+
+// ParseJWTChallengeToken returns the claims of a valid challenge token
+func ParseJWTChallengeToken(value string, publicKey ed25519.PublicKey) (*ChallengeClaims, error) {
+	token, err := jwt.ParseWithClaims(value, &ChallengeClaims{}, func(t *jwt.Token) (interface{}, error) {
+		return publicKey, nil
+	}, jwt.WithValidMethods([]string{"EdDSA"}), jwt.WithSubject(challengeSubject))
+	if err != nil {
+		return nil, err
+	}
+	return token.Claims.(*ChallengeClaims), nil
+}
+
 func HashPassword(password string) ([]byte, error) {
 	return bcrypt.GenerateFromPassword([]byte(password), 12)
 }
@@ -34,18 +34,24 @@ func SetInfoTokenCookie(c echo.Context, domain string, infoToken string, maxAge
 	c.SetCookie(&cookie)
 }
 
+// the refresh token is only sent to the endpoints which need it, the logout
+// revokes its session even if the access token has expired
+var refreshTokenCookiePaths = []string{"/api/user/refresh", "/api/user/logout"}
+
 func SetRefreshTokenCookie(c echo.Context, domain string, refreshToken string, maxAge int, secure bool) {
-	cookie := http.Cookie{
-		Name:     "stashsphere-refresh",
-		Value:    refreshToken,
-		Path:     "/api/user/refresh",
-		Domain:   domain,
-		Secure:   secure,
-		MaxAge:   maxAge,
-		HttpOnly: true,
-		SameSite: http.SameSiteStrictMode,
+	for _, path := range refreshTokenCookiePaths {
+		cookie := http.Cookie{
+			Name:     "stashsphere-refresh",
+			Value:    refreshToken,
+			Path:     path,
+			Domain:   domain,
+			Secure:   secure,
+			MaxAge:   maxAge,
+			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
+		}
+		c.SetCookie(&cookie)
 	}
-	c.SetCookie(&cookie)
 }
 
 func SetRefreshIntoTokenCookie(c echo.Context, domain string, refreshToken string, maxAge int, secure bool) {
@@ -63,3 +69,20 @@ func SetRefreshIntoTokenCookie(c echo.Context, domain string, refreshToken strin
 	}
 	c.SetCookie(&cookie)
 }
+
+// SetOidcStateCookie binds a single sign-on login to the browser which started
+// it. It is sent along with the redirect of the provider, which is a cross-site
+// navigation, so it can not be strict.
+func SetOidcStateCookie(c echo.Context, domain string, state string, maxAge int, secure bool) {
+	cookie := http.Cookie{
+		Name:     "stashsphere-oidc-state",
+		Value:    state,
+		Path:     "/api/user/oidc",
+		Domain:   domain,
+		Secure:   secure,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	c.SetCookie(&cookie)
+}
@@ -0,0 +1,110 @@
+package operations
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Cursor points behind the last row of a page. It stores the values of the
+// sort keys of that row, so it stays valid when the row is deleted.
+type Cursor struct {
+	Order  string    `json:"o"`
+	Values []*string `json:"v"`
+	Id     string    `json:"i"`
+}
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// EncodeCursor returns the opaque representation handed out to clients
+func EncodeCursor(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a cursor and checks that it was created for the order
+func DecodeCursor(encoded string, order SortOrder) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor Cursor
+	err = json.Unmarshal(data, &cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Order != order.Name || len(cursor.Values) != len(order.Keys) || cursor.Id == "" {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
+
+// CursorAt creates the cursor pointing behind the row with the given id
+func (o SortOrder) CursorAt(ctx context.Context, exec boil.ContextExecutor, id string) (string, error) {
+	columns := make([]string, len(o.Keys))
+	for i, key := range o.Keys {
+		columns[i] = fmt.Sprintf("(%s)::text", key.Expr)
+	}
+	values := make([]sql.NullString, len(o.Keys))
+	dest := make([]interface{}, len(o.Keys))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if len(o.Keys) > 0 {
+		row := exec.QueryRowContext(ctx,
+			fmt.Sprintf("SELECT %s FROM %s WHERE %s.id = $1", strings.Join(columns, ", "), o.Table, o.Table),
+			id,
+		)
+		err := row.Scan(dest...)
+		if err != nil {
+			return "", err
+		}
+	}
+	cursor := Cursor{Order: o.Name, Values: make([]*string, len(values)), Id: id}
+	for i, value := range values {
+		if value.Valid {
+			cursor.Values[i] = &value.String
+		}
+	}
+	return EncodeCursor(cursor), nil
+}
+
+// AfterCursor restricts the query to the rows following the cursor. Rows are
+// compared key by key, a row follows the cursor if all previous keys are
+// equal and the current key is sorted behind the cursor value.
+func (o SortOrder) AfterCursor(cursor *Cursor) qm.QueryMod {
+	operator := ">"
+	if o.Desc {
+		operator = "<"
+	}
+	terms := []string{}
+	args := []interface{}{}
+	equalClauses := []string{}
+	equalArgs := []interface{}{}
+	for i, key := range o.Keys {
+		value := cursor.Values[i]
+		if value == nil {
+			// nulls are sorted last, nothing but other nulls can follow
+			equalClauses = append(equalClauses, fmt.Sprintf("%s IS NULL", key.Expr))
+			continue
+		}
+		clauses := append(append([]string{}, equalClauses...),
+			fmt.Sprintf("(%s IS NULL OR %s %s ?::%s)", key.Expr, key.Expr, operator, key.Type))
+		terms = append(terms, "("+strings.Join(clauses, " AND ")+")")
+		args = append(append(args, equalArgs...), *value)
+
+		equalClauses = append(equalClauses, fmt.Sprintf("%s = ?::%s", key.Expr, key.Type))
+		equalArgs = append(equalArgs, *value)
+	}
+	clauses := append(equalClauses, fmt.Sprintf("%s.id %s ?", o.Table, operator))
+	terms = append(terms, "("+strings.Join(clauses, " AND ")+")")
+	args = append(append(args, equalArgs...), cursor.Id)
+	return qm.And("("+strings.Join(terms, " OR ")+")", args...)
+}
@@ -0,0 +1,28 @@
+package operations_test
+
+import (
+	"testing"
+
+	"github.com/stashsphere/backend/operations"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	order, err := operations.ThingSortOrder(operations.SortParams{Sort: operations.SortByName})
+	assert.NoError(t, err)
+	name := "anvil"
+	encoded := operations.EncodeCursor(operations.Cursor{Order: order.Name, Values: []*string{&name}, Id: "abc"})
+
+	cursor, err := operations.DecodeCursor(encoded, order)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", cursor.Id)
+	assert.Equal(t, "anvil", *cursor.Values[0])
+
+	other, err := operations.ThingSortOrder(operations.SortParams{Sort: operations.SortByProperty, Property: "weight"})
+	assert.NoError(t, err)
+	_, err = operations.DecodeCursor(encoded, other)
+	assert.ErrorIs(t, err, operations.ErrInvalidCursor)
+
+	_, err = operations.DecodeCursor("not a cursor", order)
+	assert.ErrorIs(t, err, operations.ErrInvalidCursor)
+}
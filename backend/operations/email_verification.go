@@ -11,6 +11,7 @@ import (
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/stashsphere/backend/models"
 	"github.com/stashsphere/backend/utils"
 )
@@ -94,8 +95,25 @@ func VerifyCode(ctx context.Context, exec boil.ContextExecutor, userId string, e
 	return err
 }
 
+// MarkEmailVerified records the address as verified without a code, for
+// addresses another party has already verified
+func MarkEmailVerified(ctx context.Context, exec boil.ContextExecutor, userId string, email string, now time.Time) error {
+	verification := models.EmailVerification{
+		UserID:     userId,
+		Email:      email,
+		VerifiedAt: null.TimeFrom(now),
+	}
+	return verification.Upsert(ctx, exec, true, []string{
+		models.EmailVerificationColumns.UserID,
+		models.EmailVerificationColumns.Email,
+	}, boil.Whitelist(models.EmailVerificationColumns.VerifiedAt), boil.Infer())
+}
+
+// GetEmailVerificationStatus returns the verification of the current email
+// address of the user, addresses of pending email changes are ignored
 func GetEmailVerificationStatus(ctx context.Context, exec boil.ContextExecutor, userId string) (*models.EmailVerification, error) {
 	verification, err := models.EmailVerifications(
+		qm.InnerJoin("users ON users.id = email_verifications.user_id AND users.email = email_verifications.email"),
 		models.EmailVerificationWhere.UserID.EQ(userId),
 	).One(ctx, exec)
 	if err != nil {
@@ -107,6 +125,23 @@ func GetEmailVerificationStatus(ctx context.Context, exec boil.ContextExecutor,
 	return verification, nil
 }
 
+// DeleteEmailVerificationsExcept removes the verifications and codes of all
+// addresses of the user but the given one
+func DeleteEmailVerificationsExcept(ctx context.Context, exec boil.ContextExecutor, userId string, email string) error {
+	_, err := models.EmailVerificationCodes(
+		models.EmailVerificationCodeWhere.UserID.EQ(userId),
+		models.EmailVerificationCodeWhere.Email.NEQ(email),
+	).DeleteAll(ctx, exec)
+	if err != nil {
+		return err
+	}
+	_, err = models.EmailVerifications(
+		models.EmailVerificationWhere.UserID.EQ(userId),
+		models.EmailVerificationWhere.Email.NEQ(email),
+	).DeleteAll(ctx, exec)
+	return err
+}
+
 func PurgeExpiredVerificationCodes(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
 	return models.EmailVerificationCodes(
 		models.EmailVerificationCodeWhere.ValidUntil.LT(time.Now().UTC().Add(-24*time.Hour)),
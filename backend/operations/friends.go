@@ -2,9 +2,14 @@ package operations
 
 import (
 	"context"
+	"time"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/utils"
 )
 
 func GetFriendIds(ctx context.Context, exec boil.ContextExecutor, userId string) ([]string, error) {
@@ -27,3 +32,49 @@ func GetFriendIds(ctx context.Context, exec boil.ContextExecutor, userId string)
 	}
 	return friendIds, nil
 }
+
+// CreateFriendRequest inserts a pending friend request unless there already is
+// one or the users are friends
+func CreateFriendRequest(ctx context.Context, exec boil.ContextExecutor, senderId string, receiverId string) (*models.FriendRequest, error) {
+	pendingFriendRequests, err := models.FriendRequests(models.FriendRequestWhere.State.EQ(models.FriendRequestStatePending), models.FriendRequestWhere.SenderID.EQ(senderId), models.FriendRequestWhere.ReceiverID.EQ(receiverId)).Count(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	if pendingFriendRequests > 0 {
+		return nil, utils.PendingFriendRequestExistsError{}
+	}
+
+	existingFriendShip, err := models.Friendships(
+		qm.Expr(
+			qm.Expr(models.FriendshipWhere.Friend1ID.EQ(senderId), (models.FriendshipWhere.Friend2ID.EQ(receiverId))),
+			qm.Or2(qm.Expr(models.FriendshipWhere.Friend2ID.EQ(senderId), models.FriendshipWhere.Friend1ID.EQ(receiverId))))).Count(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	if existingFriendShip > 0 {
+		return nil, utils.FriendShipExistsError{}
+	}
+	requestId, err := gonanoid.New()
+	if err != nil {
+		return nil, err
+	}
+	request := models.FriendRequest{
+		ID:         requestId,
+		SenderID:   senderId,
+		ReceiverID: receiverId,
+		CreatedAt:  time.Now(),
+	}
+	err = request.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	err = EnqueueWebhookEvent(ctx, exec, request.ReceiverID, WebhookEventFriendRequestCreated, WebhookFriendRequestData{
+		RequestId:  request.ID,
+		SenderId:   request.SenderID,
+		ReceiverId: request.ReceiverID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
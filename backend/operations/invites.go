@@ -0,0 +1,85 @@
+package operations
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/stashsphere/backend/models"
+)
+
+type CreateInviteParams struct {
+	// empty for invites of administrators
+	OwnerId string
+	MaxUses int
+	// only this address can register with the invite if not empty
+	Email     string
+	ExpiresAt *time.Time
+}
+
+func CreateInvite(ctx context.Context, exec boil.ContextExecutor, params CreateInviteParams) (*models.Invite, error) {
+	id, err := gonanoid.New()
+	if err != nil {
+		return nil, err
+	}
+	// easy to type for users who got the code without the link
+	code, err := gonanoid.Generate("abcdefghijkmnpqrstuvwxyz23456789", 12)
+	if err != nil {
+		return nil, err
+	}
+	invite := models.Invite{
+		ID:      id,
+		Code:    code,
+		MaxUses: params.MaxUses,
+	}
+	if params.OwnerId != "" {
+		invite.OwnerID = null.StringFrom(params.OwnerId)
+	}
+	if params.Email != "" {
+		invite.Email = null.StringFrom(params.Email)
+	}
+	if params.ExpiresAt != nil {
+		invite.ExpiresAt = null.TimeFrom(params.ExpiresAt.UTC())
+	}
+	err = invite.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
+
+// GetInvitesForUser returns the invites of the user with the users who
+// registered with them, newest first
+func GetInvitesForUser(ctx context.Context, exec boil.ContextExecutor, ownerId string) (models.InviteSlice, error) {
+	return models.Invites(
+		models.InviteWhere.OwnerID.EQ(null.StringFrom(ownerId)),
+		qm.Load(models.InviteRels.Users),
+		qm.OrderBy(models.InviteColumns.CreatedAt+" DESC"),
+	).All(ctx, exec)
+}
+
+// ConsumeInvite uses the invite with the code for a registration with the
+// email address. The invite is checked and its uses are counted in a single
+// statement, so concurrent registrations can not exceed the maximum uses. It
+// returns sql.ErrNoRows if the invite does not exist, is used up, expired or
+// bound to another address.
+func ConsumeInvite(ctx context.Context, exec boil.ContextExecutor, code string, email string, now time.Time) (*models.Invite, error) {
+	var invite models.Invite
+	err := queries.Raw(
+		`UPDATE invites SET uses = uses + 1, updated_at = $2
+		WHERE code = $1
+		AND uses < max_uses
+		AND (expires_at IS NULL OR expires_at > $2)
+		AND (email IS NULL OR lower(email) = $3)
+		RETURNING *`, code, now.UTC(), strings.ToLower(email),
+	).Bind(ctx, exec, &invite)
+	if err != nil {
+		return nil, err
+	}
+	return &invite, nil
+}
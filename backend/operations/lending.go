@@ -0,0 +1,167 @@
+package operations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/lib/pq"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/utils"
+)
+
+var activeLoanStates = []models.LoanState{models.LoanStateLent, models.LoanStateOverdue}
+
+func borrowRequestLoads() []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.Load(models.BorrowRequestRels.Borrower),
+		qm.Load(models.BorrowRequestRels.Owner),
+		qm.Load(qm.Rels(models.BorrowRequestRels.Thing, models.ThingRels.Owner)),
+		qm.Load(qm.Rels(models.BorrowRequestRels.Thing, models.ThingRels.QuantityEntries)),
+	}
+}
+
+func loanLoads() []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.Load(models.LoanRels.Borrower),
+		qm.Load(models.LoanRels.Owner),
+		qm.Load(qm.Rels(models.LoanRels.Thing, models.ThingRels.Owner)),
+		qm.Load(qm.Rels(models.LoanRels.Thing, models.ThingRels.QuantityEntries)),
+	}
+}
+
+func GetBorrowRequest(ctx context.Context, exec boil.ContextExecutor, requestId string) (*models.BorrowRequest, error) {
+	mods := append([]qm.QueryMod{models.BorrowRequestWhere.ID.EQ(requestId)}, borrowRequestLoads()...)
+	request, err := models.BorrowRequests(mods...).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.NotFoundError{EntityName: "BorrowRequest"}
+		}
+		return nil, err
+	}
+	return request, nil
+}
+
+func GetBorrowRequests(ctx context.Context, exec boil.ContextExecutor, where qm.QueryMod) (models.BorrowRequestSlice, error) {
+	mods := append([]qm.QueryMod{where, qm.OrderBy("created_at desc")}, borrowRequestLoads()...)
+	return models.BorrowRequests(mods...).All(ctx, exec)
+}
+
+func CreateBorrowRequest(ctx context.Context, exec boil.ContextExecutor, thing *models.Thing, borrowerId string, requestedDueAt *time.Time) (*models.BorrowRequest, error) {
+	requestId, err := gonanoid.New()
+	if err != nil {
+		return nil, err
+	}
+	request := models.BorrowRequest{
+		ID:         requestId,
+		ThingID:    thing.ID,
+		BorrowerID: borrowerId,
+		OwnerID:    thing.OwnerID,
+		State:      models.BorrowRequestStatePending,
+		CreatedAt:  time.Now(),
+	}
+	if requestedDueAt != nil {
+		request.RequestedDueAt = null.TimeFrom(*requestedDueAt)
+	}
+	err = request.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func HasPendingBorrowRequest(ctx context.Context, exec boil.ContextExecutor, thingId string, borrowerId string) (bool, error) {
+	return models.BorrowRequests(
+		models.BorrowRequestWhere.ThingID.EQ(thingId),
+		models.BorrowRequestWhere.BorrowerID.EQ(borrowerId),
+		models.BorrowRequestWhere.State.EQ(models.BorrowRequestStatePending),
+	).Exists(ctx, exec)
+}
+
+func GetLoan(ctx context.Context, exec boil.ContextExecutor, loanId string) (*models.Loan, error) {
+	mods := append([]qm.QueryMod{models.LoanWhere.ID.EQ(loanId)}, loanLoads()...)
+	loan, err := models.Loans(mods...).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.NotFoundError{EntityName: "Loan"}
+		}
+		return nil, err
+	}
+	return loan, nil
+}
+
+func GetLoans(ctx context.Context, exec boil.ContextExecutor, where qm.QueryMod) (models.LoanSlice, error) {
+	mods := append([]qm.QueryMod{where, qm.OrderBy("lent_at desc")}, loanLoads()...)
+	return models.Loans(mods...).All(ctx, exec)
+}
+
+// ThingIsLent returns whether there is an active (lent or overdue) loan for the thing
+func ThingIsLent(ctx context.Context, exec boil.ContextExecutor, thingId string) (bool, error) {
+	return models.Loans(
+		models.LoanWhere.ThingID.EQ(thingId),
+		models.LoanWhere.State.IN(activeLoanStates),
+	).Exists(ctx, exec)
+}
+
+func CreateLoan(ctx context.Context, exec boil.ContextExecutor, request *models.BorrowRequest, dueAt time.Time) (*models.Loan, error) {
+	lent, err := ThingIsLent(ctx, exec, request.ThingID)
+	if err != nil {
+		return nil, err
+	}
+	if lent {
+		return nil, utils.ThingAlreadyLentError{}
+	}
+	loanId, err := gonanoid.New()
+	if err != nil {
+		return nil, err
+	}
+	loan := models.Loan{
+		ID:              loanId,
+		BorrowRequestID: request.ID,
+		ThingID:         request.ThingID,
+		BorrowerID:      request.BorrowerID,
+		OwnerID:         request.OwnerID,
+		State:           models.LoanStateLent,
+		LentAt:          time.Now(),
+		DueAt:           dueAt,
+	}
+	err = loan.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		// another approval lent the thing since the check above
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "loans_active_thing_id_idx" {
+			return nil, utils.ThingAlreadyLentError{}
+		}
+		return nil, err
+	}
+	return &loan, nil
+}
+
+// MarkOverdueLoans moves all lent loans whose due date is before now into the
+// overdue state and returns them.
+func MarkOverdueLoans(ctx context.Context, exec boil.ContextExecutor, now time.Time) (models.LoanSlice, error) {
+	mods := append([]qm.QueryMod{
+		models.LoanWhere.State.EQ(models.LoanStateLent),
+		models.LoanWhere.DueAt.LT(now),
+	}, loanLoads()...)
+	loans, err := models.Loans(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	if len(loans) == 0 {
+		return loans, nil
+	}
+	_, err = loans.UpdateAll(ctx, exec, models.M{models.LoanColumns.State: models.LoanStateOverdue})
+	if err != nil {
+		return nil, err
+	}
+	for _, loan := range loans {
+		loan.State = models.LoanStateOverdue
+	}
+	return loans, nil
+}
@@ -0,0 +1,255 @@
+package operations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/lib/pq"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/utils"
+)
+
+func GetLocationUnchecked(ctx context.Context, exec boil.ContextExecutor, locationId string) (*models.Location, error) {
+	location, err := models.Locations(
+		models.LocationWhere.ID.EQ(locationId),
+		qm.Load(models.LocationRels.Owner),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.NotFoundError{EntityName: "Location"}
+		}
+		return nil, err
+	}
+	return location, nil
+}
+
+func GetLocationChecked(ctx context.Context, exec boil.ContextExecutor, locationId string, userId string) (*models.Location, error) {
+	location, err := GetLocationUnchecked(ctx, exec, locationId)
+	if err != nil {
+		return nil, err
+	}
+	if location.OwnerID == userId {
+		return location, nil
+	}
+	sharedLocationIds, err := GetSharedLocationIdsForUser(ctx, exec, userId)
+	if err != nil {
+		return nil, err
+	}
+	if !utils.Contains(sharedLocationIds, locationId) {
+		return nil, utils.UserHasNoAccessRightsError{}
+	}
+	return location, nil
+}
+
+// second order of sharing
+func getFriendOfFriendLocations(ctx context.Context, exec boil.ContextExecutor, userId string) ([]string, error) {
+	sharedLocationIds := make([]string, 0)
+	type IdRow struct {
+		Id string `boil:"id"`
+	}
+	var idRows []IdRow
+	err := queries.Raw(
+		`SELECT DISTINCT id from locations where sharing_state='friends-of-friends' and owner_id in (
+		SELECT
+		CASE WHEN friend1_id=$1 THEN friend2_id ELSE friend1_id END AS other_id
+		FROM friendships
+		WHERE friend1_id=$1 OR friend2_id=$1)`, userId,
+	).Bind(ctx, exec, &idRows)
+	if err != nil {
+		return nil, err
+	}
+	for _, idRow := range idRows {
+		sharedLocationIds = append(sharedLocationIds, idRow.Id)
+	}
+	return sharedLocationIds, nil
+}
+
+// first order of sharing
+func getFriendLocations(ctx context.Context, exec boil.ContextExecutor, userId string) ([]string, error) {
+	sharedLocationIds := make([]string, 0)
+	type IdRow struct {
+		Id string `boil:"id"`
+	}
+	var idRows []IdRow
+	err := queries.Raw(
+		`SELECT DISTINCT id from locations where (sharing_state='friends' or sharing_state='friends-of-friends') and owner_id in (
+		SELECT
+		CASE WHEN friend1_id=$1 THEN friend2_id ELSE friend1_id END AS other_id
+		FROM friendships
+		WHERE friend1_id=$1 OR friend2_id=$1)`, userId,
+	).Bind(ctx, exec, &idRows)
+	if err != nil {
+		return nil, err
+	}
+	for _, idRow := range idRows {
+		sharedLocationIds = append(sharedLocationIds, idRow.Id)
+	}
+	return sharedLocationIds, nil
+}
+
+// GetSharedLocationIdsForUser returns the ids of all locations of other users
+// that are visible to the user through their sharing state.
+func GetSharedLocationIdsForUser(ctx context.Context, exec boil.ContextExecutor, userId string) ([]string, error) {
+	sharedLocationIds := make([]string, 0)
+
+	friendLocationIds, err := getFriendLocations(ctx, exec, userId)
+	if err != nil {
+		return nil, err
+	}
+	sharedLocationIds = append(sharedLocationIds, friendLocationIds...)
+
+	friendIds, err := GetFriendIds(ctx, exec, userId)
+	if err != nil {
+		return nil, err
+	}
+	// get all locations that are shared by the friend of the friend
+	for _, friendId := range friendIds {
+		friendOfFriendLocations, err := getFriendOfFriendLocations(ctx, exec, friendId)
+		if err != nil {
+			return nil, err
+		}
+		sharedLocationIds = append(sharedLocationIds, friendOfFriendLocations...)
+	}
+	return sharedLocationIds, nil
+}
+
+// GetLocationSubtreeIds returns the id of the location and the ids of all
+// locations below it.
+func GetLocationSubtreeIds(ctx context.Context, exec boil.ContextExecutor, locationId string) ([]string, error) {
+	type IdRow struct {
+		Id string `boil:"id"`
+	}
+	var idRows []IdRow
+	err := queries.Raw(
+		`WITH RECURSIVE subtree AS (
+			SELECT id FROM locations WHERE id=$1
+			UNION
+			SELECT l.id FROM locations l JOIN subtree s ON l.parent_id = s.id
+		) SELECT id FROM subtree`, locationId,
+	).Bind(ctx, exec, &idRows)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(idRows))
+	for i, idRow := range idRows {
+		ids[i] = idRow.Id
+	}
+	return ids, nil
+}
+
+// getLocationsWithAncestors returns the given locations together with all of
+// their ancestors.
+func getLocationsWithAncestors(ctx context.Context, exec boil.ContextExecutor, locationIds []string) (models.LocationSlice, error) {
+	var locations models.LocationSlice
+	err := queries.Raw(
+		`WITH RECURSIVE path AS (
+			SELECT * FROM locations WHERE id = ANY($1)
+			UNION
+			SELECT l.* FROM locations l JOIN path p ON l.id = p.parent_id
+		) SELECT * FROM path`, pq.Array(locationIds),
+	).Bind(ctx, exec, &locations)
+	if err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
+
+// linkLocationPaths sets R.Parent on every location whose parent is part of the
+// given locations and visible to the user, so the path can be walked upwards.
+// Invisible parents end the path.
+func linkLocationPaths(ctx context.Context, exec boil.ContextExecutor, locations models.LocationSlice, userId string) (map[string]*models.Location, error) {
+	sharedLocationIds, err := GetSharedLocationIdsForUser(ctx, exec, userId)
+	if err != nil {
+		return nil, err
+	}
+	visible := make(map[string]*models.Location)
+	for _, location := range locations {
+		if location.OwnerID == userId || utils.Contains(sharedLocationIds, location.ID) {
+			visible[location.ID] = location
+		}
+	}
+	for _, location := range visible {
+		if location.R == nil {
+			location.R = location.R.NewStruct()
+		}
+		if location.ParentID.Valid {
+			location.R.Parent = visible[location.ParentID.String]
+		}
+	}
+	return visible, nil
+}
+
+// LoadLocationPaths loads the location of every thing including the chain of
+// parent locations into thing.R.Location and location.R.Parent. Locations the
+// user cannot see are left out, a thing in such a location has no location.
+func LoadLocationPaths(ctx context.Context, exec boil.ContextExecutor, userId string, things ...*models.Thing) error {
+	locationIds := []string{}
+	for _, thing := range things {
+		if thing.LocationID.Valid {
+			locationIds = append(locationIds, thing.LocationID.String)
+		}
+	}
+	if len(locationIds) == 0 {
+		return nil
+	}
+	locations, err := getLocationsWithAncestors(ctx, exec, locationIds)
+	if err != nil {
+		return err
+	}
+	visible, err := linkLocationPaths(ctx, exec, locations, userId)
+	if err != nil {
+		return err
+	}
+	for _, thing := range things {
+		if thing.R == nil {
+			thing.R = thing.R.NewStruct()
+		}
+		thing.R.Location = nil
+		if thing.LocationID.Valid {
+			thing.R.Location = visible[thing.LocationID.String]
+		}
+	}
+	return nil
+}
+
+// LoadLocationPath loads the chain of parent locations of a single location,
+// see LoadLocationPaths.
+func LoadLocationPath(ctx context.Context, exec boil.ContextExecutor, userId string, location *models.Location) error {
+	return LoadLocationParentPaths(ctx, exec, userId, location)
+}
+
+// LoadLocationParentPaths loads the chains of parent locations of all
+// locations with a single query, see LoadLocationPaths.
+func LoadLocationParentPaths(ctx context.Context, exec boil.ContextExecutor, userId string, locations ...*models.Location) error {
+	parentIds := []string{}
+	for _, location := range locations {
+		if location.ParentID.Valid {
+			parentIds = append(parentIds, location.ParentID.String)
+		}
+	}
+	if len(parentIds) == 0 {
+		return nil
+	}
+	ancestors, err := getLocationsWithAncestors(ctx, exec, parentIds)
+	if err != nil {
+		return err
+	}
+	visible, err := linkLocationPaths(ctx, exec, ancestors, userId)
+	if err != nil {
+		return err
+	}
+	for _, location := range locations {
+		if location.R == nil {
+			location.R = location.R.NewStruct()
+		}
+		location.R.Parent = nil
+		if location.ParentID.Valid {
+			location.R.Parent = visible[location.ParentID.String]
+		}
+	}
+	return nil
+}
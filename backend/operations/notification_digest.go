@@ -0,0 +1,64 @@
+package operations
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/stashsphere/backend/models"
+)
+
+// a user is due when the digest interval passed since the last digest
+const digestDueCondition = `(users.last_digest_at IS NULL OR users.last_digest_at <= CURRENT_TIMESTAMP -
+	CASE users.digest_frequency WHEN 'weekly' THEN interval '7 days' ELSE interval '1 day' END)`
+
+// unacknowledged notifications on the digest channel of the recipient which
+// were not part of a digest yet
+const digestNotificationCondition = `notifications.acknowledged_at IS NULL
+	AND notifications.digested_at IS NULL
+	AND EXISTS (SELECT 1 FROM notification_preferences np WHERE np.user_id = notifications.recipient_id
+		AND np.content_type = notifications.content_type AND np.channel = 'digest')`
+
+// UsersWithDueDigest returns the users whose digest is due and contains at
+// least one notification
+func UsersWithDueDigest(ctx context.Context, exec boil.ContextExecutor) (models.UserSlice, error) {
+	return models.Users(
+		qm.Where(digestDueCondition),
+		qm.Where(`EXISTS (SELECT 1 FROM notifications WHERE notifications.recipient_id = users.id AND `+digestNotificationCondition+`)`),
+		qm.OrderBy(models.UserColumns.ID),
+	).All(ctx, exec)
+}
+
+// LockUserForDigest locks the user until the end of the transaction, so that
+// a digest is not sent twice by several instances. It returns sql.ErrNoRows if
+// the user is locked or the digest is not due anymore.
+func LockUserForDigest(ctx context.Context, exec boil.ContextExecutor, userId string) (*models.User, error) {
+	return models.Users(
+		models.UserWhere.ID.EQ(userId),
+		qm.Where(digestDueCondition),
+		qm.For("UPDATE SKIP LOCKED"),
+	).One(ctx, exec)
+}
+
+// DigestNotifications returns the notifications of the next digest of the user
+func DigestNotifications(ctx context.Context, exec boil.ContextExecutor, userId string) (models.NotificationSlice, error) {
+	return models.Notifications(
+		models.NotificationWhere.RecipientID.EQ(userId),
+		qm.Where(digestNotificationCondition),
+		qm.OrderBy("notifications.created_at ASC"),
+	).All(ctx, exec)
+}
+
+// MarkDigested records that the notifications were sent in a digest, which
+// starts the interval until the next digest of the user. The notifications
+// are marked by id, as notifications committed later may have been created
+// before the digest was sent.
+func MarkDigested(ctx context.Context, exec boil.ContextExecutor, userId string, digested models.NotificationSlice) error {
+	_, err := exec.ExecContext(ctx, "UPDATE users SET last_digest_at = CURRENT_TIMESTAMP WHERE id = $1", userId)
+	if err != nil {
+		return err
+	}
+	_, err = digested.UpdateAll(ctx, exec, models.M{models.NotificationColumns.DigestedAt: time.Now()})
+	return err
+}
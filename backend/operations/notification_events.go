@@ -0,0 +1,36 @@
+package operations
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// NotificationEventsChannel is the Postgres channel notification events are
+// published on, so that every server instance can forward them to its streams
+const NotificationEventsChannel = "notification_events"
+
+const (
+	NotificationEventCreated      = "created"
+	NotificationEventAcknowledged = "acknowledged"
+)
+
+// NotificationEvent is the payload sent through NOTIFY. It only references the
+// notification as payloads are limited in size.
+type NotificationEvent struct {
+	Event          string `json:"event"`
+	NotificationId string `json:"notificationId"`
+	RecipientId    string `json:"recipientId"`
+}
+
+// PublishNotificationEvent notifies all listeners about the event. Inside a
+// transaction the event is only delivered once the transaction commits.
+func PublishNotificationEvent(ctx context.Context, exec boil.ContextExecutor, event NotificationEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = exec.ExecContext(ctx, "SELECT pg_notify($1, $2)", NotificationEventsChannel, string(payload))
+	return err
+}
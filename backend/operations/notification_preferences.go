@@ -0,0 +1,98 @@
+package operations
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/notifications"
+)
+
+// DefaultNotificationChannel is used for content types without a preference.
+// It stores the notification in-app and sends an email.
+const DefaultNotificationChannel = models.NotificationChannelEmail
+
+// NotificationChannelForUser returns the channel the user wants to receive
+// notifications of the content type on
+func NotificationChannelForUser(ctx context.Context, exec boil.ContextExecutor, userId string, contentType string) (models.NotificationChannel, error) {
+	preference, err := models.NotificationPreferences(
+		models.NotificationPreferenceWhere.UserID.EQ(userId),
+		models.NotificationPreferenceWhere.ContentType.EQ(contentType),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return DefaultNotificationChannel, nil
+		}
+		return "", err
+	}
+	return preference.Channel, nil
+}
+
+// NotificationChannelsForUser returns the channel for every content type in
+// notifications.ContentTypes
+func NotificationChannelsForUser(ctx context.Context, exec boil.ContextExecutor, userId string) (map[string]models.NotificationChannel, error) {
+	preferences, err := models.NotificationPreferences(
+		models.NotificationPreferenceWhere.UserID.EQ(userId),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	channels := make(map[string]models.NotificationChannel, len(notifications.ContentTypes))
+	for _, contentType := range notifications.ContentTypes {
+		channels[contentType] = DefaultNotificationChannel
+	}
+	for _, preference := range preferences {
+		channels[preference.ContentType] = preference.Channel
+	}
+	return channels, nil
+}
+
+// SetNotificationChannel stores the preference, setting the default channel
+// removes it
+func SetNotificationChannel(ctx context.Context, exec boil.ContextExecutor, userId string, contentType string, channel models.NotificationChannel) error {
+	if channel == DefaultNotificationChannel {
+		_, err := models.NotificationPreferences(
+			models.NotificationPreferenceWhere.UserID.EQ(userId),
+			models.NotificationPreferenceWhere.ContentType.EQ(contentType),
+		).DeleteAll(ctx, exec)
+		return err
+	}
+	preference := models.NotificationPreference{
+		UserID:      userId,
+		ContentType: contentType,
+		Channel:     channel,
+	}
+	return preference.Upsert(ctx, exec, true,
+		[]string{models.NotificationPreferenceColumns.UserID, models.NotificationPreferenceColumns.ContentType},
+		boil.Whitelist(models.NotificationPreferenceColumns.Channel, models.NotificationPreferenceColumns.UpdatedAt),
+		boil.Infer(),
+	)
+}
+
+// UnsubscribeToken returns the token which authorizes the unsubscribe links
+// in the emails of the user, creating it on first use
+func UnsubscribeToken(ctx context.Context, exec boil.ContextExecutor, userId string) (string, error) {
+	user, err := FindUserByID(ctx, exec, userId)
+	if err != nil {
+		return "", err
+	}
+	if user.UnsubscribeToken.Valid {
+		return user.UnsubscribeToken.String, nil
+	}
+	token := make([]byte, 32)
+	_, err = rand.Read(token)
+	if err != nil {
+		return "", err
+	}
+	user.UnsubscribeToken = null.StringFrom(hex.EncodeToString(token))
+	_, err = user.Update(ctx, exec, boil.Whitelist(models.UserColumns.UnsubscribeToken))
+	if err != nil {
+		return "", err
+	}
+	return user.UnsubscribeToken.String, nil
+}
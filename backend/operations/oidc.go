@@ -0,0 +1,86 @@
+package operations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/utils"
+)
+
+type CreateOidcLoginParams struct {
+	State        string
+	Nonce        string
+	CodeVerifier string
+	// empty if no invite code was given
+	InviteCode string
+	// set if a logged in user links their account at the provider
+	LinkUserId string
+	ExpiresAt  time.Time
+}
+
+func CreateOidcLogin(ctx context.Context, exec boil.ContextExecutor, params CreateOidcLoginParams) error {
+	login := models.OidcLogin{
+		ID:           params.State,
+		Nonce:        params.Nonce,
+		CodeVerifier: params.CodeVerifier,
+		ExpiresAt:    params.ExpiresAt,
+	}
+	if params.InviteCode != "" {
+		login.InviteCode = null.StringFrom(params.InviteCode)
+	}
+	if params.LinkUserId != "" {
+		login.LinkUserID = null.StringFrom(params.LinkUserId)
+	}
+	return login.Insert(ctx, exec, boil.Infer())
+}
+
+// ConsumeOidcLogin deletes the unexpired login with the state and returns it,
+// so every state is only accepted once. It returns sql.ErrNoRows if there is
+// no such login or it has been consumed concurrently.
+func ConsumeOidcLogin(ctx context.Context, exec boil.ContextExecutor, state string) (*models.OidcLogin, error) {
+	login, err := models.OidcLogins(
+		models.OidcLoginWhere.ID.EQ(state),
+		models.OidcLoginWhere.ExpiresAt.GT(time.Now()),
+	).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := login.Delete(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return login, nil
+}
+
+func PurgeExpiredOidcLogins(ctx context.Context, exec boil.ContextExecutor, now time.Time) (int64, error) {
+	return models.OidcLogins(
+		models.OidcLoginWhere.ExpiresAt.LT(now),
+	).DeleteAll(ctx, exec)
+}
+
+func FindUserByOidcSubject(ctx context.Context, exec boil.ContextExecutor, subject string) (*models.User, error) {
+	user, err := models.Users(models.UserWhere.OidcSubject.EQ(null.StringFrom(subject))).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.NotFoundError{EntityName: "user"}
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// LinkOidcSubject connects the user with their account at the OpenID Connect
+// provider, later logins find the user by the subject
+func LinkOidcSubject(ctx context.Context, exec boil.ContextExecutor, user *models.User, subject string) error {
+	user.OidcSubject = null.StringFrom(subject)
+	_, err := user.Update(ctx, exec, boil.Whitelist(models.UserColumns.OidcSubject))
+	return err
+}
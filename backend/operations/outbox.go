@@ -0,0 +1,131 @@
+package operations
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/stashsphere/backend/models"
+)
+
+const (
+	// delay before the first retry, doubled with every further attempt
+	OutboxRetryBaseDelay = 30 * time.Second
+	OutboxRetryMaxDelay  = 6 * time.Hour
+	// after this many failed attempts an email is moved to the dead state
+	OutboxMaxAttempts = 8
+)
+
+type EnqueueEmailParams struct {
+	Recipient string
+	Subject   string
+	Body      string
+	// optional alternative to the plain text body
+	HTMLBody        *string
+	UnsubscribeUrl  *string
+	InlineImage     []byte
+	InlineImageMime *string
+}
+
+// EnqueueEmail stores an email in the outbox. Inside a transaction the email
+// is only sent if the transaction commits.
+func EnqueueEmail(ctx context.Context, exec boil.ContextExecutor, params EnqueueEmailParams) (*models.OutboxEmail, error) {
+	id, err := gonanoid.New()
+	if err != nil {
+		return nil, err
+	}
+	email := models.OutboxEmail{
+		ID:              id,
+		Recipient:       params.Recipient,
+		Subject:         params.Subject,
+		Body:            params.Body,
+		HTMLBody:        null.StringFromPtr(params.HTMLBody),
+		UnsubscribeURL:  null.StringFromPtr(params.UnsubscribeUrl),
+		InlineImage:     null.BytesFrom(params.InlineImage),
+		InlineImageMime: null.StringFromPtr(params.InlineImageMime),
+		State:           models.OutboxEmailStatePending,
+		NextAttemptAt:   time.Now(),
+	}
+	err = email.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return &email, nil
+}
+
+func dueOutboxEmails(mods ...qm.QueryMod) []qm.QueryMod {
+	return append([]qm.QueryMod{
+		models.OutboxEmailWhere.State.EQ(models.OutboxEmailStatePending),
+		models.OutboxEmailWhere.NextAttemptAt.LTE(time.Now()),
+		// emails being sent by another worker are skipped
+		qm.For("UPDATE SKIP LOCKED"),
+	}, mods...)
+}
+
+// LockDueOutboxEmail locks the email if it is due. It returns sql.ErrNoRows if
+// it is not due or locked by another worker.
+func LockDueOutboxEmail(ctx context.Context, exec boil.ContextExecutor, id string) (*models.OutboxEmail, error) {
+	return models.OutboxEmails(dueOutboxEmails(models.OutboxEmailWhere.ID.EQ(id))...).One(ctx, exec)
+}
+
+// LockNextDueOutboxEmail locks the email waiting the longest. It returns
+// sql.ErrNoRows if no email is due.
+func LockNextDueOutboxEmail(ctx context.Context, exec boil.ContextExecutor) (*models.OutboxEmail, error) {
+	return models.OutboxEmails(dueOutboxEmails(qm.OrderBy(models.OutboxEmailColumns.NextAttemptAt+" ASC"), qm.Limit(1))...).One(ctx, exec)
+}
+
+// OutboxRetryDelay returns the delay after the given number of failed attempts
+func OutboxRetryDelay(attempts int) time.Duration {
+	return retryDelay(OutboxRetryBaseDelay, OutboxRetryMaxDelay, attempts)
+}
+
+func MarkOutboxEmailSent(ctx context.Context, exec boil.ContextExecutor, email *models.OutboxEmail) error {
+	email.Attempts++
+	email.State = models.OutboxEmailStateSent
+	email.SentAt = null.TimeFrom(time.Now())
+	_, err := email.Update(ctx, exec, boil.Infer())
+	return err
+}
+
+// MarkOutboxEmailFailed schedules the next attempt or moves the email to the
+// dead state if it ran out of attempts
+func MarkOutboxEmailFailed(ctx context.Context, exec boil.ContextExecutor, email *models.OutboxEmail, deliveryErr error) error {
+	email.Attempts++
+	email.LastError = null.StringFrom(deliveryErr.Error())
+	if email.Attempts >= OutboxMaxAttempts {
+		email.State = models.OutboxEmailStateDead
+	} else {
+		email.NextAttemptAt = time.Now().Add(OutboxRetryDelay(email.Attempts))
+	}
+	_, err := email.Update(ctx, exec, boil.Infer())
+	return err
+}
+
+// ListOutboxEmails lists the emails in the given state, newest first
+func ListOutboxEmails(ctx context.Context, exec boil.ContextExecutor, state models.OutboxEmailState) (models.OutboxEmailSlice, error) {
+	return models.OutboxEmails(
+		models.OutboxEmailWhere.State.EQ(state),
+		qm.OrderBy(models.OutboxEmailColumns.CreatedAt+" DESC"),
+	).All(ctx, exec)
+}
+
+// RequeueOutboxEmail resets the attempts of an unsent email and schedules it
+// right away
+func RequeueOutboxEmail(ctx context.Context, exec boil.ContextExecutor, email *models.OutboxEmail) error {
+	email.State = models.OutboxEmailStatePending
+	email.Attempts = 0
+	email.NextAttemptAt = time.Now()
+	_, err := email.Update(ctx, exec, boil.Infer())
+	return err
+}
+
+// PurgeSentOutboxEmails deletes emails sent before the given time
+func PurgeSentOutboxEmails(ctx context.Context, exec boil.ContextExecutor, sentBefore time.Time) (int64, error) {
+	return models.OutboxEmails(
+		models.OutboxEmailWhere.State.EQ(models.OutboxEmailStateSent),
+		models.OutboxEmailWhere.SentAt.LT(null.TimeFrom(sentBefore)),
+	).DeleteAll(ctx, exec)
+}
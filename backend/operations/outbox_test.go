@@ -0,0 +1,16 @@
+package operations_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stashsphere/backend/operations"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutboxRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, operations.OutboxRetryDelay(1))
+	assert.Equal(t, 60*time.Second, operations.OutboxRetryDelay(2))
+	assert.Equal(t, 4*time.Minute, operations.OutboxRetryDelay(4))
+	assert.Equal(t, operations.OutboxRetryMaxDelay, operations.OutboxRetryDelay(20))
+}
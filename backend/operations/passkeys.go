@@ -0,0 +1,126 @@
+package operations
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/stashsphere/backend/models"
+)
+
+func CreatePasskey(ctx context.Context, exec boil.ContextExecutor, id string, ownerId string, name string, credential *webauthn.Credential) (*models.Passkey, error) {
+	encoded, err := json.Marshal(credential)
+	if err != nil {
+		return nil, err
+	}
+	passkey := models.Passkey{
+		ID:           id,
+		OwnerID:      ownerId,
+		Name:         name,
+		CredentialID: credential.ID,
+		Credential:   types.JSON(encoded),
+	}
+	err = passkey.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return &passkey, nil
+}
+
+func GetPasskeysForUser(ctx context.Context, exec boil.ContextExecutor, ownerId string) (models.PasskeySlice, error) {
+	return models.Passkeys(
+		models.PasskeyWhere.OwnerID.EQ(ownerId),
+		qm.OrderBy(models.PasskeyColumns.CreatedAt+" ASC"),
+	).All(ctx, exec)
+}
+
+func FindPasskeyByCredentialId(ctx context.Context, exec boil.ContextExecutor, credentialId []byte) (*models.Passkey, error) {
+	return models.Passkeys(models.PasskeyWhere.CredentialID.EQ(credentialId)).One(ctx, exec)
+}
+
+// PasskeyCredential decodes the stored webauthn credential
+func PasskeyCredential(passkey *models.Passkey) (webauthn.Credential, error) {
+	credential := webauthn.Credential{}
+	err := json.Unmarshal(passkey.Credential, &credential)
+	return credential, err
+}
+
+// UpdatePasskeyCredential stores the credential after a login, which contains
+// the new sign count of the authenticator
+func UpdatePasskeyCredential(ctx context.Context, exec boil.ContextExecutor, passkey *models.Passkey, credential *webauthn.Credential, now time.Time) error {
+	encoded, err := json.Marshal(credential)
+	if err != nil {
+		return err
+	}
+	passkey.Credential = types.JSON(encoded)
+	passkey.LastUsedAt = null.TimeFrom(now)
+	_, err = passkey.Update(ctx, exec, boil.Whitelist(
+		models.PasskeyColumns.Credential,
+		models.PasskeyColumns.LastUsedAt,
+		models.PasskeyColumns.UpdatedAt,
+	))
+	return err
+}
+
+// CreateWebauthnCeremony stores the session data of a started registration or
+// login, the user id is empty for logins
+func CreateWebauthnCeremony(ctx context.Context, exec boil.ContextExecutor, id string, kind models.WebauthnCeremonyKind, userId string, session *webauthn.SessionData, expiresAt time.Time) error {
+	encoded, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	ceremony := models.WebauthnCeremony{
+		ID:          id,
+		Kind:        kind,
+		SessionData: types.JSON(encoded),
+		ExpiresAt:   expiresAt,
+	}
+	if userId != "" {
+		ceremony.UserID = null.StringFrom(userId)
+	}
+	return ceremony.Insert(ctx, exec, boil.Infer())
+}
+
+// ConsumeWebauthnCeremony deletes the unexpired ceremony and returns its
+// session data, so every challenge is only accepted once. It returns
+// sql.ErrNoRows if there is no such ceremony or it has been consumed
+// concurrently.
+func ConsumeWebauthnCeremony(ctx context.Context, exec boil.ContextExecutor, id string, kind models.WebauthnCeremonyKind, userId string) (*webauthn.SessionData, error) {
+	mods := []qm.QueryMod{
+		models.WebauthnCeremonyWhere.ID.EQ(id),
+		models.WebauthnCeremonyWhere.Kind.EQ(kind),
+		models.WebauthnCeremonyWhere.ExpiresAt.GT(time.Now()),
+	}
+	if userId != "" {
+		mods = append(mods, models.WebauthnCeremonyWhere.UserID.EQ(null.StringFrom(userId)))
+	}
+	ceremony, err := models.WebauthnCeremonies(mods...).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := ceremony.Delete(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return nil, sql.ErrNoRows
+	}
+	session := webauthn.SessionData{}
+	err = json.Unmarshal(ceremony.SessionData, &session)
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func PurgeExpiredWebauthnCeremonies(ctx context.Context, exec boil.ContextExecutor, now time.Time) (int64, error) {
+	return models.WebauthnCeremonies(
+		models.WebauthnCeremonyWhere.ExpiresAt.LT(now),
+	).DeleteAll(ctx, exec)
+}
@@ -0,0 +1,87 @@
+package operations
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/utils"
+)
+
+func hashPasswordResetToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// CreatePasswordResetToken returns a new token for the user, which is only
+// stored hashed
+func CreatePasswordResetToken(ctx context.Context, exec boil.ContextExecutor, userId string, validUntil time.Time) (string, error) {
+	token, err := gonanoid.New(32)
+	if err != nil {
+		return "", err
+	}
+	id, err := gonanoid.New()
+	if err != nil {
+		return "", err
+	}
+	resetToken := models.PasswordResetToken{
+		ID:         id,
+		UserID:     userId,
+		TokenHash:  hashPasswordResetToken(token),
+		ValidUntil: validUntil.UTC(),
+	}
+	err = resetToken.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// ConsumePasswordResetToken returns the id of the user the token was created
+// for. All reset tokens of the user are deleted, so a token can only be used
+// once.
+func ConsumePasswordResetToken(ctx context.Context, exec boil.ContextExecutor, token string) (string, error) {
+	resetToken, err := models.PasswordResetTokens(
+		models.PasswordResetTokenWhere.TokenHash.EQ(hashPasswordResetToken(token)),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", utils.InvalidPasswordResetTokenError{}
+		}
+		return "", err
+	}
+
+	// expired tokens are deleted by PurgeExpiredPasswordResetTokens
+	if time.Now().UTC().After(resetToken.ValidUntil) {
+		return "", utils.PasswordResetTokenExpiredError{}
+	}
+
+	// a concurrent reset with the same token has already deleted it
+	deleted, err := resetToken.Delete(ctx, exec)
+	if err != nil {
+		return "", err
+	}
+	if deleted == 0 {
+		return "", utils.InvalidPasswordResetTokenError{}
+	}
+
+	_, err = models.PasswordResetTokens(
+		models.PasswordResetTokenWhere.UserID.EQ(resetToken.UserID),
+	).DeleteAll(ctx, exec)
+	if err != nil {
+		return "", err
+	}
+	return resetToken.UserID, nil
+}
+
+func PurgeExpiredPasswordResetTokens(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	return models.PasswordResetTokens(
+		models.PasswordResetTokenWhere.ValidUntil.LT(time.Now().UTC().Add(-24*time.Hour)),
+	).DeleteAll(ctx, exec)
+}
@@ -0,0 +1,365 @@
+package operations
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Property filters are written as conditions on property names, combined with
+// AND and OR and grouped with parentheses, e.g.
+//
+//	weight < 2 kg AND (color = red OR color prefix gr) AND warranty_until after 2027-01-01
+//
+// Names are matched case-insensitively, spaces in names can be written as
+// underscores or the name can be quoted. Values containing spaces have to be
+// quoted.
+const (
+	PropertyFilterLess         = "<"
+	PropertyFilterLessEqual    = "<="
+	PropertyFilterGreater      = ">"
+	PropertyFilterGreaterEqual = ">="
+	PropertyFilterEqual        = "="
+	PropertyFilterPrefix       = "prefix"
+	PropertyFilterBefore       = "before"
+	PropertyFilterAfter        = "after"
+)
+
+// PropertyFilterOperators lists the operators available for each property type
+var PropertyFilterOperators = map[string][]string{
+	"float": {
+		PropertyFilterLess,
+		PropertyFilterLessEqual,
+		PropertyFilterGreater,
+		PropertyFilterGreaterEqual,
+		PropertyFilterEqual,
+	},
+	"datetime": {PropertyFilterBefore, PropertyFilterAfter},
+	"string":   {PropertyFilterEqual, PropertyFilterPrefix},
+}
+
+type unitScale struct {
+	dimension string
+	factor    float64
+}
+
+// units that can be converted into each other when filtering, the factor
+// converts into the base unit of the dimension
+var filterUnits = map[string]unitScale{
+	"mg":  {"mass", 0.000001},
+	"g":   {"mass", 0.001},
+	"kg":  {"mass", 1},
+	"t":   {"mass", 1000},
+	"lb":  {"mass", 0.45359237},
+	"oz":  {"mass", 0.028349523125},
+	"mm":  {"length", 0.001},
+	"cm":  {"length", 0.01},
+	"m":   {"length", 1},
+	"km":  {"length", 1000},
+	"in":  {"length", 0.0254},
+	"ft":  {"length", 0.3048},
+	"ml":  {"volume", 0.001},
+	"cl":  {"volume", 0.01},
+	"l":   {"volume", 1},
+	"wh":  {"energy", 1},
+	"kwh": {"energy", 1000},
+	"mah": {"charge", 0.001},
+	"ah":  {"charge", 1},
+}
+
+type PropertyFilter interface {
+	sql() (string, []interface{})
+}
+
+// PropertyFilterQueryMod restricts a things query to the things matching the
+// filter. The query has to select from the things table.
+func PropertyFilterQueryMod(filter PropertyFilter) qm.QueryMod {
+	clause, args := filter.sql()
+	return qm.And(clause, args...)
+}
+
+type propertyFilterJunction struct {
+	operator string
+	filters  []PropertyFilter
+}
+
+func (j propertyFilterJunction) sql() (string, []interface{}) {
+	clauses := make([]string, len(j.filters))
+	args := []interface{}{}
+	for i, filter := range j.filters {
+		clause, clauseArgs := filter.sql()
+		clauses[i] = clause
+		args = append(args, clauseArgs...)
+	}
+	return "(" + strings.Join(clauses, " "+j.operator+" ") + ")", args
+}
+
+type propertyCondition struct {
+	name     string
+	operator string
+	value    string
+	quoted   bool
+	unit     string
+}
+
+func (c propertyCondition) floatSql(operator string, value float64) (string, []interface{}) {
+	if c.unit == "" {
+		return "(p.type = 'float' AND p.value_float " + operator + " ?)", []interface{}{value}
+	}
+	scale, ok := filterUnits[strings.ToLower(c.unit)]
+	if !ok {
+		return "(p.type = 'float' AND p.unit = ? AND p.value_float " + operator + " ?)", []interface{}{c.unit, value}
+	}
+	units := []string{}
+	args := []interface{}{}
+	cases := []string{}
+	for unit, unitScale := range filterUnits {
+		if unitScale.dimension != scale.dimension {
+			continue
+		}
+		units = append(units, "?")
+		cases = append(cases, "WHEN ? THEN ?::float")
+		args = append(args, unit, unitScale.factor)
+	}
+	inArgs := []interface{}{}
+	for i := 0; i < len(args); i += 2 {
+		inArgs = append(inArgs, args[i])
+	}
+	clause := fmt.Sprintf(
+		"(p.type = 'float' AND lower(p.unit) IN (%s) AND p.value_float * (CASE lower(p.unit) %s END) %s ?)",
+		strings.Join(units, ", "), strings.Join(cases, " "), operator,
+	)
+	args = append(inArgs, args...)
+	return clause, append(args, value*scale.factor)
+}
+
+func (c propertyCondition) sql() (string, []interface{}) {
+	var clause string
+	var args []interface{}
+	switch c.operator {
+	case PropertyFilterLess, PropertyFilterLessEqual, PropertyFilterGreater, PropertyFilterGreaterEqual:
+		value, _ := strconv.ParseFloat(c.value, 64)
+		clause, args = c.floatSql(c.operator, value)
+	case PropertyFilterEqual:
+		clause, args = "(p.type = 'string' AND p.value_string = ?)", []interface{}{c.value}
+		if value, err := strconv.ParseFloat(c.value, 64); err == nil && !c.quoted {
+			floatClause, floatArgs := c.floatSql("=", value)
+			clause = "(" + clause + " OR " + floatClause + ")"
+			args = append(args, floatArgs...)
+		}
+	case PropertyFilterPrefix:
+		escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(c.value)
+		clause, args = "(p.type = 'string' AND p.value_string ILIKE ?)", []interface{}{escaped + "%"}
+	case PropertyFilterBefore, PropertyFilterAfter:
+		value, _ := parseFilterDatetime(c.value)
+		operator := "<"
+		if c.operator == PropertyFilterAfter {
+			operator = ">"
+		}
+		clause, args = "(p.type = 'datetime' AND p.value_datetime "+operator+" ?)", []interface{}{value}
+	}
+	return `EXISTS (SELECT 1 FROM properties p WHERE p.thing_id = things.id
+		AND lower(replace(p.name, ' ', '_')) = lower(replace(?, ' ', '_')) AND ` + clause + ")",
+		append([]interface{}{c.name}, args...)
+}
+
+func parseFilterDatetime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
+
+type filterToken struct {
+	value  string
+	quoted bool
+}
+
+func (t filterToken) is(keyword string) bool {
+	return !t.quoted && strings.EqualFold(t.value, keyword)
+}
+
+func tokenizePropertyFilter(input string) ([]filterToken, error) {
+	tokens := []filterToken{}
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, filterToken{value: string(r)})
+			i++
+		case r == '<' || r == '>' || r == '=':
+			if r != '=' && i+1 < len(runes) && runes[i+1] == '=' {
+				tokens = append(tokens, filterToken{value: string(runes[i : i+2])})
+				i += 2
+			} else {
+				tokens = append(tokens, filterToken{value: string(r)})
+				i++
+			}
+		case r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, errors.New("unterminated quote in property filter")
+			}
+			tokens = append(tokens, filterToken{value: string(runes[i+1 : end]), quoted: true})
+			i = end + 1
+		default:
+			end := i
+			for end < len(runes) && !unicode.IsSpace(runes[end]) && !strings.ContainsRune(`()<>="`, runes[end]) {
+				end++
+			}
+			tokens = append(tokens, filterToken{value: string(runes[i:end])})
+			i = end
+		}
+	}
+	return tokens, nil
+}
+
+type propertyFilterParser struct {
+	tokens []filterToken
+	pos    int
+}
+
+func (p *propertyFilterParser) peek() *filterToken {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.pos]
+}
+
+func (p *propertyFilterParser) next() (filterToken, error) {
+	token := p.peek()
+	if token == nil {
+		return filterToken{}, errors.New("unexpected end of property filter")
+	}
+	p.pos++
+	return *token, nil
+}
+
+func (p *propertyFilterParser) parseJunction(operator string, parseOperand func() (PropertyFilter, error)) (PropertyFilter, error) {
+	filter, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	filters := []PropertyFilter{filter}
+	for token := p.peek(); token != nil && token.is(operator); token = p.peek() {
+		p.pos++
+		filter, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+	return propertyFilterJunction{operator: operator, filters: filters}, nil
+}
+
+func (p *propertyFilterParser) parseOr() (PropertyFilter, error) {
+	return p.parseJunction("OR", p.parseAnd)
+}
+
+func (p *propertyFilterParser) parseAnd() (PropertyFilter, error) {
+	return p.parseJunction("AND", p.parseOperand)
+}
+
+func (p *propertyFilterParser) parseOperand() (PropertyFilter, error) {
+	token, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	if token.is("(") {
+		filter, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		closing, err := p.next()
+		if err != nil || !closing.is(")") {
+			return nil, errors.New("missing closing parenthesis in property filter")
+		}
+		return filter, nil
+	}
+	return p.parseCondition(token)
+}
+
+func (p *propertyFilterParser) parseCondition(name filterToken) (PropertyFilter, error) {
+	if !name.quoted && (name.value == ")" || strings.ContainsAny(name.value, "<>=")) {
+		return nil, fmt.Errorf("expected a property name, got %s", name.value)
+	}
+	operatorToken, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	operator := strings.ToLower(operatorToken.value)
+	value, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	condition := propertyCondition{
+		name:     name.value,
+		operator: operator,
+		value:    value.value,
+		quoted:   value.quoted,
+	}
+	switch {
+	case operatorToken.quoted:
+		return nil, fmt.Errorf("unknown operator %s for property %s", operatorToken.value, name.value)
+	case operator == PropertyFilterLess || operator == PropertyFilterLessEqual ||
+		operator == PropertyFilterGreater || operator == PropertyFilterGreaterEqual:
+		if _, err := strconv.ParseFloat(value.value, 64); err != nil || value.quoted {
+			return nil, fmt.Errorf("%s %s needs a number, got %s", name.value, operator, value.value)
+		}
+		condition.unit = p.parseUnit()
+	case operator == PropertyFilterEqual:
+		if _, err := strconv.ParseFloat(value.value, 64); err == nil && !value.quoted {
+			condition.unit = p.parseUnit()
+		}
+	case operator == PropertyFilterPrefix:
+	case operator == PropertyFilterBefore || operator == PropertyFilterAfter:
+		if _, err := parseFilterDatetime(value.value); err != nil {
+			return nil, fmt.Errorf("%s %s needs a date like 2006-01-02, got %s", name.value, operator, value.value)
+		}
+	default:
+		return nil, fmt.Errorf("unknown operator %s for property %s", operatorToken.value, name.value)
+	}
+	return condition, nil
+}
+
+// parseUnit consumes an optional unit following a number
+func (p *propertyFilterParser) parseUnit() string {
+	token := p.peek()
+	if token == nil || token.quoted || token.is("AND") || token.is("OR") || token.is(")") {
+		return ""
+	}
+	p.pos++
+	return token.value
+}
+
+// ParsePropertyFilter parses a filter expression as described above
+func ParsePropertyFilter(input string) (PropertyFilter, error) {
+	tokens, err := tokenizePropertyFilter(input)
+	if err != nil {
+		return nil, err
+	}
+	parser := propertyFilterParser{tokens: tokens}
+	filter, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := parser.peek(); token != nil {
+		return nil, fmt.Errorf("unexpected %s in property filter", token.value)
+	}
+	return filter, nil
+}
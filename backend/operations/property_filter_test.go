@@ -0,0 +1,41 @@
+package operations_test
+
+import (
+	"testing"
+
+	"github.com/stashsphere/backend/operations"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePropertyFilter(t *testing.T) {
+	valid := []string{
+		"weight < 2 kg",
+		"weight<=2",
+		"length > 3.5 m AND color = red",
+		"color prefix gr OR (warranty_until after 2027-01-01 and weight >= 100 g)",
+		"\"Warranty until\" before 2027-01-01T12:00:00Z",
+		"name = \"with spaces\"",
+		"size = 10",
+	}
+	for _, filter := range valid {
+		_, err := operations.ParsePropertyFilter(filter)
+		assert.NoError(t, err, filter)
+	}
+	invalid := []string{
+		"",
+		"weight",
+		"weight <",
+		"weight < heavy",
+		"weight < \"2\"",
+		"warranty before tomorrow",
+		"color like red",
+		"(color = red",
+		"color = red)",
+		"color = red AND",
+		"color = \"red",
+	}
+	for _, filter := range invalid {
+		_, err := operations.ParsePropertyFilter(filter)
+		assert.Error(t, err, filter)
+	}
+}
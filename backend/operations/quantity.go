@@ -0,0 +1,53 @@
+package operations
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/stashsphere/backend/models"
+)
+
+type AddQuantityEntryParams struct {
+	Delta       int64
+	Reason      *models.QuantityReason
+	Note        string
+	CreatedById string
+}
+
+// AddQuantityEntry appends an entry to the quantity log of the thing. The thing
+// must be loaded with QuantityEntries, the new entry is added to them.
+func AddQuantityEntry(ctx context.Context, exec boil.ContextExecutor, thing *models.Thing, params AddQuantityEntryParams) (*models.QuantityEntry, error) {
+	quantityID, err := gonanoid.New()
+	if err != nil {
+		return nil, err
+	}
+	entry := &models.QuantityEntry{
+		ID:          quantityID,
+		DeltaValue:  params.Delta,
+		Reason:      models.NullQuantityReasonFromPtr(params.Reason),
+		Note:        params.Note,
+		CreatedByID: null.StringFrom(params.CreatedById),
+	}
+	err = thing.AddQuantityEntries(ctx, exec, true, entry)
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func CountQuantityEntries(ctx context.Context, exec boil.ContextExecutor, thingId string) (int64, error) {
+	return models.QuantityEntries(models.QuantityEntryWhere.ThingID.EQ(thingId)).Count(ctx, exec)
+}
+
+func GetQuantityEntries(ctx context.Context, exec boil.ContextExecutor, thingId string, mods ...qm.QueryMod) (models.QuantityEntrySlice, error) {
+	query := []qm.QueryMod{
+		models.QuantityEntryWhere.ThingID.EQ(thingId),
+		qm.Load(models.QuantityEntryRels.CreatedBy),
+		qm.OrderBy("created_at desc, id desc"),
+	}
+	query = append(query, mods...)
+	return models.QuantityEntries(query...).All(ctx, exec)
+}
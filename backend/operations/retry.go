@@ -0,0 +1,16 @@
+package operations
+
+import "time"
+
+// retryDelay doubles the base delay with every failed attempt after the first
+// one, capped at max
+func retryDelay(base time.Duration, max time.Duration, attempts int) time.Duration {
+	delay := base
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+	return delay
+}
@@ -0,0 +1,145 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/lib/pq"
+)
+
+// The tsvector expressions have to match the GIN indexes created in the
+// add_search_indexes migration, otherwise postgres will not use them.
+const (
+	thingSearchVector      = `setweight(to_tsvector('simple', t.name), 'A') || setweight(to_tsvector('simple', t.description), 'B')`
+	thingPrivateNoteVector = `to_tsvector('simple', t.private_note)`
+	propertySearchVector   = `to_tsvector('simple', coalesce(p.value_string, ''))`
+	listSearchVector       = `to_tsvector('simple', l.name)`
+	snippetStartSel        = "\uE000"
+	snippetStopSel         = "\uE001"
+	snippetHeadlineOptions = `StartSel="` + snippetStartSel + `", StopSel="` + snippetStopSel + `", MaxWords=20, MinWords=5, MaxFragments=2, FragmentDelimiter=" … "`
+)
+
+// SearchTsQuery turns the user input into a tsquery in which every word is
+// matched as a prefix, so that results show up while typing. Everything but
+// letters and digits is dropped, which also keeps the tsquery syntax out of the
+// users reach. An empty string is returned if nothing is left to search for.
+func SearchTsQuery(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := make([]string, len(words))
+	for i, word := range words {
+		terms[i] = fmt.Sprintf("%s:*", word)
+	}
+	return strings.Join(terms, " & ")
+}
+
+// SnippetToHTML escapes the headline returned by postgres and wraps the
+// matches in <mark> tags.
+func SnippetToHTML(snippet string) string {
+	escaped := html.EscapeString(snippet)
+	escaped = strings.ReplaceAll(escaped, snippetStartSel, "<mark>")
+	return strings.ReplaceAll(escaped, snippetStopSel, "</mark>")
+}
+
+type SearchHit struct {
+	Id      string  `boil:"id"`
+	Rank    float64 `boil:"rank"`
+	Snippet string  `boil:"snippet"`
+}
+
+type CountRow struct {
+	Count int64 `boil:"count"`
+}
+
+// $1 tsquery, $2 user id, $3 ids of things shared with the user
+const thingSearchFrom = `FROM things t
+	CROSS JOIN to_tsquery('simple', $1) q
+	LEFT JOIN LATERAL (
+		SELECT max(ts_rank(setweight(` + propertySearchVector + `, 'C'), q)) AS rank,
+		string_agg(p.value_string, ' ') AS text
+		FROM properties p
+		WHERE p.thing_id = t.id AND p.type = 'string' AND ` + propertySearchVector + ` @@ q
+	) props ON true
+	WHERE (t.owner_id = $2 OR t.id = ANY($3))
+	AND (
+		` + thingSearchVector + ` @@ q
+		OR (t.owner_id = $2 AND ` + thingPrivateNoteVector + ` @@ q)
+		OR props.rank IS NOT NULL
+	)`
+
+// SearchThings returns one page of the things visible to the user that match
+// the tsquery in name, description, string properties or, for their own
+// things, the private note. The hits are ordered by rank.
+func SearchThings(ctx context.Context, exec boil.ContextExecutor, tsQuery string, userId string, sharedThingIds []string, limit int, offset int) (int64, []SearchHit, error) {
+	var countRows []CountRow
+	err := queries.Raw(
+		`SELECT count(*) AS count `+thingSearchFrom,
+		tsQuery, userId, pq.Array(sharedThingIds),
+	).Bind(ctx, exec, &countRows)
+	if err != nil {
+		return 0, nil, err
+	}
+	var hits []SearchHit
+	err = queries.Raw(
+		`SELECT hit.id, hit.rank,
+		ts_headline('simple', hit.text, to_tsquery('simple', $1), $6) AS snippet
+		FROM (
+			SELECT t.id, t.created_at,
+			ts_rank(`+thingSearchVector+`, q)
+			+ CASE WHEN t.owner_id = $2 THEN 0.4 * ts_rank(`+thingPrivateNoteVector+`, q) ELSE 0 END
+			+ coalesce(props.rank, 0) AS rank,
+			concat_ws(' … ',
+				nullif(t.description, ''),
+				CASE WHEN t.owner_id = $2 THEN nullif(t.private_note, '') END,
+				props.text
+			) AS text
+			`+thingSearchFrom+`
+			ORDER BY rank DESC, t.created_at, t.id
+			LIMIT $4 OFFSET $5
+		) hit
+		ORDER BY hit.rank DESC, hit.created_at, hit.id`,
+		tsQuery, userId, pq.Array(sharedThingIds), limit, offset, snippetHeadlineOptions,
+	).Bind(ctx, exec, &hits)
+	if err != nil {
+		return 0, nil, err
+	}
+	return countRows[0].Count, hits, nil
+}
+
+// $1 tsquery, $2 user id, $3 ids of lists shared with the user
+const listSearchFrom = `FROM lists l
+	CROSS JOIN to_tsquery('simple', $1) q
+	WHERE (l.owner_id = $2 OR l.id = ANY($3))
+	AND ` + listSearchVector + ` @@ q`
+
+// SearchLists returns one page of the lists visible to the user whose name
+// matches the tsquery, ordered by rank.
+func SearchLists(ctx context.Context, exec boil.ContextExecutor, tsQuery string, userId string, sharedListIds []string, limit int, offset int) (int64, []SearchHit, error) {
+	var countRows []CountRow
+	err := queries.Raw(
+		`SELECT count(*) AS count `+listSearchFrom,
+		tsQuery, userId, pq.Array(sharedListIds),
+	).Bind(ctx, exec, &countRows)
+	if err != nil {
+		return 0, nil, err
+	}
+	var hits []SearchHit
+	err = queries.Raw(
+		`SELECT l.id, ts_rank(`+listSearchVector+`, q) AS rank,
+		ts_headline('simple', l.name, q, $6) AS snippet
+		`+listSearchFrom+`
+		ORDER BY rank DESC, l.created_at, l.id
+		LIMIT $4 OFFSET $5`,
+		tsQuery, userId, pq.Array(sharedListIds), limit, offset, snippetHeadlineOptions,
+	).Bind(ctx, exec, &hits)
+	if err != nil {
+		return 0, nil, err
+	}
+	return countRows[0].Count, hits, nil
+}
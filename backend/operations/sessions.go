@@ -0,0 +1,107 @@
+package operations
+
+import (
+	"context"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/stashsphere/backend/models"
+)
+
+// longest user agent stored for a session
+const sessionUserAgentLength = 512
+
+type SessionClient struct {
+	UserAgent string
+	IPAddress string
+}
+
+func (sc SessionClient) userAgent() string {
+	if len(sc.UserAgent) > sessionUserAgentLength {
+		return sc.UserAgent[:sessionUserAgentLength]
+	}
+	return sc.UserAgent
+}
+
+func CreateSession(ctx context.Context, exec boil.ContextExecutor, id string, ownerId string, refreshTokenId string, client SessionClient, expiresAt time.Time) (*models.Session, error) {
+	session := models.Session{
+		ID:             id,
+		OwnerID:        ownerId,
+		RefreshTokenID: refreshTokenId,
+		UserAgent:      client.userAgent(),
+		IPAddress:      client.IPAddress,
+		LastSeenAt:     time.Now(),
+		ExpiresAt:      expiresAt,
+	}
+	err := session.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// LockSession locks the unexpired session of the user for rotating its refresh
+// token. It returns sql.ErrNoRows if the session was revoked or expired.
+func LockSession(ctx context.Context, exec boil.ContextExecutor, sessionId string, ownerId string) (*models.Session, error) {
+	return models.Sessions(
+		models.SessionWhere.ID.EQ(sessionId),
+		models.SessionWhere.OwnerID.EQ(ownerId),
+		models.SessionWhere.ExpiresAt.GT(time.Now()),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+}
+
+// FindSession returns the unexpired session of the user
+func FindSession(ctx context.Context, exec boil.ContextExecutor, sessionId string, ownerId string) (*models.Session, error) {
+	return models.Sessions(
+		models.SessionWhere.ID.EQ(sessionId),
+		models.SessionWhere.OwnerID.EQ(ownerId),
+		models.SessionWhere.ExpiresAt.GT(time.Now()),
+	).One(ctx, exec)
+}
+
+// RotateSession replaces the refresh token id of the session, which
+// invalidates the previous refresh token, and records the client
+func RotateSession(ctx context.Context, exec boil.ContextExecutor, session *models.Session, refreshTokenId string, client SessionClient, expiresAt time.Time) error {
+	session.RefreshTokenID = refreshTokenId
+	session.UserAgent = client.userAgent()
+	session.IPAddress = client.IPAddress
+	session.LastSeenAt = time.Now()
+	session.ExpiresAt = expiresAt
+	_, err := session.Update(ctx, exec, boil.Infer())
+	return err
+}
+
+func GetSessionsForUser(ctx context.Context, exec boil.ContextExecutor, ownerId string) (models.SessionSlice, error) {
+	return models.Sessions(
+		models.SessionWhere.OwnerID.EQ(ownerId),
+		models.SessionWhere.ExpiresAt.GT(time.Now()),
+		qm.OrderBy(models.SessionColumns.LastSeenAt+" DESC"),
+	).All(ctx, exec)
+}
+
+// DeleteSession revokes the session of the user and returns the number of
+// deleted sessions
+func DeleteSession(ctx context.Context, exec boil.ContextExecutor, sessionId string, ownerId string) (int64, error) {
+	return models.Sessions(
+		models.SessionWhere.ID.EQ(sessionId),
+		models.SessionWhere.OwnerID.EQ(ownerId),
+	).DeleteAll(ctx, exec)
+}
+
+// DeleteSessionsForUser revokes all sessions of the user except the given
+// one, which may be empty to revoke every session
+func DeleteSessionsForUser(ctx context.Context, exec boil.ContextExecutor, ownerId string, exceptSessionId string) (int64, error) {
+	mods := []qm.QueryMod{models.SessionWhere.OwnerID.EQ(ownerId)}
+	if exceptSessionId != "" {
+		mods = append(mods, models.SessionWhere.ID.NEQ(exceptSessionId))
+	}
+	return models.Sessions(mods...).DeleteAll(ctx, exec)
+}
+
+func PurgeExpiredSessions(ctx context.Context, exec boil.ContextExecutor, now time.Time) (int64, error) {
+	return models.Sessions(
+		models.SessionWhere.ExpiresAt.LT(now),
+	).DeleteAll(ctx, exec)
+}
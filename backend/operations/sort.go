@@ -0,0 +1,155 @@
+package operations
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/lib/pq"
+)
+
+const (
+	SortByName      = "name"
+	SortByCreatedAt = "createdAt"
+	SortByUpdatedAt = "updatedAt"
+	SortByQuantity  = "quantity"
+	SortByOwner     = "owner"
+	SortByProperty  = "property"
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+type SortParams struct {
+	// one of the SortBy constants, empty sorts by creation date
+	Sort  string
+	Order string
+	// name of the property for SortByProperty
+	Property string
+}
+
+// unitFactorSql converts float properties with known units into the base unit
+// of their dimension, so that e.g. 800 g sorts before 1 kg.
+func unitFactorSql(column string) string {
+	units := make([]string, 0, len(filterUnits))
+	for unit := range filterUnits {
+		units = append(units, unit)
+	}
+	sort.Strings(units)
+	cases := make([]string, len(units))
+	for i, unit := range units {
+		cases[i] = fmt.Sprintf("WHEN '%s' THEN %g", unit, filterUnits[unit].factor)
+	}
+	return fmt.Sprintf("(CASE lower(%s) %s ELSE 1 END)", column, strings.Join(cases, " "))
+}
+
+// SortKey is an expression to order by. The type is used to cast the values
+// stored in cursors back.
+type SortKey struct {
+	Expr string
+	Type string
+}
+
+// SortOrder orders the rows of a table by its keys and the id, so that the
+// order is stable across pages. Null values are always sorted last.
+type SortOrder struct {
+	Table string
+	Keys  []SortKey
+	Desc  bool
+	// identifies the order in cursors, a cursor is only valid for the same order
+	Name string
+}
+
+func (o SortOrder) direction() string {
+	if o.Desc {
+		return "DESC"
+	}
+	return "ASC"
+}
+
+func (o SortOrder) QueryMod() qm.QueryMod {
+	direction := o.direction()
+	clauses := []string{}
+	for _, key := range o.Keys {
+		clauses = append(clauses, fmt.Sprintf("%s %s NULLS LAST", key.Expr, direction))
+	}
+	clauses = append(clauses, fmt.Sprintf("%s.id %s", o.Table, direction))
+	return qm.OrderBy(strings.Join(clauses, ", "))
+}
+
+func ThingSortOrder(params SortParams) (SortOrder, error) {
+	order := SortOrder{
+		Table: "things",
+		Desc:  params.Order == SortOrderDesc,
+		Name:  strings.Join([]string{params.Sort, params.Order, params.Property}, ":"),
+	}
+	switch params.Sort {
+	case "", SortByCreatedAt:
+		order.Keys = []SortKey{{"things.created_at", "timestamp"}}
+	case SortByUpdatedAt:
+		order.Keys = []SortKey{{"things.updated_at", "timestamp"}}
+	case SortByName:
+		order.Keys = []SortKey{{"lower(things.name)", "text"}}
+	case SortByQuantity:
+		order.Keys = []SortKey{{"(SELECT coalesce(sum(qe.delta_value), 0) FROM quantity_entries qe WHERE qe.thing_id = things.id)", "numeric"}}
+	case SortByOwner:
+		order.Keys = []SortKey{{"(SELECT lower(u.name) FROM users u WHERE u.id = things.owner_id)", "text"}}
+	case SortByProperty:
+		if params.Property == "" {
+			return order, fmt.Errorf("sorting by property needs a property name")
+		}
+		// property names are unique per thing, but matched case-insensitively
+		// like in the property filters. Things without the property come last.
+		property := `(SELECT %s FROM properties p WHERE p.thing_id = things.id
+			AND lower(replace(p.name, ' ', '_')) = lower(replace(` + pq.QuoteLiteral(params.Property) + `, ' ', '_')) ORDER BY p.name LIMIT 1)`
+		order.Keys = []SortKey{
+			{fmt.Sprintf(property, "p.value_float * "+unitFactorSql("p.unit")), "float8"},
+			{fmt.Sprintf(property, "p.value_datetime"), "timestamp"},
+			{fmt.Sprintf(property, "lower(p.value_string)"), "text"},
+		}
+	default:
+		return order, fmt.Errorf("unknown sort %s", params.Sort)
+	}
+	return order, nil
+}
+
+// ListSortOrder orders lists, sorting by quantity uses the number of things in
+// the list. Lists have no properties to sort by.
+func ListSortOrder(params SortParams) (SortOrder, error) {
+	order := SortOrder{
+		Table: "lists",
+		Desc:  params.Order == SortOrderDesc,
+		Name:  strings.Join([]string{params.Sort, params.Order}, ":"),
+	}
+	switch params.Sort {
+	case "", SortByCreatedAt:
+		order.Keys = []SortKey{{"lists.created_at", "timestamp"}}
+	case SortByUpdatedAt:
+		order.Keys = []SortKey{{"lists.updated_at", "timestamp"}}
+	case SortByName:
+		order.Keys = []SortKey{{"lower(lists.name)", "text"}}
+	case SortByQuantity:
+		order.Keys = []SortKey{{"(SELECT count(*) FROM lists_things lt WHERE lt.list_id = lists.id)", "bigint"}}
+	case SortByOwner:
+		order.Keys = []SortKey{{"(SELECT lower(u.name) FROM users u WHERE u.id = lists.owner_id)", "text"}}
+	default:
+		return order, fmt.Errorf("unknown sort %s", params.Sort)
+	}
+	return order, nil
+}
+
+// ImageSortOrder lists the oldest images first
+var ImageSortOrder = SortOrder{
+	Table: "images",
+	Keys:  []SortKey{{"images.created_at", "timestamp"}},
+	Name:  "images",
+}
+
+// NotificationSortOrder lists the newest notifications first
+var NotificationSortOrder = SortOrder{
+	Table: "notifications",
+	Keys:  []SortKey{{"notifications.created_at", "timestamp"}},
+	Desc:  true,
+	Name:  "notifications",
+}
@@ -224,6 +224,25 @@ func DeltaQuantity(thing *models.Thing, target uint64) int64 {
 	return int64(target) - SumQuantity(thing)
 }
 
+// BelowLowStockThreshold returns whether the given quantity is below the
+// low-stock threshold of the thing. Things without a threshold never are.
+func BelowLowStockThreshold(thing *models.Thing, quantity int64) bool {
+	return thing.LowStockThreshold.Valid && quantity < thing.LowStockThreshold.Int64
+}
+
+// GetLowStockThings returns all things of the owner whose summed quantity is
+// below their low-stock threshold.
+func GetLowStockThings(ctx context.Context, exec boil.ContextExecutor, ownerId string, mods ...qm.QueryMod) (models.ThingSlice, error) {
+	query := []qm.QueryMod{
+		models.ThingWhere.OwnerID.EQ(ownerId),
+		models.ThingWhere.LowStockThreshold.IsNotNull(),
+		qm.Where(`low_stock_threshold > (
+			SELECT COALESCE(SUM(delta_value), 0) FROM quantity_entries WHERE quantity_entries.thing_id = things.id)`),
+	}
+	query = append(query, mods...)
+	return models.Things(query...).All(ctx, exec)
+}
+
 func GetThingChecked(ctx context.Context, exec boil.ContextExecutor, thingId string, userId string) (*models.Thing, error) {
 	thing, err := GetThingUnchecked(ctx, exec, thingId)
 	if err != nil {
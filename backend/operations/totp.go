@@ -0,0 +1,192 @@
+package operations
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/stashsphere/backend/models"
+)
+
+// TOTP parameters according to RFC 6238, the defaults of authenticator apps
+const (
+	totpDigits = 6
+	totpPeriod = 30
+	// accepted time steps before and after the current one to allow for
+	// clock drift
+	totpSkew = 1
+)
+
+const RecoveryCodeCount = 10
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTotpSecret returns a new base32 encoded secret
+func GenerateTotpSecret() (string, error) {
+	secret := make([]byte, 20)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TotpUri returns the otpauth URI authenticator apps import the secret from
+func TotpUri(issuer string, account string, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(totpDigits))
+	query.Set("period", fmt.Sprint(totpPeriod))
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+func totpStep(t time.Time) int64 {
+	return t.Unix() / totpPeriod
+}
+
+// TotpCode returns the code for the time step according to RFC 4226
+func TotpCode(secret []byte, step int64) string {
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	modulo := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%modulo)
+}
+
+// ValidateTotpCode returns the time step of the code if it is valid at the
+// given time and newer than the last used step
+func ValidateTotpCode(secret string, code string, now time.Time, lastStep int64) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	current := totpStep(now)
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(TotpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// normalizeRecoveryCode ignores case, spaces and dashes users may type
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+func hashRecoveryCode(code string) string {
+	hash := sha256.Sum256([]byte(normalizeRecoveryCode(code)))
+	return hex.EncodeToString(hash[:])
+}
+
+// ReplaceRecoveryCodes deletes the recovery codes of the user and returns new
+// ones, which are only stored hashed
+func ReplaceRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, ownerId string) ([]string, error) {
+	_, err := models.RecoveryCodes(models.RecoveryCodeWhere.OwnerID.EQ(ownerId)).DeleteAll(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	codes := make([]string, RecoveryCodeCount)
+	for i := range codes {
+		code, err := gonanoid.Generate("abcdefghijkmnpqrstuvwxyz23456789", 10)
+		if err != nil {
+			return nil, err
+		}
+		id, err := gonanoid.New()
+		if err != nil {
+			return nil, err
+		}
+		recoveryCode := models.RecoveryCode{
+			ID:       id,
+			OwnerID:  ownerId,
+			CodeHash: hashRecoveryCode(code),
+		}
+		err = recoveryCode.Insert(ctx, exec, boil.Infer())
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code[:5] + "-" + code[5:]
+	}
+	return codes, nil
+}
+
+func DeleteRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, ownerId string) error {
+	_, err := models.RecoveryCodes(models.RecoveryCodeWhere.OwnerID.EQ(ownerId)).DeleteAll(ctx, exec)
+	return err
+}
+
+// UseRecoveryCode marks the unused recovery code of the user as used. It
+// returns false if there is no such code.
+func UseRecoveryCode(ctx context.Context, exec boil.ContextExecutor, ownerId string, code string, now time.Time) (bool, error) {
+	updated, err := models.RecoveryCodes(
+		models.RecoveryCodeWhere.OwnerID.EQ(ownerId),
+		models.RecoveryCodeWhere.CodeHash.EQ(hashRecoveryCode(code)),
+		models.RecoveryCodeWhere.UsedAt.IsNull(),
+	).UpdateAll(ctx, exec, models.M{
+		models.RecoveryCodeColumns.UsedAt: null.TimeFrom(now),
+	})
+	if err != nil {
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+// LockUserForTwoFactor locks the user so concurrent attempts can not use the
+// same code twice
+func LockUserForTwoFactor(ctx context.Context, exec boil.ContextExecutor, userId string) (*models.User, error) {
+	return models.Users(
+		models.UserWhere.ID.EQ(userId),
+		qm.For("UPDATE"),
+	).One(ctx, exec)
+}
+
+// VerifySecondFactor checks a TOTP code or a recovery code of the user, which
+// must be locked for update. Accepted codes can not be used again.
+func VerifySecondFactor(ctx context.Context, exec boil.ContextExecutor, user *models.User, code string, now time.Time) (bool, error) {
+	if !user.TotpSecret.Valid {
+		return false, nil
+	}
+	step, ok := ValidateTotpCode(user.TotpSecret.String, code, now, user.TotpLastStep)
+	if ok {
+		user.TotpLastStep = step
+		_, err := user.Update(ctx, exec, boil.Whitelist(models.UserColumns.TotpLastStep))
+		return err == nil, err
+	}
+	if !user.TotpEnabledAt.Valid {
+		// recovery codes are only valid once enrollment is confirmed
+		return false, nil
+	}
+	return UseRecoveryCode(ctx, exec, user.ID, code, now)
+}
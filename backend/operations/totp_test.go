@@ -0,0 +1,53 @@
+package operations_test
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stashsphere/backend/operations"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTotpCode(t *testing.T) {
+	// test vectors of RFC 6238 for SHA1, truncated to 6 digits
+	secret := []byte("12345678901234567890")
+	assert.Equal(t, "287082", operations.TotpCode(secret, 59/30))
+	assert.Equal(t, "081804", operations.TotpCode(secret, 1111111109/30))
+	assert.Equal(t, "050471", operations.TotpCode(secret, 1111111111/30))
+	assert.Equal(t, "005924", operations.TotpCode(secret, 1234567890/30))
+	assert.Equal(t, "279037", operations.TotpCode(secret, 2000000000/30))
+}
+
+func TestValidateTotpCode(t *testing.T) {
+	secret, err := operations.GenerateTotpSecret()
+	assert.NoError(t, err)
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	assert.NoError(t, err)
+
+	now := time.Unix(1760000000, 0)
+	step := now.Unix() / 30
+	code := operations.TotpCode(key, step)
+
+	validStep, ok := operations.ValidateTotpCode(secret, code, now, 0)
+	assert.True(t, ok)
+	assert.Equal(t, step, validStep)
+	// codes of adjacent steps are accepted for clock drift
+	_, ok = operations.ValidateTotpCode(secret, operations.TotpCode(key, step-1), now, 0)
+	assert.True(t, ok)
+	_, ok = operations.ValidateTotpCode(secret, operations.TotpCode(key, step+2), now, 0)
+	assert.False(t, ok)
+	// used codes can not be replayed
+	_, ok = operations.ValidateTotpCode(secret, code, now, step)
+	assert.False(t, ok)
+	_, ok = operations.ValidateTotpCode(secret, "12345", now, 0)
+	assert.False(t, ok)
+}
+
+func TestTotpUri(t *testing.T) {
+	uri := operations.TotpUri("Stash Sphere", "alice@example.com", "ABCDEF")
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Stash%20Sphere:alice@example.com?"))
+	assert.Contains(t, uri, "secret=ABCDEF")
+	assert.Contains(t, uri, "issuer=Stash+Sphere")
+}
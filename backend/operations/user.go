@@ -40,6 +40,20 @@ func PurgeUser(ctx context.Context, exec boil.ContextExecutor, userId string, im
 		return err
 	}
 
+	// Delete loans and borrow requests, both as borrower and as owner
+	if _, err := models.Loans(qm.Expr(
+		models.LoanWhere.BorrowerID.EQ(userId),
+		qm.Or2(models.LoanWhere.OwnerID.EQ(userId)),
+	)).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+	if _, err := models.BorrowRequests(qm.Expr(
+		models.BorrowRequestWhere.BorrowerID.EQ(userId),
+		qm.Or2(models.BorrowRequestWhere.OwnerID.EQ(userId)),
+	)).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
 	// Delete notifications
 	if _, err := user.R.RecipientNotifications.DeleteAll(ctx, exec); err != nil {
 		return err
@@ -136,6 +150,11 @@ func PurgeUser(ctx context.Context, exec boil.ContextExecutor, userId string, im
 		}
 	}
 
+	// Delete locations, nested locations are removed by the cascade on parent_id
+	if _, err := models.Locations(models.LocationWhere.OwnerID.EQ(userId)).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
 	// Delete profile first (it may reference images via image_id FK)
 	if user.R.Profile != nil {
 		if _, err := user.R.Profile.Delete(ctx, exec); err != nil {
@@ -210,6 +229,17 @@ func FindUserByEmail(ctx context.Context, exec boil.ContextExecutor, email strin
 	return user, nil
 }
 
+func FindUserByUnsubscribeToken(ctx context.Context, exec boil.ContextExecutor, token string) (*models.User, error) {
+	user, err := models.Users(models.UserWhere.UnsubscribeToken.EQ(null.StringFrom(token))).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.NotFoundError{EntityName: "user"}
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
 func FindUserWithProfileByID(ctx context.Context, exec boil.ContextExecutor, userId string) (*models.User, error) {
 	user, err := models.Users(models.UserWhere.ID.EQ(userId),
 		qm.Load(models.UserRels.Profile),
@@ -0,0 +1,285 @@
+package operations
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/stashsphere/backend/models"
+)
+
+const (
+	WebhookEventThingCreated         = "thing.created"
+	WebhookEventThingUpdated         = "thing.updated"
+	WebhookEventThingDeleted         = "thing.deleted"
+	WebhookEventListChanged          = "list.changed"
+	WebhookEventShareCreated         = "share.created"
+	WebhookEventFriendRequestCreated = "friend_request.created"
+	WebhookEventNotificationCreated  = "notification.created"
+	// sent on demand to check that an endpoint is reachable, can not be
+	// subscribed to
+	WebhookEventPing = "ping"
+)
+
+// WebhookEventTypes lists all event types webhooks can subscribe to
+var WebhookEventTypes = []string{
+	WebhookEventThingCreated,
+	WebhookEventThingUpdated,
+	WebhookEventThingDeleted,
+	WebhookEventListChanged,
+	WebhookEventShareCreated,
+	WebhookEventFriendRequestCreated,
+	WebhookEventNotificationCreated,
+}
+
+const (
+	// delay before the first retry, doubled with every further attempt
+	WebhookRetryBaseDelay = 30 * time.Second
+	WebhookRetryMaxDelay  = 6 * time.Hour
+	// after this many failed attempts a delivery is moved to the dead state
+	WebhookMaxAttempts = 8
+	// a claimed delivery is skipped by other workers for this long, if the
+	// worker dies while sending it is retried afterwards
+	WebhookClaimDuration = 2 * time.Minute
+)
+
+const (
+	WebhookSignatureHeader = "X-StashSphere-Signature"
+	WebhookEventHeader     = "X-StashSphere-Event"
+	WebhookDeliveryHeader  = "X-StashSphere-Delivery"
+)
+
+type WebhookPayload struct {
+	DeliveryId string    `json:"deliveryId"`
+	Event      string    `json:"event"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Data       any       `json:"data"`
+}
+
+type WebhookThingData struct {
+	ThingId string `json:"thingId"`
+	Name    string `json:"name"`
+	OwnerId string `json:"ownerId"`
+}
+
+const (
+	WebhookListCreated = "created"
+	WebhookListUpdated = "updated"
+	WebhookListDeleted = "deleted"
+)
+
+type WebhookListData struct {
+	ListId  string `json:"listId"`
+	Name    string `json:"name"`
+	OwnerId string `json:"ownerId"`
+	Change  string `json:"change"`
+}
+
+type WebhookShareData struct {
+	ShareId      string  `json:"shareId"`
+	OwnerId      string  `json:"ownerId"`
+	TargetUserId string  `json:"targetUserId"`
+	ThingId      *string `json:"thingId"`
+	ListId       *string `json:"listId"`
+}
+
+type WebhookFriendRequestData struct {
+	RequestId  string `json:"requestId"`
+	SenderId   string `json:"senderId"`
+	ReceiverId string `json:"receiverId"`
+}
+
+type WebhookNotificationData struct {
+	NotificationId string          `json:"notificationId"`
+	ContentType    string          `json:"contentType"`
+	Content        json.RawMessage `json:"content"`
+}
+
+func IsWebhookEventType(eventType string) bool {
+	for _, t := range WebhookEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func CreateWebhookDelivery(ctx context.Context, exec boil.ContextExecutor, webhookId string, event string, data any) (*models.WebhookDelivery, error) {
+	id, err := gonanoid.New()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	payload, err := json.Marshal(WebhookPayload{
+		DeliveryId: id,
+		Event:      event,
+		CreatedAt:  now,
+		Data:       data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	delivery := models.WebhookDelivery{
+		ID:            id,
+		WebhookID:     webhookId,
+		EventType:     event,
+		Payload:       string(payload),
+		State:         models.WebhookDeliveryStatePending,
+		NextAttemptAt: now,
+	}
+	err = delivery.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// EnqueueWebhookEvent creates a delivery for every active webhook of the user
+// subscribed to the event. Inside a transaction the deliveries are only sent
+// if the transaction commits.
+func EnqueueWebhookEvent(ctx context.Context, exec boil.ContextExecutor, userId string, event string, data any) error {
+	webhooks, err := models.Webhooks(
+		models.WebhookWhere.OwnerID.EQ(userId),
+		models.WebhookWhere.Active.EQ(true),
+		qm.Where("? = ANY("+models.WebhookColumns.EventTypes+")", event),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	for _, webhook := range webhooks {
+		_, err = CreateWebhookDelivery(ctx, exec, webhook.ID, event, data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnqueueThingWebhookEvent notifies the webhooks of the owner of the thing
+func EnqueueThingWebhookEvent(ctx context.Context, exec boil.ContextExecutor, event string, thing *models.Thing) error {
+	return EnqueueWebhookEvent(ctx, exec, thing.OwnerID, event, WebhookThingData{
+		ThingId: thing.ID,
+		Name:    thing.Name,
+		OwnerId: thing.OwnerID,
+	})
+}
+
+// EnqueueListWebhookEvent notifies the webhooks of the owner of the list
+func EnqueueListWebhookEvent(ctx context.Context, exec boil.ContextExecutor, change string, list *models.List) error {
+	return EnqueueWebhookEvent(ctx, exec, list.OwnerID, WebhookEventListChanged, WebhookListData{
+		ListId:  list.ID,
+		Name:    list.Name,
+		OwnerId: list.OwnerID,
+		Change:  change,
+	})
+}
+
+// EnqueueShareWebhookEvent notifies the webhooks of both the owner and the
+// target user of the share
+func EnqueueShareWebhookEvent(ctx context.Context, exec boil.ContextExecutor, share *models.Share, thingId *string, listId *string) error {
+	data := WebhookShareData{
+		ShareId:      share.ID,
+		OwnerId:      share.OwnerID,
+		TargetUserId: share.TargetUserID,
+		ThingId:      thingId,
+		ListId:       listId,
+	}
+	for _, userId := range []string{share.OwnerID, share.TargetUserID} {
+		err := EnqueueWebhookEvent(ctx, exec, userId, WebhookEventShareCreated, data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SignWebhookPayload computes the signature header value for a payload sent at
+// the given time. Receivers recompute the HMAC-SHA256 of "<t>.<body>" with
+// their secret and compare it to v1.
+func SignWebhookPayload(secret string, timestamp time.Time, payload []byte) string {
+	t := timestamp.Unix()
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", t)
+	mac.Write(payload)
+	return fmt.Sprintf("t=%d,v1=%s", t, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// ClaimNextDueWebhookDelivery claims the delivery of an active webhook waiting
+// the longest and loads its webhook. The claim postpones the next attempt by
+// WebhookClaimDuration, so the delivery can be sent after the transaction
+// committed. It returns sql.ErrNoRows if no delivery is due.
+func ClaimNextDueWebhookDelivery(ctx context.Context, exec boil.ContextExecutor) (*models.WebhookDelivery, error) {
+	now := time.Now()
+	delivery, err := models.WebhookDeliveries(
+		models.WebhookDeliveryWhere.State.EQ(models.WebhookDeliveryStatePending),
+		models.WebhookDeliveryWhere.NextAttemptAt.LTE(now),
+		// deliveries queued before the webhook was deactivated are kept
+		// until it is active again
+		qm.Where("EXISTS (SELECT 1 FROM webhooks WHERE webhooks.id = webhook_deliveries.webhook_id AND webhooks.active)"),
+		qm.OrderBy(models.WebhookDeliveryColumns.NextAttemptAt+" ASC"),
+		qm.Limit(1),
+		// deliveries being claimed by another worker are skipped
+		qm.For("UPDATE SKIP LOCKED"),
+	).One(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	delivery.NextAttemptAt = now.Add(WebhookClaimDuration)
+	_, err = delivery.Update(ctx, exec, boil.Whitelist(models.WebhookDeliveryColumns.NextAttemptAt))
+	if err != nil {
+		return nil, err
+	}
+	err = delivery.L.LoadWebhook(ctx, exec, true, delivery, nil)
+	if err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// WebhookRetryDelay returns the delay after the given number of failed attempts
+func WebhookRetryDelay(attempts int) time.Duration {
+	return retryDelay(WebhookRetryBaseDelay, WebhookRetryMaxDelay, attempts)
+}
+
+func MarkWebhookDeliveryDelivered(ctx context.Context, exec boil.ContextExecutor, delivery *models.WebhookDelivery, responseCode int) error {
+	delivery.Attempts++
+	delivery.State = models.WebhookDeliveryStateDelivered
+	delivery.ResponseCode = null.IntFrom(responseCode)
+	delivery.LastError = null.StringFromPtr(nil)
+	delivery.DeliveredAt = null.TimeFrom(time.Now())
+	_, err := delivery.Update(ctx, exec, boil.Infer())
+	return err
+}
+
+// MarkWebhookDeliveryFailed schedules the next attempt or moves the delivery
+// to the dead state if it ran out of attempts. responseCode is nil if no
+// response was received.
+func MarkWebhookDeliveryFailed(ctx context.Context, exec boil.ContextExecutor, delivery *models.WebhookDelivery, responseCode *int, deliveryErr error) error {
+	delivery.Attempts++
+	delivery.ResponseCode = null.IntFromPtr(responseCode)
+	delivery.LastError = null.StringFrom(deliveryErr.Error())
+	if delivery.Attempts >= WebhookMaxAttempts {
+		delivery.State = models.WebhookDeliveryStateDead
+	} else {
+		delivery.NextAttemptAt = time.Now().Add(WebhookRetryDelay(delivery.Attempts))
+	}
+	_, err := delivery.Update(ctx, exec, boil.Infer())
+	return err
+}
+
+// PurgeWebhookDeliveries deletes deliveries which are no longer pending and
+// were created before the given time
+func PurgeWebhookDeliveries(ctx context.Context, exec boil.ContextExecutor, createdBefore time.Time) (int64, error) {
+	return models.WebhookDeliveries(
+		models.WebhookDeliveryWhere.State.NEQ(models.WebhookDeliveryStatePending),
+		models.WebhookDeliveryWhere.CreatedAt.LT(createdBefore),
+	).DeleteAll(ctx, exec)
+}
@@ -0,0 +1,28 @@
+package operations_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stashsphere/backend/operations"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignWebhookPayload(t *testing.T) {
+	body := []byte(`{"event":"ping"}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1700000000."))
+	mac.Write(body)
+	expected := "t=1700000000,v1=" + hex.EncodeToString(mac.Sum(nil))
+	assert.Equal(t, expected, operations.SignWebhookPayload("secret", time.Unix(1700000000, 0), body))
+	assert.NotEqual(t, expected, operations.SignWebhookPayload("other", time.Unix(1700000000, 0), body))
+}
+
+func TestWebhookRetryDelay(t *testing.T) {
+	assert.Equal(t, 30*time.Second, operations.WebhookRetryDelay(1))
+	assert.Equal(t, 2*time.Minute, operations.WebhookRetryDelay(3))
+	assert.Equal(t, operations.WebhookRetryMaxDelay, operations.WebhookRetryDelay(20))
+}
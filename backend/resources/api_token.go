@@ -0,0 +1,45 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/stashsphere/backend/models"
+)
+
+type ApiToken struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// start of the token to tell tokens apart
+	TokenPrefix string     `json:"tokenPrefix"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expiresAt"`
+	LastUsedAt  *time.Time `json:"lastUsedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// ApiTokenWithToken is returned once after creating a token, the token can
+// not be retrieved later
+type ApiTokenWithToken struct {
+	ApiToken
+	Token string `json:"token"`
+}
+
+func ApiTokenFromModel(apiToken *models.APIToken) ApiToken {
+	return ApiToken{
+		ID:          apiToken.ID,
+		Name:        apiToken.Name,
+		TokenPrefix: apiToken.TokenPrefix,
+		Scopes:      apiToken.Scopes,
+		ExpiresAt:   apiToken.ExpiresAt.Ptr(),
+		LastUsedAt:  apiToken.LastUsedAt.Ptr(),
+		CreatedAt:   apiToken.CreatedAt,
+	}
+}
+
+func ApiTokensFromModelSlice(mApiTokens models.APITokenSlice) []ApiToken {
+	apiTokens := make([]ApiToken, len(mApiTokens))
+	for i, apiToken := range mApiTokens {
+		apiTokens[i] = ApiTokenFromModel(apiToken)
+	}
+	return apiTokens
+}
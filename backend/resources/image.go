@@ -92,4 +92,5 @@ type PaginatedImages struct {
 	Page           uint64  `json:"page"`
 	TotalPageCount uint64  `json:"totalPageCount"`
 	TotalCount     uint64  `json:"totalCount"`
+	NextCursor     *string `json:"nextCursor"`
 }
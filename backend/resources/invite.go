@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/stashsphere/backend/models"
+)
+
+type Invite struct {
+	ID        string     `json:"id"`
+	Code      string     `json:"code"`
+	Url       string     `json:"url"`
+	MaxUses   int        `json:"maxUses"`
+	Uses      int        `json:"uses"`
+	Email     *string    `json:"email"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+	// users who registered with the invite
+	InvitedUsers []User `json:"invitedUsers"`
+}
+
+func InviteFromModel(invite *models.Invite, url string) Invite {
+	invitedUsers := []User{}
+	if invite.R != nil && invite.R.Users != nil {
+		invitedUsers = UsersFromModelSlice(invite.R.Users)
+	}
+	return Invite{
+		ID:           invite.ID,
+		Code:         invite.Code,
+		Url:          url,
+		MaxUses:      invite.MaxUses,
+		Uses:         invite.Uses,
+		Email:        invite.Email.Ptr(),
+		ExpiresAt:    invite.ExpiresAt.Ptr(),
+		CreatedAt:    invite.CreatedAt,
+		InvitedUsers: invitedUsers,
+	}
+}
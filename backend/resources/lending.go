@@ -0,0 +1,104 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/services"
+)
+
+type BorrowRequest struct {
+	Id             string       `json:"id"`
+	Thing          ReducedThing `json:"thing"`
+	Borrower       User         `json:"borrower"`
+	Owner          User         `json:"owner"`
+	State          string       `json:"state"`
+	RequestedDueAt *time.Time   `json:"requestedDueAt"`
+	CreatedAt      time.Time    `json:"createdAt"`
+}
+
+func BorrowRequestFromModel(model *models.BorrowRequest, userId string) *BorrowRequest {
+	var requestedDueAt *time.Time
+	if model.RequestedDueAt.Valid {
+		requestedDueAt = &model.RequestedDueAt.Time
+	}
+	return &BorrowRequest{
+		Id:             model.ID,
+		Thing:          *ReducedThingFromModel(model.R.Thing, userId),
+		Borrower:       UserFromModel(model.R.Borrower),
+		Owner:          UserFromModel(model.R.Owner),
+		State:          model.State.String(),
+		RequestedDueAt: requestedDueAt,
+		CreatedAt:      model.CreatedAt,
+	}
+}
+
+func BorrowRequestsFromModelSlice(mRequests models.BorrowRequestSlice, userId string) []BorrowRequest {
+	requests := make([]BorrowRequest, len(mRequests))
+	for i, model := range mRequests {
+		requests[i] = *BorrowRequestFromModel(model, userId)
+	}
+	return requests
+}
+
+type BorrowRequestsResponse struct {
+	Received []BorrowRequest `json:"received"`
+	Sent     []BorrowRequest `json:"sent"`
+}
+
+func BorrowRequestsResponseFromResult(result *services.BorrowRequestsResult, userId string) *BorrowRequestsResponse {
+	return &BorrowRequestsResponse{
+		Received: BorrowRequestsFromModelSlice(result.Received, userId),
+		Sent:     BorrowRequestsFromModelSlice(result.Sent, userId),
+	}
+}
+
+type Loan struct {
+	Id              string       `json:"id"`
+	BorrowRequestId string       `json:"borrowRequestId"`
+	Thing           ReducedThing `json:"thing"`
+	Borrower        User         `json:"borrower"`
+	Owner           User         `json:"owner"`
+	State           string       `json:"state"`
+	LentAt          time.Time    `json:"lentAt"`
+	DueAt           time.Time    `json:"dueAt"`
+	ReturnedAt      *time.Time   `json:"returnedAt"`
+}
+
+func LoanFromModel(model *models.Loan, userId string) *Loan {
+	var returnedAt *time.Time
+	if model.ReturnedAt.Valid {
+		returnedAt = &model.ReturnedAt.Time
+	}
+	return &Loan{
+		Id:              model.ID,
+		BorrowRequestId: model.BorrowRequestID,
+		Thing:           *ReducedThingFromModel(model.R.Thing, userId),
+		Borrower:        UserFromModel(model.R.Borrower),
+		Owner:           UserFromModel(model.R.Owner),
+		State:           model.State.String(),
+		LentAt:          model.LentAt,
+		DueAt:           model.DueAt,
+		ReturnedAt:      returnedAt,
+	}
+}
+
+func LoansFromModelSlice(mLoans models.LoanSlice, userId string) []Loan {
+	loans := make([]Loan, len(mLoans))
+	for i, model := range mLoans {
+		loans[i] = *LoanFromModel(model, userId)
+	}
+	return loans
+}
+
+type LoansResponse struct {
+	Lent     []Loan `json:"lent"`
+	Borrowed []Loan `json:"borrowed"`
+}
+
+func LoansResponseFromResult(result *services.LoansResult, userId string) *LoansResponse {
+	return &LoansResponse{
+		Lent:     LoansFromModelSlice(result.Lent, userId),
+		Borrowed: LoansFromModelSlice(result.Borrowed, userId),
+	}
+}
@@ -10,6 +10,7 @@ type List struct {
 	ID           string         `json:"id"`
 	Name         string         `json:"name"`
 	CreatedAt    time.Time      `json:"createdAt"`
+	UpdatedAt    time.Time      `json:"updatedAt"`
 	Owner        User           `json:"owner"`
 	Things       []Thing        `json:"things"`
 	Actions      Actions        `json:"actions"`
@@ -41,6 +42,7 @@ func ListFromModel(list *models.List, userId string, sharedListIds []string) Lis
 		ID:           list.ID,
 		Name:         list.Name,
 		CreatedAt:    list.CreatedAt,
+		UpdatedAt:    list.UpdatedAt,
 		Owner:        UserFromModel(list.R.Owner),
 		Things:       thingResources,
 		Shares:       shares,
@@ -104,9 +106,10 @@ func ReducedListsFromModelSlice(lists models.ListSlice, userId string) []Reduced
 }
 
 type PaginatedLists struct {
-	Things         []List `json:"lists"`
-	PerPage        uint64 `json:"perPage"`
-	Page           uint64 `json:"page"`
-	TotalPageCount uint64 `json:"totalPageCount"`
-	TotalCount     uint64 `json:"totalCount"`
+	Things         []List  `json:"lists"`
+	PerPage        uint64  `json:"perPage"`
+	Page           uint64  `json:"page"`
+	TotalPageCount uint64  `json:"totalPageCount"`
+	TotalCount     uint64  `json:"totalCount"`
+	NextCursor     *string `json:"nextCursor"`
 }
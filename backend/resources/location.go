@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/services"
+)
+
+type ReducedLocation struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// LocationPathFromModel walks up the loaded parents of the location and
+// returns the path starting at the outermost location.
+func LocationPathFromModel(location *models.Location) []ReducedLocation {
+	path := []ReducedLocation{}
+	for location != nil {
+		path = append([]ReducedLocation{{ID: location.ID, Name: location.Name}}, path...)
+		if location.R == nil {
+			break
+		}
+		location = location.R.Parent
+	}
+	return path
+}
+
+type Location struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	Owner        User              `json:"owner"`
+	ParentId     *string           `json:"parentId"`
+	Path         []ReducedLocation `json:"path"`
+	SharingState *string           `json:"sharingState"`
+	Actions      Actions           `json:"actions"`
+}
+
+func LocationFromModel(location *models.Location, userId string) *Location {
+	var sharingState *string
+	sharingStateString := location.SharingState.String()
+	if location.OwnerID == userId {
+		sharingState = &sharingStateString
+	}
+	return &Location{
+		ID:           location.ID,
+		Name:         location.Name,
+		Description:  location.Description,
+		CreatedAt:    location.CreatedAt,
+		Owner:        UserFromModel(location.R.Owner),
+		ParentId:     location.ParentID.Ptr(),
+		Path:         LocationPathFromModel(location),
+		SharingState: sharingState,
+		Actions: Actions{
+			CanEdit:   location.OwnerID == userId,
+			CanDelete: location.OwnerID == userId,
+			CanShare:  location.OwnerID == userId,
+		},
+	}
+}
+
+func LocationsFromModelSlice(mLocations models.LocationSlice, userId string) []Location {
+	locations := make([]Location, len(mLocations))
+	for i, location := range mLocations {
+		locations[i] = *LocationFromModel(location, userId)
+	}
+	return locations
+}
+
+type LocationTree struct {
+	Location
+	Children []LocationTree `json:"children"`
+	Things   []ReducedThing `json:"things"`
+}
+
+func LocationTreeFromResult(tree *services.LocationTree, userId string) LocationTree {
+	children := make([]LocationTree, len(tree.Children))
+	for i, child := range tree.Children {
+		children[i] = LocationTreeFromResult(child, userId)
+	}
+	return LocationTree{
+		Location: *LocationFromModel(tree.Location, userId),
+		Children: children,
+		Things:   ReducedThingsFromModelSlice(tree.Things, userId),
+	}
+}
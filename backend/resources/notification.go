@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/notifications"
+	"github.com/stashsphere/backend/services"
 )
 
 type Notification struct {
@@ -20,6 +22,7 @@ type PaginatedNotifications struct {
 	Page           uint64         `json:"page"`
 	TotalPageCount uint64         `json:"totalPageCount"`
 	TotalCount     uint64         `json:"totalCount"`
+	NextCursor     *string        `json:"nextCursor"`
 }
 
 func NotificationFromModel(model *models.Notification) *Notification {
@@ -39,3 +42,34 @@ func NotificationsFromModelSlice(mNotifications models.NotificationSlice) []Noti
 	}
 	return notifications
 }
+
+type NotificationPreference struct {
+	ContentType string `json:"contentType"`
+	Channel     string `json:"channel"`
+}
+
+type NotificationPreferences struct {
+	Preferences     []NotificationPreference `json:"preferences"`
+	DigestFrequency string                   `json:"digestFrequency"`
+}
+
+// NotificationPreferencesFromService lists the channels in the order of
+// notifications.ContentTypes
+func NotificationPreferencesFromService(serviceModel *services.NotificationPreferences) NotificationPreferences {
+	channels := serviceModel.Channels
+	preferences := make([]NotificationPreference, 0, len(channels))
+	for _, contentType := range notifications.ContentTypes {
+		channel, ok := channels[contentType]
+		if !ok {
+			continue
+		}
+		preferences = append(preferences, NotificationPreference{
+			ContentType: contentType,
+			Channel:     string(channel),
+		})
+	}
+	return NotificationPreferences{
+		Preferences:     preferences,
+		DigestFrequency: string(serviceModel.DigestFrequency),
+	}
+}
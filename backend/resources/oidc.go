@@ -0,0 +1,6 @@
+package resources
+
+type OidcLogin struct {
+	// url of the provider the browser is sent to
+	AuthorizationUrl string `json:"authorizationUrl"`
+}
@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/stashsphere/backend/models"
+)
+
+type Passkey struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+func PasskeyFromModel(passkey *models.Passkey) Passkey {
+	return Passkey{
+		ID:         passkey.ID,
+		Name:       passkey.Name,
+		LastUsedAt: passkey.LastUsedAt.Ptr(),
+		CreatedAt:  passkey.CreatedAt,
+	}
+}
+
+func PasskeysFromModelSlice(mPasskeys models.PasskeySlice) []Passkey {
+	passkeys := make([]Passkey, len(mPasskeys))
+	for i, passkey := range mPasskeys {
+		passkeys[i] = PasskeyFromModel(passkey)
+	}
+	return passkeys
+}
+
+// PasskeyRegistrationOptions are passed to navigator.credentials.create
+type PasskeyRegistrationOptions struct {
+	CeremonyId string                       `json:"ceremonyId"`
+	Options    *protocol.CredentialCreation `json:"options"`
+}
+
+// PasskeyLoginOptions are passed to navigator.credentials.get
+type PasskeyLoginOptions struct {
+	CeremonyId string                        `json:"ceremonyId"`
+	Options    *protocol.CredentialAssertion `json:"options"`
+}
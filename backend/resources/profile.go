@@ -15,7 +15,12 @@ type Profile struct {
 	Email         string        `json:"email"`
 	Image         *ReducedImage `json:"image"`
 	PurgeAt       *time.Time    `json:"purgeAt"`
+	Locale        string        `json:"locale"`
 	EmailVerified *bool         `json:"emailVerified,omitempty"`
+	// whether logins require a TOTP code
+	TwoFactorEnabled bool `json:"twoFactorEnabled"`
+	// new email address waiting for its verification code
+	PendingEmail *string `json:"pendingEmail,omitempty"`
 }
 
 func ProfileFromUserContext(ctx *middleware.UserContext) Profile {
@@ -45,13 +50,15 @@ func ProfileFromModel(user *models.User) Profile {
 	}
 
 	return Profile{
-		ID:          user.ID,
-		Name:        user.Name,
-		Email:       user.Email,
-		Image:       image,
-		FullName:    fullName,
-		Information: information,
-		PurgeAt:     purgeAt,
+		ID:               user.ID,
+		Name:             user.Name,
+		Email:            user.Email,
+		Image:            image,
+		FullName:         fullName,
+		Information:      information,
+		PurgeAt:          purgeAt,
+		Locale:           user.Locale,
+		TwoFactorEnabled: user.TotpEnabledAt.Valid,
 	}
 }
 
@@ -61,6 +68,13 @@ func (p Profile) WithEmailVerification(verification *models.EmailVerification) P
 	return p
 }
 
+func (p Profile) WithPendingEmail(user *models.User) Profile {
+	if user.PendingEmail.Valid {
+		p.PendingEmail = &user.PendingEmail.String
+	}
+	return p
+}
+
 func ProfilesFromModelSlice(mProfiles models.UserSlice) []Profile {
 	profiles := make([]Profile, len(mProfiles))
 	for i, profile := range mProfiles {
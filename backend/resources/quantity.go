@@ -0,0 +1,55 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/stashsphere/backend/models"
+)
+
+type QuantityEntry struct {
+	ID        string    `json:"id"`
+	Delta     int64     `json:"delta"`
+	Reason    *string   `json:"reason"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"createdAt"`
+	// nil when the user who made the change has been deleted
+	CreatedBy *User `json:"createdBy"`
+}
+
+// requires an entry loaded with CreatedBy
+func QuantityEntryFromModel(entry *models.QuantityEntry) QuantityEntry {
+	var reason *string
+	if entry.Reason.Valid {
+		reasonString := entry.Reason.Val.String()
+		reason = &reasonString
+	}
+	var createdBy *User
+	if entry.R != nil && entry.R.CreatedBy != nil {
+		user := UserFromModel(entry.R.CreatedBy)
+		createdBy = &user
+	}
+	return QuantityEntry{
+		ID:        entry.ID,
+		Delta:     entry.DeltaValue,
+		Reason:    reason,
+		Note:      entry.Note,
+		CreatedAt: entry.CreatedAt,
+		CreatedBy: createdBy,
+	}
+}
+
+func QuantityEntriesFromModelSlice(mEntries models.QuantityEntrySlice) []QuantityEntry {
+	entries := make([]QuantityEntry, len(mEntries))
+	for i, entry := range mEntries {
+		entries[i] = QuantityEntryFromModel(entry)
+	}
+	return entries
+}
+
+type PaginatedQuantityEntries struct {
+	Entries        []QuantityEntry `json:"entries"`
+	PerPage        uint64          `json:"perPage"`
+	Page           uint64          `json:"page"`
+	TotalPageCount uint64          `json:"totalPageCount"`
+	TotalCount     uint64          `json:"totalCount"`
+}
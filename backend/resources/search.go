@@ -2,14 +2,54 @@ package resources
 
 import "github.com/stashsphere/backend/services"
 
+type ThingSearchHit struct {
+	Thing
+	Rank    float64 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+type ListSearchHit struct {
+	List
+	Rank    float64 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
 type SearchResult struct {
-	Things []Thing `json:"things"`
-	Lists  []List  `json:"lists"`
+	Things              []ThingSearchHit `json:"things"`
+	Lists               []ListSearchHit  `json:"lists"`
+	PerPage             uint64           `json:"perPage"`
+	Page                uint64           `json:"page"`
+	TotalThingCount     uint64           `json:"totalThingCount"`
+	TotalListCount      uint64           `json:"totalListCount"`
+	TotalThingPageCount uint64           `json:"totalThingPageCount"`
+	TotalListPageCount  uint64           `json:"totalListPageCount"`
 }
 
-func SearchResultsFromModel(result *services.SearchResult, userId string, sharedListIds []string) *SearchResult {
+func SearchResultsFromModel(result *services.SearchResult, userId string, sharedListIds []string, perPage uint64, page uint64) *SearchResult {
+	things := make([]ThingSearchHit, len(result.Things))
+	for i, hit := range result.Things {
+		things[i] = ThingSearchHit{
+			Thing:   *ThingFromModel(hit.Thing, userId, sharedListIds),
+			Rank:    hit.Rank,
+			Snippet: hit.Snippet,
+		}
+	}
+	lists := make([]ListSearchHit, len(result.Lists))
+	for i, hit := range result.Lists {
+		lists[i] = ListSearchHit{
+			List:    ListFromModel(hit.List, userId, sharedListIds),
+			Rank:    hit.Rank,
+			Snippet: hit.Snippet,
+		}
+	}
 	return &SearchResult{
-		Things: ThingsFromModel(result.Things, userId, sharedListIds),
-		Lists:  ListsFromModel(result.Lists, userId, sharedListIds),
+		Things:              things,
+		Lists:               lists,
+		PerPage:             perPage,
+		Page:                page,
+		TotalThingCount:     result.ThingCount,
+		TotalListCount:      result.ListCount,
+		TotalThingPageCount: result.ThingPageCount,
+		TotalListPageCount:  result.ListPageCount,
 	}
 }
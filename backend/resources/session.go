@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/stashsphere/backend/models"
+)
+
+type Session struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"userAgent"`
+	IPAddress  string    `json:"ipAddress"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+	// whether the session is the one of the request
+	Current bool `json:"current"`
+}
+
+func SessionFromModel(session *models.Session, currentSessionId string) Session {
+	return Session{
+		ID:         session.ID,
+		UserAgent:  session.UserAgent,
+		IPAddress:  session.IPAddress,
+		LastSeenAt: session.LastSeenAt,
+		ExpiresAt:  session.ExpiresAt,
+		CreatedAt:  session.CreatedAt,
+		Current:    session.ID == currentSessionId,
+	}
+}
+
+func SessionsFromModelSlice(mSessions models.SessionSlice, currentSessionId string) []Session {
+	sessions := make([]Session, len(mSessions))
+	for i, session := range mSessions {
+		sessions[i] = SessionFromModel(session, currentSessionId)
+	}
+	return sessions
+}
@@ -9,20 +9,23 @@ import (
 )
 
 type Thing struct {
-	ID           string         `json:"id"`
-	Name         string         `json:"name"`
-	Description  string         `json:"description"`
-	PrivateNote  *string        `json:"privateNote"`
-	CreatedAt    time.Time      `json:"createdAt"`
-	Owner        User           `json:"owner"`
-	Lists        []ReducedList  `json:"lists"`
-	Images       []ReducedImage `json:"images"`
-	Properties   []interface{}  `json:"properties"`
-	Shares       []ReducedShare `json:"shares"`
-	SharingState *string        `json:"sharingState"`
-	Actions      Actions        `json:"actions"`
-	Quantity     int64          `json:"quantity"`
-	QuantityUnit string         `json:"quantityUnit"`
+	ID                string            `json:"id"`
+	Name              string            `json:"name"`
+	Description       string            `json:"description"`
+	PrivateNote       *string           `json:"privateNote"`
+	CreatedAt         time.Time         `json:"createdAt"`
+	UpdatedAt         time.Time         `json:"updatedAt"`
+	Owner             User              `json:"owner"`
+	Lists             []ReducedList     `json:"lists"`
+	Images            []ReducedImage    `json:"images"`
+	Properties        []interface{}     `json:"properties"`
+	Shares            []ReducedShare    `json:"shares"`
+	SharingState      *string           `json:"sharingState"`
+	Actions           Actions           `json:"actions"`
+	Quantity          int64             `json:"quantity"`
+	QuantityUnit      string            `json:"quantityUnit"`
+	LowStockThreshold *int64            `json:"lowStockThreshold"`
+	LocationPath      []ReducedLocation `json:"locationPath"`
 }
 
 func SumQuantityEntries(entries models.QuantityEntrySlice) int64 {
@@ -79,6 +82,7 @@ func ThingFromModel(thing *models.Thing, userId string, sharedListIds []string)
 		PrivateNote:  privateNote,
 		Description:  thing.Description,
 		CreatedAt:    thing.CreatedAt,
+		UpdatedAt:    thing.UpdatedAt,
 		Owner:        UserFromModel(thing.R.Owner),
 		Lists:        filteredLists,
 		Images:       ReducedImagesFromModel(images),
@@ -90,8 +94,10 @@ func ThingFromModel(thing *models.Thing, userId string, sharedListIds []string)
 			CanDelete: canDelete,
 			CanShare:  canShare,
 		},
-		Quantity:     SumQuantityEntries(thing.R.QuantityEntries),
-		QuantityUnit: thing.QuantityUnit,
+		Quantity:          SumQuantityEntries(thing.R.QuantityEntries),
+		QuantityUnit:      thing.QuantityUnit,
+		LowStockThreshold: thing.LowStockThreshold.Ptr(),
+		LocationPath:      LocationPathFromModel(thing.R.Location),
 	}
 }
 
@@ -159,4 +165,5 @@ type PaginatedThings struct {
 	Page           uint64  `json:"page"`
 	TotalPageCount uint64  `json:"totalPageCount"`
 	TotalCount     uint64  `json:"totalCount"`
+	NextCursor     *string `json:"nextCursor"`
 }
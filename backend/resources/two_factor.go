@@ -0,0 +1,34 @@
+package resources
+
+import (
+	"encoding/base64"
+
+	"github.com/stashsphere/backend/services"
+)
+
+type TotpEnrollment struct {
+	Secret string `json:"secret"`
+	// otpauth URI to import the secret into authenticator apps
+	Uri string `json:"uri"`
+	// QR code of the URI as PNG data URI
+	QrCode string `json:"qrCode"`
+}
+
+func TotpEnrollmentFromService(enrollment *services.TotpEnrollment) TotpEnrollment {
+	return TotpEnrollment{
+		Secret: enrollment.Secret,
+		Uri:    enrollment.Uri,
+		QrCode: "data:image/png;base64," + base64.StdEncoding.EncodeToString(enrollment.QrCode),
+	}
+}
+
+// RecoveryCodes are only shown once, each can replace a TOTP code once
+type RecoveryCodes struct {
+	Codes []string `json:"codes"`
+}
+
+// TwoFactorChallenge is returned by the login of users with two-factor
+// authentication
+type TwoFactorChallenge struct {
+	ChallengeToken string `json:"challengeToken"`
+}
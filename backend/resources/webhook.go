@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/stashsphere/backend/models"
+)
+
+type Webhook struct {
+	ID         string    `json:"id"`
+	Url        string    `json:"url"`
+	EventTypes []string  `json:"eventTypes"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
+
+// WebhookWithSecret is returned once after creating a webhook, afterwards
+// the secret can only be replaced
+type WebhookWithSecret struct {
+	Webhook
+	Secret string `json:"secret"`
+}
+
+func WebhookFromModel(webhook *models.Webhook) Webhook {
+	return Webhook{
+		ID:         webhook.ID,
+		Url:        webhook.URL,
+		EventTypes: webhook.EventTypes,
+		Active:     webhook.Active,
+		CreatedAt:  webhook.CreatedAt,
+		UpdatedAt:  webhook.UpdatedAt,
+	}
+}
+
+func WebhooksFromModelSlice(mWebhooks models.WebhookSlice) []Webhook {
+	webhooks := make([]Webhook, len(mWebhooks))
+	for i, webhook := range mWebhooks {
+		webhooks[i] = WebhookFromModel(webhook)
+	}
+	return webhooks
+}
+
+type WebhookDelivery struct {
+	ID            string          `json:"id"`
+	EventType     string          `json:"eventType"`
+	State         string          `json:"state"`
+	Attempts      int             `json:"attempts"`
+	ResponseCode  *int            `json:"responseCode"`
+	LastError     *string         `json:"lastError"`
+	Payload       json.RawMessage `json:"payload"`
+	NextAttemptAt time.Time       `json:"nextAttemptAt"`
+	DeliveredAt   *time.Time      `json:"deliveredAt"`
+	CreatedAt     time.Time       `json:"createdAt"`
+}
+
+func WebhookDeliveryFromModel(delivery *models.WebhookDelivery) WebhookDelivery {
+	return WebhookDelivery{
+		ID:            delivery.ID,
+		EventType:     delivery.EventType,
+		State:         delivery.State.String(),
+		Attempts:      delivery.Attempts,
+		ResponseCode:  delivery.ResponseCode.Ptr(),
+		LastError:     delivery.LastError.Ptr(),
+		Payload:       json.RawMessage(delivery.Payload),
+		NextAttemptAt: delivery.NextAttemptAt,
+		DeliveredAt:   delivery.DeliveredAt.Ptr(),
+		CreatedAt:     delivery.CreatedAt,
+	}
+}
+
+func WebhookDeliveriesFromModelSlice(mDeliveries models.WebhookDeliverySlice) []WebhookDelivery {
+	deliveries := make([]WebhookDelivery, len(mDeliveries))
+	for i, delivery := range mDeliveries {
+		deliveries[i] = WebhookDeliveryFromModel(delivery)
+	}
+	return deliveries
+}
+
+type PaginatedWebhookDeliveries struct {
+	Deliveries     []WebhookDelivery `json:"deliveries"`
+	PerPage        uint64            `json:"perPage"`
+	Page           uint64            `json:"page"`
+	TotalPageCount uint64            `json:"totalPageCount"`
+	TotalCount     uint64            `json:"totalCount"`
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+)
+
+// ApiTokenService manages the personal access tokens users authenticate
+// scripts with
+type ApiTokenService struct {
+	db *sql.DB
+}
+
+func NewApiTokenService(db *sql.DB) *ApiTokenService {
+	return &ApiTokenService{db}
+}
+
+type CreateApiTokenParams struct {
+	OwnerId string
+	Name    string
+	Scopes  []string
+	// the token does not expire if nil
+	ExpiresAt *time.Time
+}
+
+// CreateApiToken returns the new token and its value, which is only available
+// now
+func (as *ApiTokenService) CreateApiToken(ctx context.Context, params CreateApiTokenParams) (*models.APIToken, string, error) {
+	if params.Name == "" {
+		return nil, "", utils.ParameterError{Err: errors.New("api token name must not be empty")}
+	}
+	if len(params.Scopes) == 0 {
+		return nil, "", utils.ParameterError{Err: errors.New("api token must have at least one scope")}
+	}
+	for _, scope := range params.Scopes {
+		if !operations.IsApiTokenScope(scope) {
+			return nil, "", utils.ParameterError{Err: fmt.Errorf("unknown api token scope %s", scope)}
+		}
+	}
+	if params.ExpiresAt != nil && !params.ExpiresAt.After(time.Now()) {
+		return nil, "", utils.ParameterError{Err: errors.New("api token expiry must be in the future")}
+	}
+	tokenId, err := gonanoid.New()
+	if err != nil {
+		return nil, "", err
+	}
+	return operations.CreateApiToken(ctx, as.db, tokenId, operations.CreateApiTokenParams{
+		OwnerId:   params.OwnerId,
+		Name:      params.Name,
+		Scopes:    params.Scopes,
+		ExpiresAt: params.ExpiresAt,
+	})
+}
+
+func (as *ApiTokenService) GetApiTokensForUser(ctx context.Context, userId string) (models.APITokenSlice, error) {
+	return models.APITokens(
+		models.APITokenWhere.OwnerID.EQ(userId),
+		qm.OrderBy(models.APITokenColumns.CreatedAt+" ASC"),
+	).All(ctx, as.db)
+}
+
+// DeleteApiToken revokes the token
+func (as *ApiTokenService) DeleteApiToken(ctx context.Context, tokenId string, userId string) error {
+	apiToken, err := models.FindAPIToken(ctx, as.db, tokenId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return utils.NotFoundError{EntityName: "ApiToken"}
+		}
+		return err
+	}
+	if apiToken.OwnerID != userId {
+		return utils.EntityDoesNotBelongToUserError{}
+	}
+	_, err = apiToken.Delete(ctx, as.db)
+	return err
+}
+
+// AuthenticateApiToken returns the token with its owner if it is valid and
+// has the scope required for the request, and records its use
+func (as *ApiTokenService) AuthenticateApiToken(ctx context.Context, token string, method string, path string) (*models.APIToken, error) {
+	now := time.Now()
+	apiToken, err := operations.FindValidApiToken(ctx, as.db, token, now)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.NotAuthenticatedError{}
+		}
+		return nil, err
+	}
+	scope, allowed := operations.RequiredApiTokenScope(method, path)
+	if !allowed || !slices.Contains(apiToken.Scopes, scope) {
+		return nil, utils.InsufficientScopeError{Scope: scope}
+	}
+	err = operations.TouchApiToken(ctx, as.db, apiToken, now)
+	if err != nil {
+		return nil, err
+	}
+	return apiToken, nil
+}
@@ -0,0 +1,79 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiTokens(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	apiTokenService := services.NewApiTokenService(db)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	_, _, err = apiTokenService.CreateApiToken(context.Background(), services.CreateApiTokenParams{
+		OwnerId: alice.ID,
+		Name:    "backup",
+		Scopes:  []string{"everything"},
+	})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+	past := time.Now().Add(-time.Hour)
+	_, _, err = apiTokenService.CreateApiToken(context.Background(), services.CreateApiTokenParams{
+		OwnerId:   alice.ID,
+		Name:      "backup",
+		Scopes:    []string{operations.ApiTokenScopeRead},
+		ExpiresAt: &past,
+	})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+
+	apiToken, token, err := apiTokenService.CreateApiToken(context.Background(), services.CreateApiTokenParams{
+		OwnerId: alice.ID,
+		Name:    "backup",
+		Scopes:  []string{operations.ApiTokenScopeRead},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, token[:len(apiToken.TokenPrefix)], apiToken.TokenPrefix)
+
+	authenticated, err := apiTokenService.AuthenticateApiToken(context.Background(), token, http.MethodGet, "/api/things")
+	assert.NoError(t, err)
+	assert.Equal(t, alice.ID, authenticated.R.Owner.ID)
+	assert.True(t, authenticated.LastUsedAt.Valid)
+
+	_, err = apiTokenService.AuthenticateApiToken(context.Background(), token, http.MethodPost, "/api/things")
+	assert.ErrorIs(t, err, utils.InsufficientScopeError{Scope: operations.ApiTokenScopeThingsWrite})
+	_, err = apiTokenService.AuthenticateApiToken(context.Background(), token+"x", http.MethodGet, "/api/things")
+	assert.ErrorIs(t, err, utils.NotAuthenticatedError{})
+
+	tokens, err := apiTokenService.GetApiTokensForUser(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 1)
+	assert.True(t, tokens[0].LastUsedAt.Valid)
+
+	err = apiTokenService.DeleteApiToken(context.Background(), apiToken.ID, bob.ID)
+	assert.ErrorIs(t, err, utils.EntityDoesNotBelongToUserError{})
+	err = apiTokenService.DeleteApiToken(context.Background(), apiToken.ID, alice.ID)
+	assert.NoError(t, err)
+	_, err = apiTokenService.AuthenticateApiToken(context.Background(), token, http.MethodGet, "/api/things")
+	assert.ErrorIs(t, err, utils.NotAuthenticatedError{})
+}
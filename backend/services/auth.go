@@ -4,14 +4,21 @@ import (
 	"context"
 	"crypto/ed25519"
 	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/rs/zerolog/log"
 	"github.com/stashsphere/backend/models"
 	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
 )
 
+// time to enter the second factor after the password
+const challengeTokenLifetime = 5 * time.Minute
+
 type AuthService struct {
 	db                   *sql.DB
 	privateKey           ed25519.PrivateKey
@@ -34,16 +41,92 @@ func NewAuthService(db *sql.DB, privateKey ed25519.PrivateKey, publicKey ed25519
 	}
 }
 
-func (as *AuthService) AuthorizeUser(ctx context.Context, email string, password string) (*models.User, string, string, string, string, error) {
+// issueTokens creates the access and refresh tokens for the session
+func (as *AuthService) issueTokens(user *models.User, session *models.Session) (string, string, string, string, error) {
+	now := time.Now()
+	accessToken, infoToken, err := operations.CreateJWTAccessTokenForUser(user, session.ID, as.privateKey, now, as.accessTokenLifeTime)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	refreshToken, refreshInfoToken, err := operations.CreateJWTRefreshTokenForUser(user, session, as.privateKey, now)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	return accessToken, infoToken, refreshToken, refreshInfoToken, nil
+}
+
+// StartSession creates a session for the authenticated user and returns its
+// tokens
+func (as *AuthService) StartSession(ctx context.Context, user *models.User, client operations.SessionClient) (string, string, string, string, error) {
+	sessionId, err := gonanoid.New()
+	if err != nil {
+		return "", "", "", "", err
+	}
+	refreshTokenId, err := gonanoid.New()
+	if err != nil {
+		return "", "", "", "", err
+	}
+	session, err := operations.CreateSession(ctx, as.db, sessionId, user.ID, refreshTokenId, client, time.Now().Add(as.refreshTokenLifeTime))
+	if err != nil {
+		return "", "", "", "", err
+	}
+	return as.issueTokens(user, session)
+}
+
+// AuthorizeUser starts a session if the password matches. Users with
+// two-factor authentication get a utils.TwoFactorRequiredError instead, which
+// is completed with CompleteTwoFactorLogin.
+func (as *AuthService) AuthorizeUser(ctx context.Context, email string, password string, client operations.SessionClient) (*models.User, string, string, string, string, error) {
 	user, err := operations.AuthenticateUserByEmail(as.db, ctx, email, password)
 	if err != nil {
 		return nil, "", "", "", "", err
 	}
-	accessToken, infoToken, err := operations.CreateJWTAccessTokenForUser(user, as.privateKey, time.Now(), as.accessTokenLifeTime)
+	if user.TotpEnabledAt.Valid {
+		challengeToken, err := operations.CreateJWTChallengeTokenForUser(user, as.privateKey, time.Now(), challengeTokenLifetime)
+		if err != nil {
+			return nil, "", "", "", "", err
+		}
+		return nil, "", "", "", "", utils.TwoFactorRequiredError{ChallengeToken: challengeToken}
+	}
+	accessToken, infoToken, refreshToken, refreshInfoToken, err := as.StartSession(ctx, user, client)
+	if err != nil {
+		return nil, "", "", "", "", err
+	}
+	return user, accessToken, infoToken, refreshToken, refreshInfoToken, nil
+}
+
+// CompleteTwoFactorLogin starts a session if the code is a valid TOTP or
+// recovery code of the user the challenge token was issued for
+func (as *AuthService) CompleteTwoFactorLogin(ctx context.Context, challengeToken string, code string, client operations.SessionClient) (*models.User, string, string, string, string, error) {
+	claims, err := operations.ParseJWTChallengeToken(challengeToken, as.publicKey)
+	if err != nil {
+		return nil, "", "", "", "", utils.NotAuthenticatedError{}
+	}
+	var user *models.User
+	err = utils.Tx(ctx, as.db, func(tx *sql.Tx) error {
+		user, err = operations.LockUserForTwoFactor(ctx, tx, claims.UserId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return utils.NotAuthenticatedError{}
+			}
+			return err
+		}
+		if !user.TotpEnabledAt.Valid {
+			return utils.NotAuthenticatedError{}
+		}
+		ok, err := operations.VerifySecondFactor(ctx, tx, user, code, time.Now())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return utils.InvalidTwoFactorCodeError{}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, "", "", "", "", err
 	}
-	refreshToken, refreshInfoToken, err := operations.CreateJWTRefreshTokenForUser(user, as.privateKey, time.Now(), as.refreshTokenLifeTime)
+	accessToken, infoToken, refreshToken, refreshInfoToken, err := as.StartSession(ctx, user, client)
 	if err != nil {
 		return nil, "", "", "", "", err
 	}
@@ -64,7 +147,18 @@ func (as *AuthService) ClearAuthCookies(ctx echo.Context) {
 	operations.SetRefreshIntoTokenCookie(ctx, as.cookieDomain, "", -1, as.secureCookies)
 }
 
-func (as *AuthService) AuthorizeUserWithRefreshToken(ctx context.Context, value string) (*models.User, string, string, string, string, error) {
+func (as *AuthService) SetOidcStateCookie(ctx echo.Context, state string) {
+	operations.SetOidcStateCookie(ctx, as.cookieDomain, state, int(oidcLoginLifetime.Seconds()), as.secureCookies)
+}
+
+func (as *AuthService) ClearOidcStateCookie(ctx echo.Context) {
+	operations.SetOidcStateCookie(ctx, as.cookieDomain, "", -1, as.secureCookies)
+}
+
+// AuthorizeUserWithRefreshToken rotates the refresh token of the session. A
+// refresh token which has already been rotated indicates that it was stolen,
+// so its session is revoked.
+func (as *AuthService) AuthorizeUserWithRefreshToken(ctx context.Context, value string, client operations.SessionClient) (*models.User, string, string, string, string, error) {
 	token, err := jwt.ParseWithClaims(value, &operations.RefreshClaims{}, func(t *jwt.Token) (interface{}, error) {
 		return as.publicKey, nil
 	}, jwt.WithValidMethods([]string{"EdDSA"}))
@@ -72,17 +166,116 @@ func (as *AuthService) AuthorizeUserWithRefreshToken(ctx context.Context, value
 		return nil, "", "", "", "", err
 	}
 	claims := token.Claims.(*operations.RefreshClaims)
-	user, err := operations.FindUserByID(ctx, as.db, claims.UserId)
-	if err != nil {
-		return nil, "", "", "", "", err
+	if claims.SessionId == "" || claims.ID == "" {
+		// issued before sessions were tracked
+		return nil, "", "", "", "", utils.NotAuthenticatedError{}
 	}
-	accessToken, infoToken, err := operations.CreateJWTAccessTokenForUser(user, as.privateKey, time.Now(), as.accessTokenLifeTime)
+	var user *models.User
+	var session *models.Session
+	reused := false
+	err = utils.Tx(ctx, as.db, func(tx *sql.Tx) error {
+		session, err = operations.LockSession(ctx, tx, claims.SessionId, claims.UserId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return utils.NotAuthenticatedError{}
+			}
+			return err
+		}
+		if session.RefreshTokenID != claims.ID {
+			reused = true
+			_, err = operations.DeleteSession(ctx, tx, session.ID, session.OwnerID)
+			return err
+		}
+		user, err = operations.FindUserByID(ctx, tx, claims.UserId)
+		if err != nil {
+			return err
+		}
+		refreshTokenId, err := gonanoid.New()
+		if err != nil {
+			return err
+		}
+		return operations.RotateSession(ctx, tx, session, refreshTokenId, client, time.Now().Add(as.refreshTokenLifeTime))
+	})
 	if err != nil {
 		return nil, "", "", "", "", err
 	}
-	refreshToken, refreshInfoToken, err := operations.CreateJWTRefreshTokenForUser(user, as.privateKey, time.Now(), as.refreshTokenLifeTime)
+	if reused {
+		log.Warn().Str("userId", claims.UserId).Str("sessionId", claims.SessionId).Msg("Refresh token reused, revoked session")
+		return nil, "", "", "", "", utils.NotAuthenticatedError{}
+	}
+	accessToken, infoToken, refreshToken, refreshInfoToken, err := as.issueTokens(user, session)
 	if err != nil {
 		return nil, "", "", "", "", err
 	}
 	return user, accessToken, infoToken, refreshToken, refreshInfoToken, nil
 }
+
+// ReissueTokens creates new tokens for an existing session, so they contain
+// changed user data like the email address. The refresh token is not rotated.
+func (as *AuthService) ReissueTokens(ctx context.Context, userId string, sessionId string) (string, string, string, string, error) {
+	session, err := operations.FindSession(ctx, as.db, sessionId, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", "", "", "", utils.NotAuthenticatedError{}
+		}
+		return "", "", "", "", err
+	}
+	user, err := operations.FindUserByID(ctx, as.db, userId)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	return as.issueTokens(user, session)
+}
+
+func (as *AuthService) GetSessionsForUser(ctx context.Context, userId string) (models.SessionSlice, error) {
+	return operations.GetSessionsForUser(ctx, as.db, userId)
+}
+
+// SessionActive returns whether the session of the user exists and is not
+// expired
+func (as *AuthService) SessionActive(ctx context.Context, userId string, sessionId string) (bool, error) {
+	_, err := operations.FindSession(ctx, as.db, sessionId, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// RevokeSession deletes the session of the user, its refresh token can not be
+// used anymore
+func (as *AuthService) RevokeSession(ctx context.Context, userId string, sessionId string) error {
+	deleted, err := operations.DeleteSession(ctx, as.db, sessionId, userId)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return utils.NotFoundError{EntityName: "Session"}
+	}
+	return nil
+}
+
+// RevokeSessionOfRefreshToken deletes the session the refresh token belongs to.
+// Expired refresh tokens are accepted, their session may still exist.
+func (as *AuthService) RevokeSessionOfRefreshToken(ctx context.Context, value string) error {
+	token, err := jwt.ParseWithClaims(value, &operations.RefreshClaims{}, func(t *jwt.Token) (interface{}, error) {
+		return as.publicKey, nil
+	}, jwt.WithValidMethods([]string{"EdDSA"}), jwt.WithoutClaimsValidation())
+	if err != nil {
+		return utils.NotAuthenticatedError{}
+	}
+	claims := token.Claims.(*operations.RefreshClaims)
+	if claims.SessionId == "" {
+		return nil
+	}
+	_, err = operations.DeleteSession(ctx, as.db, claims.SessionId, claims.UserId)
+	return err
+}
+
+// RevokeOtherSessions deletes all sessions of the user except the given one
+func (as *AuthService) RevokeOtherSessions(ctx context.Context, userId string, currentSessionId string) error {
+	_, err := operations.DeleteSessionsForUser(ctx, as.db, userId, currentSessionId)
+	return err
+}
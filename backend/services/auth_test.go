@@ -0,0 +1,101 @@
+package services_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessions(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	authService := services.NewAuthService(db, privateKey, publicKey, time.Hour, 24*time.Hour, "", false)
+	userService := services.NewUserService(db, false, "", 60, nil)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+
+	laptop := operations.SessionClient{UserAgent: "Firefox", IPAddress: "192.0.2.1"}
+	phone := operations.SessionClient{UserAgent: "Safari", IPAddress: "192.0.2.2"}
+
+	_, _, _, laptopRefresh, _, err := authService.AuthorizeUser(context.Background(), alice.Email, aliceParams.Password, laptop)
+	assert.NoError(t, err)
+	_, _, _, phoneRefresh, _, err := authService.AuthorizeUser(context.Background(), alice.Email, aliceParams.Password, phone)
+	assert.NoError(t, err)
+
+	sessions, err := authService.GetSessionsForUser(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 2)
+
+	// refreshing rotates the refresh token
+	_, _, _, rotatedRefresh, _, err := authService.AuthorizeUserWithRefreshToken(context.Background(), laptopRefresh, laptop)
+	assert.NoError(t, err)
+	assert.NotEqual(t, laptopRefresh, rotatedRefresh)
+	_, _, _, rotatedRefresh, _, err = authService.AuthorizeUserWithRefreshToken(context.Background(), rotatedRefresh, laptop)
+	assert.NoError(t, err)
+
+	// reusing a rotated token revokes the session
+	_, _, _, _, _, err = authService.AuthorizeUserWithRefreshToken(context.Background(), laptopRefresh, laptop)
+	assert.ErrorIs(t, err, utils.NotAuthenticatedError{})
+	_, _, _, _, _, err = authService.AuthorizeUserWithRefreshToken(context.Background(), rotatedRefresh, laptop)
+	assert.ErrorIs(t, err, utils.NotAuthenticatedError{})
+
+	sessions, err = authService.GetSessionsForUser(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, "Safari", sessions[0].UserAgent)
+	phoneSessionId := sessions[0].ID
+
+	err = authService.RevokeSession(context.Background(), alice.ID, "unknown")
+	assert.ErrorIs(t, err, utils.NotFoundError{EntityName: "Session"})
+
+	// changing the password keeps only the current session
+	_, _, _, _, _, err = authService.AuthorizeUser(context.Background(), alice.Email, aliceParams.Password, laptop)
+	assert.NoError(t, err)
+	err = userService.UpdatePassword(context.Background(), services.UpdatePasswordParams{
+		UserId:      alice.ID,
+		OldPassword: aliceParams.Password,
+		NewPassword: "a new password",
+		SessionId:   phoneSessionId,
+	})
+	assert.NoError(t, err)
+	sessions, err = authService.GetSessionsForUser(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 1)
+	assert.Equal(t, phoneSessionId, sessions[0].ID)
+	_, _, _, _, _, err = authService.AuthorizeUserWithRefreshToken(context.Background(), phoneRefresh, phone)
+	assert.NoError(t, err)
+
+	err = authService.RevokeSession(context.Background(), alice.ID, phoneSessionId)
+	assert.NoError(t, err)
+	sessions, err = authService.GetSessionsForUser(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 0)
+
+	// logouts revoke the session of the refresh token without an access token
+	_, _, _, laptopRefresh, _, err = authService.AuthorizeUser(context.Background(), alice.Email, aliceParams.Password, laptop)
+	assert.NoError(t, err)
+	err = authService.RevokeSessionOfRefreshToken(context.Background(), "invalid")
+	assert.ErrorIs(t, err, utils.NotAuthenticatedError{})
+	err = authService.RevokeSessionOfRefreshToken(context.Background(), laptopRefresh)
+	assert.NoError(t, err)
+	sessions, err = authService.GetSessionsForUser(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Len(t, sessions, 0)
+}
@@ -2,57 +2,65 @@ package services
 
 import (
 	"fmt"
-	"strings"
 
-	"github.com/emersion/go-sasl"
-	"github.com/emersion/go-smtp"
 	"github.com/stashsphere/backend/config"
 )
 
+// EmailMessage is an email with a plain text body and an optional HTML
+// alternative
+type EmailMessage struct {
+	// makes the Message-ID stable across retries, a random one is used if empty
+	Id       string
+	To       string
+	Subject  string
+	TextBody string
+	HTMLBody string
+	// sent as List-Unsubscribe header if set
+	UnsubscribeUrl string
+	// referenced as cid:thumbnail from the HTML body
+	InlineImage *InlineImage
+}
+
+type InlineImage struct {
+	Mime    string
+	Content []byte
+}
+
+// content id of the inline image, referenced from HTML templates
+const inlineImageContentId = "thumbnail"
+
 type EmailService interface {
-	Deliver(identifier string, subject string, body string) error
+	Deliver(message EmailMessage) error
 }
 
-type SMTPEmailService struct {
-	config config.StashSphereMailConfig
+// EmailBatch delivers several messages over one connection. It must be closed
+// after the last message.
+type EmailBatch interface {
+	Deliver(message EmailMessage) error
+	Close() error
 }
 
-func NewEmailService(config config.StashSphereMailConfig) EmailService {
-	if config.Backend == "stdout" {
-		return StdoutEmailService{}
-	} else {
-		return SMTPEmailService{config}
-	}
+// BatchEmailService is implemented by email services which can reuse their
+// connection for several messages
+type BatchEmailService interface {
+	EmailService
+	NewBatch() EmailBatch
 }
 
-func (h SMTPEmailService) Deliver(identifier string, subject string, body string) error {
-	config := h.config
-	auth := sasl.NewPlainClient("", config.User, config.Password)
-	to := []string{identifier}
-	msg := strings.NewReader(
-		fmt.Sprintf(
-			"From: %s\r\n"+
-				"To: %s\r\n"+
-				"Subject: %s\r\n"+
-				"\r\n"+
-				"%s\r\n", config.FromAddr, identifier, subject, body),
-	)
-	err := smtp.SendMail(
-		fmt.Sprintf("%s:%d", config.Host, config.Port),
-		auth,
-		config.FromAddr,
-		to,
-		msg)
-	return err
+func NewEmailService(config config.StashSphereMailConfig) (EmailService, error) {
+	if config.Backend == "stdout" {
+		return StdoutEmailService{}, nil
+	}
+	return NewSMTPEmailService(config)
 }
 
 type StdoutEmailService struct {
 }
 
-func (h StdoutEmailService) Deliver(identifier string, subject string, body string) error {
-	fmt.Printf("To: %s\n", identifier)
-	fmt.Printf("Subject: %s\n\n", subject)
-	fmt.Printf("Body: \n%s\n", body)
+func (h StdoutEmailService) Deliver(message EmailMessage) error {
+	fmt.Printf("To: %s\n", message.To)
+	fmt.Printf("Subject: %s\n\n", message.Subject)
+	fmt.Printf("Body: \n%s\n", message.TextBody)
 	return nil
 }
 
@@ -60,17 +68,29 @@ type TestEmail struct {
 	To      string
 	Subject string
 	Body    string
+	HTML    string
+	// List-Unsubscribe url
+	UnsubscribeUrl string
+	InlineImage    *InlineImage
 }
 
 type TestEmailService struct {
 	Mails []TestEmail
+	// if set, deliveries fail with this error
+	Err error
 }
 
-func (h *TestEmailService) Deliver(identifier string, subject string, body string) error {
+func (h *TestEmailService) Deliver(message EmailMessage) error {
+	if h.Err != nil {
+		return h.Err
+	}
 	newMail := TestEmail{
-		To:      identifier,
-		Subject: subject,
-		Body:    body,
+		To:             message.To,
+		Subject:        message.Subject,
+		Body:           message.TextBody,
+		HTML:           message.HTMLBody,
+		UnsubscribeUrl: message.UnsubscribeUrl,
+		InlineImage:    message.InlineImage,
 	}
 	h.Mails = append(h.Mails, newMail)
 	return nil
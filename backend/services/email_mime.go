@@ -0,0 +1,214 @@
+package services
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	gonanoid "github.com/matoous/go-nanoid/v2"
+)
+
+// envelopeAddress returns the bare address of a From header value like
+// "StashSphere <noreply@example.com>"
+func envelopeAddress(from string) (string, error) {
+	address, err := mail.ParseAddress(from)
+	if err != nil {
+		return "", err
+	}
+	return address.Address, nil
+}
+
+type header struct {
+	key   string
+	value string
+}
+
+func writeHeaders(w io.Writer, headers []header) error {
+	for _, h := range headers {
+		_, err := fmt.Fprintf(w, "%s: %s\r\n", h.key, h.value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mimeHeader(headers ...header) textproto.MIMEHeader {
+	mh := textproto.MIMEHeader{}
+	for _, h := range headers {
+		mh.Set(h.key, h.value)
+	}
+	return mh
+}
+
+func writeQuotedPrintablePart(w *multipart.Writer, contentType string, body string) error {
+	part, err := w.CreatePart(mimeHeader(
+		header{"Content-Type", contentType + "; charset=utf-8"},
+		header{"Content-Transfer-Encoding", "quoted-printable"},
+	))
+	if err != nil {
+		return err
+	}
+	qp := quotedprintable.NewWriter(part)
+	_, err = io.WriteString(qp, normalizeLineEndings(body))
+	if err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+func writeBase64Part(w *multipart.Writer, headers textproto.MIMEHeader, content []byte) error {
+	headers.Set("Content-Transfer-Encoding", "base64")
+	part, err := w.CreatePart(headers)
+	if err != nil {
+		return err
+	}
+	encoded := base64.StdEncoding.EncodeToString(content)
+	// lines must not exceed 76 characters
+	for len(encoded) > 76 {
+		_, err = io.WriteString(part, encoded[:76]+"\r\n")
+		if err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err = io.WriteString(part, encoded+"\r\n")
+	return err
+}
+
+func normalizeLineEndings(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "\r\n", "\n"), "\n", "\r\n")
+}
+
+// writeAlternative writes the text and HTML bodies as multipart/alternative
+// parts, the preferred HTML part last
+func writeAlternative(w *multipart.Writer, message EmailMessage) error {
+	err := writeQuotedPrintablePart(w, "text/plain", message.TextBody)
+	if err != nil {
+		return err
+	}
+	err = writeQuotedPrintablePart(w, "text/html", message.HTMLBody)
+	if err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// BuildMIMEMessage assembles the message sent over SMTP. Messages with an HTML
+// body are sent as multipart/alternative with a text fallback, wrapped in
+// multipart/related if they embed an image. Non-ASCII headers are encoded
+// according to RFC 2047.
+func BuildMIMEMessage(from string, message EmailMessage) ([]byte, error) {
+	fromAddress, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, err
+	}
+	toAddress, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return nil, err
+	}
+	id := message.Id
+	if id == "" {
+		id, err = gonanoid.New()
+		if err != nil {
+			return nil, err
+		}
+	}
+	_, domain, _ := strings.Cut(fromAddress.Address, "@")
+
+	headers := []header{
+		{"From", fromAddress.String()},
+		{"To", toAddress.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", message.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-ID", fmt.Sprintf("<%s@%s>", id, domain)},
+		{"MIME-Version", "1.0"},
+	}
+	if message.UnsubscribeUrl != "" {
+		headers = append(headers,
+			header{"List-Unsubscribe", fmt.Sprintf("<%s>", message.UnsubscribeUrl)},
+			header{"List-Unsubscribe-Post", "List-Unsubscribe=One-Click"},
+		)
+	}
+
+	var b bytes.Buffer
+	if message.HTMLBody == "" {
+		headers = append(headers,
+			header{"Content-Type", "text/plain; charset=utf-8"},
+			header{"Content-Transfer-Encoding", "quoted-printable"},
+		)
+		err = writeHeaders(&b, headers)
+		if err != nil {
+			return nil, err
+		}
+		b.WriteString("\r\n")
+		qp := quotedprintable.NewWriter(&b)
+		_, err = io.WriteString(qp, normalizeLineEndings(message.TextBody))
+		if err != nil {
+			return nil, err
+		}
+		err = qp.Close()
+		if err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	}
+
+	if message.InlineImage == nil {
+		alternative := multipart.NewWriter(&b)
+		headers = append(headers, header{"Content-Type", "multipart/alternative; boundary=" + alternative.Boundary()})
+		err = writeHeaders(&b, headers)
+		if err != nil {
+			return nil, err
+		}
+		b.WriteString("\r\n")
+		err = writeAlternative(alternative, message)
+		if err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	}
+
+	related := multipart.NewWriter(&b)
+	headers = append(headers, header{"Content-Type", fmt.Sprintf(`multipart/related; boundary=%s; type="multipart/alternative"`, related.Boundary())})
+	err = writeHeaders(&b, headers)
+	if err != nil {
+		return nil, err
+	}
+	b.WriteString("\r\n")
+	var alternativeBody bytes.Buffer
+	alternative := multipart.NewWriter(&alternativeBody)
+	err = writeAlternative(alternative, message)
+	if err != nil {
+		return nil, err
+	}
+	part, err := related.CreatePart(mimeHeader(header{"Content-Type", "multipart/alternative; boundary=" + alternative.Boundary()}))
+	if err != nil {
+		return nil, err
+	}
+	_, err = part.Write(alternativeBody.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	err = writeBase64Part(related, mimeHeader(
+		header{"Content-Type", message.InlineImage.Mime},
+		header{"Content-ID", fmt.Sprintf("<%s>", inlineImageContentId)},
+		header{"Content-Disposition", "inline"},
+	), message.InlineImage.Content)
+	if err != nil {
+		return nil, err
+	}
+	err = related.Close()
+	if err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
@@ -0,0 +1,118 @@
+package services_test
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stashsphere/backend/services"
+	"github.com/stretchr/testify/assert"
+)
+
+type mimePart struct {
+	header  textproto.MIMEHeader
+	content string
+}
+
+func readParts(t *testing.T, contentType string, body io.Reader) []mimePart {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(mediaType, "multipart/"))
+	reader := multipart.NewReader(body, params["boundary"])
+	parts := []mimePart{}
+	for {
+		part, err := reader.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		content, err := io.ReadAll(part)
+		assert.NoError(t, err)
+		parts = append(parts, mimePart{part.Header, string(content)})
+	}
+	return parts
+}
+
+func TestBuildMIMEMessagePlain(t *testing.T) {
+	raw, err := services.BuildMIMEMessage("StashSphere <noreply@example.com>", services.EmailMessage{
+		Id:       "abc",
+		To:       "Jürgen Müller <juergen@example.com>",
+		Subject:  "[StashSphere] Grüße",
+		TextBody: "Hallo Jürgen,\nwillkommen!",
+	})
+	assert.NoError(t, err)
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	assert.NoError(t, err)
+
+	decoder := mime.WordDecoder{}
+	subject, err := decoder.DecodeHeader(msg.Header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, "[StashSphere] Grüße", subject)
+	to, err := msg.Header.AddressList("To")
+	assert.NoError(t, err)
+	assert.Equal(t, "Jürgen Müller", to[0].Name)
+	assert.Equal(t, "<abc@example.com>", msg.Header.Get("Message-ID"))
+	_, err = msg.Header.Date()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0", msg.Header.Get("MIME-Version"))
+	assert.Equal(t, "", msg.Header.Get("List-Unsubscribe"))
+	assert.Equal(t, "text/plain; charset=utf-8", msg.Header.Get("Content-Type"))
+	assert.Equal(t, "quoted-printable", msg.Header.Get("Content-Transfer-Encoding"))
+	// only ASCII is sent on the wire
+	for _, c := range raw {
+		assert.Less(t, c, byte(128))
+	}
+}
+
+func TestBuildMIMEMessageAlternative(t *testing.T) {
+	raw, err := services.BuildMIMEMessage("noreply@example.com", services.EmailMessage{
+		To:             "alice@example.com",
+		Subject:        "Hello",
+		TextBody:       "Hi Alice",
+		HTMLBody:       "<p>Hi Alice</p>",
+		UnsubscribeUrl: "https://api.example.com/api/notifications/unsubscribe?token=t",
+	})
+	assert.NoError(t, err)
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	assert.NoError(t, err)
+	assert.Equal(t, "<https://api.example.com/api/notifications/unsubscribe?token=t>", msg.Header.Get("List-Unsubscribe"))
+	assert.Equal(t, "List-Unsubscribe=One-Click", msg.Header.Get("List-Unsubscribe-Post"))
+	assert.True(t, strings.HasSuffix(msg.Header.Get("Message-ID"), "@example.com>"))
+
+	parts := readParts(t, msg.Header.Get("Content-Type"), msg.Body)
+	assert.Len(t, parts, 2)
+	assert.Equal(t, "text/plain; charset=utf-8", parts[0].header.Get("Content-Type"))
+	assert.Equal(t, "Hi Alice", parts[0].content)
+	assert.Equal(t, "text/html; charset=utf-8", parts[1].header.Get("Content-Type"))
+	assert.Equal(t, "<p>Hi Alice</p>", parts[1].content)
+}
+
+func TestBuildMIMEMessageInlineImage(t *testing.T) {
+	image := bytes.Repeat([]byte{0x89, 'P', 'N', 'G'}, 100)
+	raw, err := services.BuildMIMEMessage("noreply@example.com", services.EmailMessage{
+		To:          "alice@example.com",
+		Subject:     "Shared",
+		TextBody:    "Bob shared a thing",
+		HTMLBody:    `<p>Bob shared a thing</p><img src="cid:thumbnail">`,
+		InlineImage: &services.InlineImage{Mime: "image/png", Content: image},
+	})
+	assert.NoError(t, err)
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	assert.NoError(t, err)
+	mediaType, _, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/related", mediaType)
+
+	parts := readParts(t, msg.Header.Get("Content-Type"), msg.Body)
+	assert.Len(t, parts, 2)
+	alternative := readParts(t, parts[0].header.Get("Content-Type"), strings.NewReader(parts[0].content))
+	assert.Len(t, alternative, 2)
+	assert.Equal(t, "image/png", parts[1].header.Get("Content-Type"))
+	assert.Equal(t, "<thumbnail>", parts[1].header.Get("Content-ID"))
+	assert.Equal(t, "base64", parts[1].header.Get("Content-Transfer-Encoding"))
+}
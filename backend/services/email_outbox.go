@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+)
+
+// emails delivered per ProcessDue call, the remaining ones are picked up by
+// the next call
+const outboxBatchSize = 100
+
+// EmailOutboxService delivers the emails stored in the outbox through the
+// email service and retries failed deliveries
+type EmailOutboxService struct {
+	db           *sql.DB
+	emailService EmailService
+}
+
+func NewEmailOutboxService(db *sql.DB, emailService EmailService) *EmailOutboxService {
+	return &EmailOutboxService{db, emailService}
+}
+
+func emailMessageFromOutbox(email *models.OutboxEmail) EmailMessage {
+	message := EmailMessage{
+		Id:             email.ID,
+		To:             email.Recipient,
+		Subject:        email.Subject,
+		TextBody:       email.Body,
+		HTMLBody:       email.HTMLBody.String,
+		UnsubscribeUrl: email.UnsubscribeURL.String,
+	}
+	if email.InlineImage.Valid {
+		message.InlineImage = &InlineImage{
+			Mime:    email.InlineImageMime.String,
+			Content: email.InlineImage.Bytes,
+		}
+	}
+	return message
+}
+
+// newSender returns a batch which reuses its connection for several emails if
+// the email service supports it. The returned function closes the batch.
+func (eo *EmailOutboxService) newSender() (EmailService, func()) {
+	batchService, ok := eo.emailService.(BatchEmailService)
+	if !ok {
+		return eo.emailService, func() {}
+	}
+	batch := batchService.NewBatch()
+	return batch, func() {
+		err := batch.Close()
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to close email batch")
+		}
+	}
+}
+
+// deliver sends the locked email and records the outcome, a failed delivery
+// is stored in the last error of the email
+func (eo *EmailOutboxService) deliver(ctx context.Context, tx *sql.Tx, sender EmailService, email *models.OutboxEmail) (bool, error) {
+	deliveryErr := sender.Deliver(emailMessageFromOutbox(email))
+	if deliveryErr != nil {
+		return false, operations.MarkOutboxEmailFailed(ctx, tx, email, deliveryErr)
+	}
+	return true, operations.MarkOutboxEmailSent(ctx, tx, email)
+}
+
+// Deliver sends the email unless it is not due or being sent by a worker
+func (eo *EmailOutboxService) Deliver(ctx context.Context, id string) error {
+	return eo.deliverWith(ctx, eo.emailService, id)
+}
+
+func (eo *EmailOutboxService) deliverWith(ctx context.Context, sender EmailService, id string) error {
+	var deliveryErr error
+	err := utils.Tx(ctx, eo.db, func(tx *sql.Tx) error {
+		email, err := operations.LockDueOutboxEmail(ctx, tx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+		delivered, err := eo.deliver(ctx, tx, sender, email)
+		if err == nil && !delivered {
+			deliveryErr = errors.New(email.LastError.String)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return deliveryErr
+}
+
+// ProcessDue delivers the emails which are due and returns the number of sent
+// and failed deliveries
+func (eo *EmailOutboxService) ProcessDue(ctx context.Context) (int, int, error) {
+	sender, closeSender := eo.newSender()
+	defer closeSender()
+	sent, failed := 0, 0
+	for i := 0; i < outboxBatchSize; i++ {
+		done := false
+		err := utils.Tx(ctx, eo.db, func(tx *sql.Tx) error {
+			email, err := operations.LockNextDueOutboxEmail(ctx, tx)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					done = true
+					return nil
+				}
+				return err
+			}
+			delivered, err := eo.deliver(ctx, tx, sender, email)
+			if err != nil {
+				return err
+			}
+			if !delivered {
+				log.Warn().Str("error", email.LastError.String).Str("emailId", email.ID).Int("attempts", email.Attempts).Msg("Failed to deliver email")
+				failed++
+			} else {
+				sent++
+			}
+			return nil
+		})
+		if err != nil {
+			return sent, failed, err
+		}
+		if done {
+			break
+		}
+	}
+	return sent, failed, nil
+}
+
+// emailQueue stores emails in the outbox within a transaction and remembers
+// them to be delivered once the transaction committed
+type emailQueue struct {
+	tx  *sql.Tx
+	ids []string
+}
+
+func (q *emailQueue) add(ctx context.Context, message EmailMessage) error {
+	params := operations.EnqueueEmailParams{
+		Recipient: message.To,
+		Subject:   message.Subject,
+		Body:      message.TextBody,
+	}
+	if message.HTMLBody != "" {
+		params.HTMLBody = &message.HTMLBody
+	}
+	if message.UnsubscribeUrl != "" {
+		params.UnsubscribeUrl = &message.UnsubscribeUrl
+	}
+	if message.InlineImage != nil {
+		params.InlineImage = message.InlineImage.Content
+		params.InlineImageMime = &message.InlineImage.Mime
+	}
+	email, err := operations.EnqueueEmail(ctx, q.tx, params)
+	if err != nil {
+		return err
+	}
+	q.ids = append(q.ids, email.ID)
+	return nil
+}
+
+// withEmailQueue runs fn in a transaction and tries to deliver the queued
+// emails right after the commit. Failed deliveries are retried by the outbox
+// worker and do not fail the call.
+func (eo *EmailOutboxService) withEmailQueue(ctx context.Context, fn func(tx *sql.Tx, queue *emailQueue) error) error {
+	queue := emailQueue{}
+	err := utils.Tx(ctx, eo.db, func(tx *sql.Tx) error {
+		queue.tx = tx
+		return fn(tx, &queue)
+	})
+	if err != nil {
+		return err
+	}
+	if len(queue.ids) == 0 {
+		return nil
+	}
+	sender, closeSender := eo.newSender()
+	defer closeSender()
+	for _, id := range queue.ids {
+		err := eo.deliverWith(ctx, sender, id)
+		if err != nil {
+			log.Warn().Err(err).Str("emailId", id).Msg("Failed to deliver email, retrying later")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailOutbox(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	outboxService := services.NewEmailOutboxService(db, &emailService)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	// a failed delivery does not fail the notification
+	emailService.Err = errors.New("connection refused")
+	err = notificationService.ThingShared(context.Background(), services.ThingSharedParams{
+		ThingId:      "thing",
+		SharerId:     alice.ID,
+		TargetUserId: bob.ID,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, emailService.Mails)
+
+	emails, err := operations.ListOutboxEmails(context.Background(), db, models.OutboxEmailStatePending)
+	assert.NoError(t, err)
+	assert.Len(t, emails, 1)
+	email := emails[0]
+	assert.Equal(t, bob.Email, email.Recipient)
+	assert.Equal(t, 1, email.Attempts)
+	assert.Equal(t, "connection refused", email.LastError.String)
+
+	// the retry is not due yet
+	emailService.Err = nil
+	sent, failed, err := outboxService.ProcessDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent+failed)
+
+	_, err = db.Exec("UPDATE outbox_emails SET next_attempt_at = next_attempt_at - interval '1 hour'")
+	assert.NoError(t, err)
+	sent, failed, err = outboxService.ProcessDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, 0, failed)
+	assert.Len(t, emailService.Mails, 1)
+	assert.Equal(t, bob.Email, emailService.Mails[0].To)
+	err = email.Reload(context.Background(), db)
+	assert.NoError(t, err)
+	assert.Equal(t, models.OutboxEmailStateSent, email.State)
+	assert.Equal(t, 2, email.Attempts)
+
+	// emails running out of attempts are moved to the dead state
+	emailService.Err = errors.New("mailbox unavailable")
+	err = notificationService.ThingShared(context.Background(), services.ThingSharedParams{
+		ThingId:      "thing",
+		SharerId:     alice.ID,
+		TargetUserId: bob.ID,
+	})
+	assert.NoError(t, err)
+	_, err = db.Exec("UPDATE outbox_emails SET attempts = $1, next_attempt_at = next_attempt_at - interval '1 day' WHERE state = 'pending'", operations.OutboxMaxAttempts-1)
+	assert.NoError(t, err)
+	sent, failed, err = outboxService.ProcessDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 1, failed)
+	dead, err := operations.ListOutboxEmails(context.Background(), db, models.OutboxEmailStateDead)
+	assert.NoError(t, err)
+	assert.Len(t, dead, 1)
+
+	emailService.Err = nil
+	err = operations.RequeueOutboxEmail(context.Background(), db, dead[0])
+	assert.NoError(t, err)
+	sent, _, err = outboxService.ProcessDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Len(t, emailService.Mails, 2)
+}
@@ -0,0 +1,223 @@
+package services
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/emersion/go-sasl"
+	"github.com/emersion/go-smtp"
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/config"
+)
+
+// TLS modes of the SMTP connection
+const (
+	smtpTLSNone = "none"
+	// upgrades the plain connection if the server offers STARTTLS
+	smtpTLSOpportunistic = "opportunistic"
+	// upgrades the plain connection, the server must support STARTTLS
+	smtpTLSStartTLS = "starttls"
+	// TLS from the start, usually on port 465
+	smtpTLSImplicit = "tls"
+)
+
+// SASL mechanisms used to authenticate with the SMTP server
+const (
+	smtpAuthNone  = "none"
+	smtpAuthPlain = "plain"
+	smtpAuthLogin = "login"
+)
+
+const smtpDialTimeout = 30 * time.Second
+
+// SMTPEmailService delivers emails through an SMTP server
+type SMTPEmailService struct {
+	config    config.StashSphereMailConfig
+	tlsConfig *tls.Config
+}
+
+// NewSMTPEmailService checks the TLS and auth settings and loads the custom
+// CA if configured
+func NewSMTPEmailService(config config.StashSphereMailConfig) (*SMTPEmailService, error) {
+	if config.TLS == "" {
+		config.TLS = smtpTLSOpportunistic
+	}
+	if config.Auth == "" {
+		config.Auth = smtpAuthPlain
+	}
+	switch config.TLS {
+	case smtpTLSNone, smtpTLSOpportunistic, smtpTLSStartTLS, smtpTLSImplicit:
+	default:
+		return nil, fmt.Errorf("unknown smtp tls mode %q, expected none, opportunistic, starttls or tls", config.TLS)
+	}
+	switch config.Auth {
+	case smtpAuthNone, smtpAuthPlain, smtpAuthLogin:
+	default:
+		return nil, fmt.Errorf("unknown smtp auth mechanism %q, expected none, plain or login", config.Auth)
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         config.Host,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	if config.CAFile != "" {
+		caPem, err := os.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading smtp ca file: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPem) {
+			return nil, fmt.Errorf("no certificates found in smtp ca file %s", config.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if config.InsecureSkipVerify {
+		log.Warn().Msg("SMTP certificate verification is disabled. Do not use in production.")
+	}
+	return &SMTPEmailService{config, tlsConfig}, nil
+}
+
+func (h *SMTPEmailService) Deliver(message EmailMessage) error {
+	batch := h.NewBatch()
+	err := batch.Deliver(message)
+	if err != nil {
+		return err
+	}
+	// the message was accepted, failing now would send it again
+	err = batch.Close()
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to close smtp connection")
+	}
+	return nil
+}
+
+func (h *SMTPEmailService) NewBatch() EmailBatch {
+	return &smtpBatch{service: h}
+}
+
+func (h *SMTPEmailService) saslClient() sasl.Client {
+	switch h.config.Auth {
+	case smtpAuthPlain:
+		return sasl.NewPlainClient("", h.config.User, h.config.Password)
+	case smtpAuthLogin:
+		return sasl.NewLoginClient(h.config.User, h.config.Password)
+	}
+	return nil
+}
+
+// dial connects to the server, establishes TLS as configured and
+// authenticates
+func (h *SMTPEmailService) dial() (*smtp.Client, error) {
+	addr := net.JoinHostPort(h.config.Host, strconv.Itoa(int(h.config.Port)))
+	dialer := &net.Dialer{Timeout: smtpDialTimeout}
+	var client *smtp.Client
+	switch h.config.TLS {
+	case smtpTLSImplicit:
+		conn, err := tls.DialWithDialer(dialer, "tcp", addr, h.tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		client = smtp.NewClient(conn)
+	case smtpTLSStartTLS:
+		conn, err := dialer.Dial("tcp", addr)
+		if err != nil {
+			return nil, err
+		}
+		client, err = smtp.NewClientStartTLS(conn, h.tlsConfig)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+	case smtpTLSOpportunistic:
+		conn, err := dialer.Dial("tcp", addr)
+		if err != nil {
+			return nil, err
+		}
+		client = smtp.NewClient(conn)
+		// the client cannot upgrade after EHLO, so reconnect with STARTTLS
+		// if the server offers it
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			client.Close()
+			conn, err = dialer.Dial("tcp", addr)
+			if err != nil {
+				return nil, err
+			}
+			client, err = smtp.NewClientStartTLS(conn, h.tlsConfig)
+			if err != nil {
+				conn.Close()
+				return nil, err
+			}
+		}
+	default:
+		conn, err := dialer.Dial("tcp", addr)
+		if err != nil {
+			return nil, err
+		}
+		client = smtp.NewClient(conn)
+	}
+
+	auth := h.saslClient()
+	if auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			client.Close()
+			return nil, errors.New("smtp server does not support AUTH")
+		}
+		err := client.Auth(auth)
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// smtpBatch keeps the connection open between messages. After a failed
+// delivery the state of the connection is unknown, so the next message
+// reconnects.
+type smtpBatch struct {
+	service *SMTPEmailService
+	client  *smtp.Client
+}
+
+func (b *smtpBatch) Deliver(message EmailMessage) error {
+	msg, err := BuildMIMEMessage(b.service.config.FromAddr, message)
+	if err != nil {
+		return err
+	}
+	from, err := envelopeAddress(b.service.config.FromAddr)
+	if err != nil {
+		return err
+	}
+	if b.client == nil {
+		b.client, err = b.service.dial()
+		if err != nil {
+			return err
+		}
+	}
+	err = b.client.SendMail(from, []string{message.To}, bytes.NewReader(msg))
+	if err != nil {
+		b.client.Close()
+		b.client = nil
+		return err
+	}
+	return nil
+}
+
+func (b *smtpBatch) Close() error {
+	if b.client == nil {
+		return nil
+	}
+	err := b.client.Quit()
+	b.client = nil
+	return err
+}
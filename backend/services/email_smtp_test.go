@@ -0,0 +1,329 @@
+package services_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-sasl"
+	"github.com/emersion/go-smtp"
+	"github.com/stashsphere/backend/config"
+	"github.com/stashsphere/backend/services"
+	"github.com/stretchr/testify/assert"
+)
+
+type smtpTestBackend struct {
+	mu       sync.Mutex
+	sessions int
+	mails    []string
+	// auth mechanisms offered to clients, auth is not required if empty
+	mechanisms []string
+}
+
+func (b *smtpTestBackend) NewSession(c *smtp.Conn) (smtp.Session, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sessions++
+	return &smtpTestSession{backend: b}, nil
+}
+
+type smtpTestSession struct {
+	backend       *smtpTestBackend
+	authenticated bool
+}
+
+func (s *smtpTestSession) AuthMechanisms() []string {
+	return s.backend.mechanisms
+}
+
+func (s *smtpTestSession) Auth(mech string) (sasl.Server, error) {
+	check := func(username, password string) error {
+		if username != "user" || password != "secret" {
+			return errors.New("invalid credentials")
+		}
+		s.authenticated = true
+		return nil
+	}
+	switch mech {
+	case sasl.Plain:
+		return sasl.NewPlainServer(func(identity, username, password string) error {
+			return check(username, password)
+		}), nil
+	case sasl.Login:
+		return &loginServer{check: check}, nil
+	}
+	return nil, smtp.ErrAuthUnknownMechanism
+}
+
+func (s *smtpTestSession) Mail(from string, opts *smtp.MailOptions) error {
+	if len(s.backend.mechanisms) > 0 && !s.authenticated {
+		return smtp.ErrAuthRequired
+	}
+	return nil
+}
+
+func (s *smtpTestSession) Rcpt(to string, opts *smtp.RcptOptions) error {
+	if to == "rejected@example.com" {
+		return &smtp.SMTPError{Code: 550, EnhancedCode: smtp.EnhancedCode{5, 1, 1}, Message: "No such user"}
+	}
+	return nil
+}
+
+func (s *smtpTestSession) Data(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	s.backend.mu.Lock()
+	defer s.backend.mu.Unlock()
+	s.backend.mails = append(s.backend.mails, string(data))
+	return nil
+}
+
+func (s *smtpTestSession) Reset() {}
+
+func (s *smtpTestSession) Logout() error {
+	return nil
+}
+
+// loginServer implements the server side of the LOGIN mechanism which go-sasl
+// only provides a client for
+type loginServer struct {
+	check    func(username, password string) error
+	username *string
+}
+
+func (l *loginServer) Next(response []byte) ([]byte, bool, error) {
+	if response == nil {
+		return []byte("Username:"), false, nil
+	}
+	if l.username == nil {
+		username := string(response)
+		l.username = &username
+		return []byte("Password:"), false, nil
+	}
+	return nil, true, l.check(*l.username, string(response))
+}
+
+// testCertificate creates a self-signed certificate for localhost and writes
+// it to a PEM file usable as CA file
+func testCertificate(t *testing.T) (tls.Certificate, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	err = os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600)
+	assert.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, caFile
+}
+
+// startSMTPServer serves the backend on a random port, with implicit TLS if
+// implicitTLS is set and STARTTLS otherwise if tlsConfig is set
+func startSMTPServer(t *testing.T, backend *smtpTestBackend, tlsConfig *tls.Config, implicitTLS bool) uint16 {
+	server := smtp.NewServer(backend)
+	server.Domain = "localhost"
+	server.AllowInsecureAuth = true
+	server.TLSConfig = tlsConfig
+	var listener net.Listener
+	var err error
+	if implicitTLS {
+		listener, err = tls.Listen("tcp", "127.0.0.1:0", tlsConfig)
+	} else {
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	assert.NoError(t, err)
+	go server.Serve(listener)
+	t.Cleanup(func() {
+		server.Close()
+	})
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	assert.NoError(t, err)
+	portNumber, err := strconv.Atoi(port)
+	assert.NoError(t, err)
+	return uint16(portNumber)
+}
+
+func testMessage(to string) services.EmailMessage {
+	return services.EmailMessage{
+		To:       to,
+		Subject:  "Test",
+		TextBody: "Hello",
+	}
+}
+
+func TestSMTPEmailServiceConfig(t *testing.T) {
+	_, err := services.NewSMTPEmailService(config.StashSphereMailConfig{TLS: "ssl"})
+	assert.Error(t, err)
+	_, err = services.NewSMTPEmailService(config.StashSphereMailConfig{Auth: "cram-md5"})
+	assert.Error(t, err)
+	_, err = services.NewSMTPEmailService(config.StashSphereMailConfig{CAFile: filepath.Join(t.TempDir(), "missing.pem")})
+	assert.Error(t, err)
+}
+
+func TestSMTPEmailServiceNoTLS(t *testing.T) {
+	backend := &smtpTestBackend{}
+	port := startSMTPServer(t, backend, nil, false)
+
+	emailService, err := services.NewSMTPEmailService(config.StashSphereMailConfig{
+		FromAddr: "StashSphere <noreply@example.com>",
+		Host:     "127.0.0.1",
+		Port:     port,
+		TLS:      "none",
+		Auth:     "none",
+	})
+	assert.NoError(t, err)
+	err = emailService.Deliver(testMessage("alice@example.com"))
+	assert.NoError(t, err)
+	assert.Len(t, backend.mails, 1)
+	assert.Contains(t, backend.mails[0], "Subject: Test")
+
+	// by default STARTTLS is only used if the server offers it
+	emailService, err = services.NewSMTPEmailService(config.StashSphereMailConfig{
+		FromAddr: "noreply@example.com",
+		Host:     "127.0.0.1",
+		Port:     port,
+		Auth:     "none",
+	})
+	assert.NoError(t, err)
+	err = emailService.Deliver(testMessage("alice@example.com"))
+	assert.NoError(t, err)
+	assert.Len(t, backend.mails, 2)
+
+	emailService, err = services.NewSMTPEmailService(config.StashSphereMailConfig{
+		FromAddr: "noreply@example.com",
+		Host:     "127.0.0.1",
+		Port:     port,
+		TLS:      "starttls",
+		Auth:     "none",
+	})
+	assert.NoError(t, err)
+	err = emailService.Deliver(testMessage("alice@example.com"))
+	assert.Error(t, err)
+	assert.Len(t, backend.mails, 2)
+}
+
+func TestSMTPEmailServiceStartTLS(t *testing.T) {
+	certificate, caFile := testCertificate(t)
+	backend := &smtpTestBackend{mechanisms: []string{sasl.Plain, sasl.Login}}
+	port := startSMTPServer(t, backend, &tls.Config{Certificates: []tls.Certificate{certificate}}, false)
+
+	mailConfig := config.StashSphereMailConfig{
+		FromAddr: "noreply@example.com",
+		Host:     "localhost",
+		Port:     port,
+		User:     "user",
+		Password: "secret",
+		TLS:      "starttls",
+	}
+
+	// the self-signed certificate is not trusted without the CA file
+	emailService, err := services.NewSMTPEmailService(mailConfig)
+	assert.NoError(t, err)
+	err = emailService.Deliver(testMessage("alice@example.com"))
+	assert.Error(t, err)
+
+	mailConfig.CAFile = caFile
+	for _, auth := range []string{"plain", "login"} {
+		mailConfig.Auth = auth
+		emailService, err = services.NewSMTPEmailService(mailConfig)
+		assert.NoError(t, err)
+		err = emailService.Deliver(testMessage("alice@example.com"))
+		assert.NoError(t, err, auth)
+	}
+	assert.Len(t, backend.mails, 2)
+
+	// the default upgrades the connection because the server offers STARTTLS
+	mailConfig.TLS = ""
+	emailService, err = services.NewSMTPEmailService(mailConfig)
+	assert.NoError(t, err)
+	err = emailService.Deliver(testMessage("alice@example.com"))
+	assert.NoError(t, err)
+	assert.Len(t, backend.mails, 3)
+	mailConfig.CAFile = ""
+	emailService, err = services.NewSMTPEmailService(mailConfig)
+	assert.NoError(t, err)
+	err = emailService.Deliver(testMessage("alice@example.com"))
+	assert.Error(t, err)
+	mailConfig.CAFile = caFile
+	mailConfig.TLS = "starttls"
+
+	mailConfig.Password = "wrong"
+	emailService, err = services.NewSMTPEmailService(mailConfig)
+	assert.NoError(t, err)
+	err = emailService.Deliver(testMessage("alice@example.com"))
+	assert.Error(t, err)
+}
+
+func TestSMTPEmailServiceImplicitTLS(t *testing.T) {
+	certificate, _ := testCertificate(t)
+	backend := &smtpTestBackend{}
+	port := startSMTPServer(t, backend, &tls.Config{Certificates: []tls.Certificate{certificate}}, true)
+
+	emailService, err := services.NewSMTPEmailService(config.StashSphereMailConfig{
+		FromAddr:           "noreply@example.com",
+		Host:               "localhost",
+		Port:               port,
+		TLS:                "tls",
+		Auth:               "none",
+		InsecureSkipVerify: true,
+	})
+	assert.NoError(t, err)
+	err = emailService.Deliver(testMessage("alice@example.com"))
+	assert.NoError(t, err)
+	assert.Len(t, backend.mails, 1)
+}
+
+func TestSMTPEmailServiceBatch(t *testing.T) {
+	backend := &smtpTestBackend{}
+	port := startSMTPServer(t, backend, nil, false)
+
+	emailService, err := services.NewSMTPEmailService(config.StashSphereMailConfig{
+		FromAddr: "noreply@example.com",
+		Host:     "127.0.0.1",
+		Port:     port,
+		TLS:      "none",
+		Auth:     "none",
+	})
+	assert.NoError(t, err)
+	batch := emailService.NewBatch()
+	for _, to := range []string{"alice@example.com", "bob@example.com", "carol@example.com"} {
+		err = batch.Deliver(testMessage(to))
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, backend.sessions)
+	// a rejected delivery drops the connection, the next one reconnects
+	err = batch.Deliver(testMessage("rejected@example.com"))
+	assert.Error(t, err)
+	err = batch.Deliver(testMessage("dave@example.com"))
+	assert.NoError(t, err)
+	assert.NoError(t, batch.Close())
+
+	assert.Len(t, backend.mails, 4)
+	assert.Equal(t, 2, backend.sessions)
+}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"bytes"
+	"html/template"
+	"strings"
+
+	"github.com/stashsphere/backend/notifications/templates"
+)
+
+// renderEmail renders the subject, plain text body and HTML body of the named
+// email in the given locale
+func (ns *NotificationService) renderEmail(to string, locale string, name string, subjectData any, bodyData any) (EmailMessage, error) {
+	subjectTempl, err := templates.ParseText(locale, name+".subject.txt")
+	if err != nil {
+		return EmailMessage{}, err
+	}
+	textTempl, err := templates.ParseText(locale, name+".body.txt")
+	if err != nil {
+		return EmailMessage{}, err
+	}
+	htmlTempl, err := templates.ParseHTML(locale, name+".body.html")
+	if err != nil {
+		return EmailMessage{}, err
+	}
+	layoutTempl, err := templates.ParseHTML(locale, "layout.html")
+	if err != nil {
+		return EmailMessage{}, err
+	}
+
+	var subject bytes.Buffer
+	err = subjectTempl.Execute(&subject, subjectData)
+	if err != nil {
+		return EmailMessage{}, err
+	}
+	var text bytes.Buffer
+	err = textTempl.Execute(&text, bodyData)
+	if err != nil {
+		return EmailMessage{}, err
+	}
+	var content bytes.Buffer
+	err = htmlTempl.Execute(&content, bodyData)
+	if err != nil {
+		return EmailMessage{}, err
+	}
+
+	type LayoutData struct {
+		Locale       string
+		Subject      string
+		InstanceName string
+		FrontendUrl  string
+		// already escaped by the body template
+		Content template.HTML
+	}
+
+	var html bytes.Buffer
+	err = layoutTempl.Execute(&html, LayoutData{
+		Locale:       locale,
+		Subject:      strings.TrimSpace(subject.String()),
+		InstanceName: ns.data.InstanceName,
+		FrontendUrl:  ns.data.FrontendUrl,
+		Content:      template.HTML(content.String()),
+	})
+	if err != nil {
+		return EmailMessage{}, err
+	}
+
+	return EmailMessage{
+		To: to,
+		// a trailing newline would end the header early
+		Subject:  strings.TrimSpace(subject.String()),
+		TextBody: text.String(),
+		HTMLBody: html.String(),
+	}, nil
+}
@@ -8,9 +8,9 @@ import (
 
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
-	gonanoid "github.com/matoous/go-nanoid/v2"
 	"github.com/rs/zerolog/log"
 	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
 	"github.com/stashsphere/backend/utils"
 )
 
@@ -31,40 +31,9 @@ type CreateFriendRequestParams struct {
 func (fs *FriendService) CreateFriendRequest(ctx context.Context, params CreateFriendRequestParams) (*models.FriendRequest, error) {
 	var outerRequest *models.FriendRequest
 	err := utils.Tx(ctx, fs.db, func(tx *sql.Tx) error {
-		pendingFriendRequests, err := models.FriendRequests(models.FriendRequestWhere.State.EQ(models.FriendRequestStatePending), models.FriendRequestWhere.SenderID.EQ(params.UserId), models.FriendRequestWhere.ReceiverID.EQ(params.ReceiverId)).Count(ctx, tx)
-		if err != nil {
-			return err
-		}
-		if pendingFriendRequests > 0 {
-			return utils.PendingFriendRequestExistsError{}
-		}
-
-		existingFriendShip, err := models.Friendships(
-			qm.Expr(
-				qm.Expr(models.FriendshipWhere.Friend1ID.EQ(params.UserId), (models.FriendshipWhere.Friend2ID.EQ(params.ReceiverId))),
-				qm.Or2(qm.Expr(models.FriendshipWhere.Friend2ID.EQ(params.UserId), models.FriendshipWhere.Friend1ID.EQ(params.ReceiverId))))).Count(ctx, tx)
-		if err != nil {
-			return err
-		}
-		if existingFriendShip > 0 {
-			return utils.FriendShipExistsError{}
-		}
-		requestId, err := gonanoid.New()
-		if err != nil {
-			return err
-		}
-		request := models.FriendRequest{
-			ID:         requestId,
-			SenderID:   params.UserId,
-			ReceiverID: params.ReceiverId,
-			CreatedAt:  time.Now(),
-		}
-		err = request.Insert(ctx, tx, boil.Infer())
-		if err != nil {
-			return err
-		}
-		outerRequest = &request
-		return nil
+		var err error
+		outerRequest, err = operations.CreateFriendRequest(ctx, tx, params.UserId, params.ReceiverId)
+		return err
 	})
 	if err != nil {
 		return nil, err
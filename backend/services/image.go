@@ -7,7 +7,6 @@ import (
 	"encoding/base32"
 	"errors"
 	"io"
-	"math"
 	"os"
 	"path"
 	"path/filepath"
@@ -193,9 +192,12 @@ type ImageIndexParams struct {
 	PerPage        uint64
 	Page           uint64
 	OnlyUnassigned bool
+	// nil uses offset pagination, an empty cursor requests the first page in
+	// cursor mode
+	Cursor *string
 }
 
-func (is *ImageService) ImageIndex(ctx context.Context, params ImageIndexParams) (uint64, uint64, models.ImageSlice, error) {
+func (is *ImageService) ImageIndex(ctx context.Context, params ImageIndexParams) (PageInfo, models.ImageSlice, error) {
 	userId, perPage, page, onlyUnassigned := params.UserId, params.PerPage, params.Page, params.OnlyUnassigned
 
 	searchCond := []qm.QueryMod{models.ImageWhere.OwnerID.EQ(userId)}
@@ -207,7 +209,7 @@ func (is *ImageService) ImageIndex(ctx context.Context, params ImageIndexParams)
 		var idRows []IdRow
 		err := models.NewQuery(qm.Distinct("image_id"), qm.From("images_things")).Bind(ctx, is.db, &idRows)
 		if err != nil {
-			return 0, 0, models.ImageSlice{}, err
+			return PageInfo{}, models.ImageSlice{}, err
 		}
 		imageIds := make([]string, len(idRows))
 		for i, row := range idRows {
@@ -215,7 +217,7 @@ func (is *ImageService) ImageIndex(ctx context.Context, params ImageIndexParams)
 		}
 		err = models.NewQuery(qm.Distinct("image_id"), qm.From("profiles")).Bind(ctx, is.db, &idRows)
 		if err != nil {
-			return 0, 0, models.ImageSlice{}, err
+			return PageInfo{}, models.ImageSlice{}, err
 		}
 		for _, row := range idRows {
 			imageIds = append(imageIds, row.ImageId)
@@ -223,18 +225,27 @@ func (is *ImageService) ImageIndex(ctx context.Context, params ImageIndexParams)
 		// TODO: convert to join...
 		searchCond = append(searchCond, models.ImageWhere.ID.NIN(imageIds))
 	}
-	imageCount, err := models.Images(searchCond...).Count(ctx, is.db)
-	if err != nil {
-		return 0, 0, models.ImageSlice{}, err
-	}
 	imageQuery := []qm.QueryMod{
 		qm.Load(models.ImageRels.ImagesThings),
 		qm.Load(qm.Rels(models.ImageRels.ImagesThings, models.ImagesThingRels.Thing, models.ThingRels.Owner)),
 		qm.Load(models.ImageRels.Owner),
 		qm.Load(models.ImageRels.Profiles),
-		qm.OrderBy(models.ImageColumns.CreatedAt),
-		qm.Offset(int(perPage * page)),
-		qm.Limit(int(perPage)),
+		operations.ImageSortOrder.QueryMod(),
+	}
+	var imageCount int64
+	var err error
+	if params.Cursor != nil {
+		cursorMods, err := cursorQueryMods(operations.ImageSortOrder, *params.Cursor, perPage)
+		if err != nil {
+			return PageInfo{}, models.ImageSlice{}, err
+		}
+		imageQuery = append(imageQuery, cursorMods...)
+	} else {
+		imageCount, err = models.Images(searchCond...).Count(ctx, is.db)
+		if err != nil {
+			return PageInfo{}, models.ImageSlice{}, err
+		}
+		imageQuery = append(imageQuery, qm.Offset(int(perPage*page)), qm.Limit(int(perPage)))
 	}
 	for _, s := range searchCond {
 		imageQuery = append(imageQuery, s)
@@ -244,10 +255,22 @@ func (is *ImageService) ImageIndex(ctx context.Context, params ImageIndexParams)
 		imageQuery...,
 	).All(ctx, is.db)
 	if err != nil {
-		return 0, 0, models.ImageSlice{}, err
+		return PageInfo{}, models.ImageSlice{}, err
+	}
+	pageInfo := offsetPageInfo(imageCount, perPage)
+	if params.Cursor != nil {
+		ids := make([]string, len(images))
+		for i, image := range images {
+			ids[i] = image.ID
+		}
+		var n int
+		n, pageInfo, err = cursorPageInfo(ctx, is.db, operations.ImageSortOrder, perPage, ids)
+		if err != nil {
+			return PageInfo{}, models.ImageSlice{}, err
+		}
+		images = images[:n]
 	}
-	totalPages := uint64(math.Ceil(float64(imageCount) / float64(perPage)))
-	return uint64(imageCount), totalPages, images, nil
+	return pageInfo, images, nil
 }
 
 func (is *ImageService) DeleteImage(ctx context.Context, userId string, imageId string) (*models.Image, error) {
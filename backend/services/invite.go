@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+)
+
+const (
+	// limits of invites created by users, administrators can create larger
+	// invites with the create-invite command
+	maxInviteUses         = 100
+	maxInviteLifetime     = 90 * 24 * time.Hour
+	defaultInviteLifetime = 14 * 24 * time.Hour
+)
+
+// InviteService manages the invite codes users and administrators register
+// new users with
+type InviteService struct {
+	db          *sql.DB
+	frontendUrl string
+}
+
+func NewInviteService(db *sql.DB, frontendUrl string) *InviteService {
+	return &InviteService{db, frontendUrl}
+}
+
+type CreateInviteParams struct {
+	// empty for invites of administrators
+	OwnerId string
+	MaxUses int
+	// only this address can register with the invite if not empty
+	Email string
+	// defaults to two weeks
+	ExpiresAt *time.Time
+}
+
+func (is *InviteService) CreateInvite(ctx context.Context, params CreateInviteParams) (*models.Invite, error) {
+	if params.MaxUses < 1 || params.MaxUses > maxInviteUses {
+		return nil, utils.ParameterError{Err: fmt.Errorf("invite must be usable between 1 and %d times", maxInviteUses)}
+	}
+	now := time.Now()
+	expiresAt := now.Add(defaultInviteLifetime)
+	if params.ExpiresAt != nil {
+		expiresAt = *params.ExpiresAt
+	}
+	if !expiresAt.After(now) {
+		return nil, utils.ParameterError{Err: errors.New("invite expiry must be in the future")}
+	}
+	if expiresAt.After(now.Add(maxInviteLifetime)) {
+		return nil, utils.ParameterError{Err: errors.New("invite must expire within 90 days")}
+	}
+	return operations.CreateInvite(ctx, is.db, operations.CreateInviteParams{
+		OwnerId:   params.OwnerId,
+		MaxUses:   params.MaxUses,
+		Email:     params.Email,
+		ExpiresAt: &expiresAt,
+	})
+}
+
+func (is *InviteService) GetInvitesForUser(ctx context.Context, userId string) (models.InviteSlice, error) {
+	return operations.GetInvitesForUser(ctx, is.db, userId)
+}
+
+// DeleteInvite revokes the invite, users who registered with it keep their
+// accounts
+func (is *InviteService) DeleteInvite(ctx context.Context, inviteId string, userId string) error {
+	invite, err := models.FindInvite(ctx, is.db, inviteId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return utils.NotFoundError{EntityName: "Invite"}
+		}
+		return err
+	}
+	if !invite.OwnerID.Valid || invite.OwnerID.String != userId {
+		return utils.EntityDoesNotBelongToUserError{}
+	}
+	_, err = invite.Delete(ctx, is.db)
+	return err
+}
+
+// InviteLink returns the link to the registration with the invite code filled
+// in
+func (is *InviteService) InviteLink(invite *models.Invite) string {
+	return is.frontendUrl + "/user/register?invite=" + url.QueryEscape(invite.Code)
+}
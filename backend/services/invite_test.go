@@ -0,0 +1,153 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvites(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	ctx := context.Background()
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	userService := services.NewUserService(db, true, "static", 60, notificationService)
+	friendService := services.NewFriendService(db, notificationService)
+	inviteService := services.NewInviteService(db, "https://example.com")
+
+	// the code of the configuration keeps working
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	aliceParams.InviteCode = "static"
+	alice, err := userService.CreateUser(ctx, *aliceParams)
+	assert.NoError(t, err)
+	assert.False(t, alice.InviteID.Valid)
+
+	_, err = inviteService.CreateInvite(ctx, services.CreateInviteParams{OwnerId: alice.ID, MaxUses: 0})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+	_, err = inviteService.CreateInvite(ctx, services.CreateInviteParams{OwnerId: alice.ID, MaxUses: 101})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+	farFuture := time.Now().Add(365 * 24 * time.Hour)
+	_, err = inviteService.CreateInvite(ctx, services.CreateInviteParams{OwnerId: alice.ID, MaxUses: 1, ExpiresAt: &farFuture})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+	past := time.Now().Add(-time.Hour)
+	_, err = inviteService.CreateInvite(ctx, services.CreateInviteParams{OwnerId: alice.ID, MaxUses: 1, ExpiresAt: &past})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+
+	invite, err := inviteService.CreateInvite(ctx, services.CreateInviteParams{OwnerId: alice.ID, MaxUses: 2})
+	assert.NoError(t, err)
+	assert.True(t, invite.ExpiresAt.Valid, "invites expire by default")
+	assert.Equal(t, "https://example.com/user/register?invite="+invite.Code, inviteService.InviteLink(invite))
+
+	// registrations with the invite are tracked and receive a friend request
+	// from the inviter
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bobParams.InviteCode = invite.Code
+	bob, err := userService.CreateUser(ctx, *bobParams)
+	assert.NoError(t, err)
+	assert.Equal(t, invite.ID, bob.InviteID.String)
+	requests, err := friendService.GetFriendRequests(ctx, bob.ID)
+	assert.NoError(t, err)
+	assert.Len(t, requests.Received, 1)
+	assert.Equal(t, alice.ID, requests.Received[0].SenderID)
+
+	carolParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	carolParams.InviteCode = invite.Code
+	_, err = userService.CreateUser(ctx, *carolParams)
+	assert.NoError(t, err)
+
+	// the invite is used up
+	daveParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	daveParams.InviteCode = invite.Code
+	_, err = userService.CreateUser(ctx, *daveParams)
+	assert.ErrorIs(t, err, utils.WrongInviteCodeError{})
+	daveParams.InviteCode = ""
+	_, err = userService.CreateUser(ctx, *daveParams)
+	assert.ErrorIs(t, err, utils.WrongInviteCodeError{})
+
+	invites, err := inviteService.GetInvitesForUser(ctx, alice.ID)
+	assert.NoError(t, err)
+	assert.Len(t, invites, 1)
+	assert.Equal(t, 2, invites[0].Uses)
+	assert.Len(t, invites[0].R.Users, 2)
+
+	// invites bound to an email address only accept this address
+	boundInvite, err := inviteService.CreateInvite(ctx, services.CreateInviteParams{OwnerId: alice.ID, MaxUses: 1, Email: "Dave@Example.com"})
+	assert.NoError(t, err)
+	daveParams.InviteCode = boundInvite.Code
+	_, err = userService.CreateUser(ctx, *daveParams)
+	assert.ErrorIs(t, err, utils.WrongInviteCodeError{})
+	daveParams.Email = "dave@example.com"
+	dave, err := userService.CreateUser(ctx, *daveParams)
+	assert.NoError(t, err)
+	assert.Equal(t, boundInvite.ID, dave.InviteID.String)
+
+	// expired invites are rejected
+	soon := time.Now().Add(time.Second)
+	expiringInvite, err := inviteService.CreateInvite(ctx, services.CreateInviteParams{OwnerId: alice.ID, MaxUses: 1, ExpiresAt: &soon})
+	assert.NoError(t, err)
+	time.Sleep(time.Until(soon))
+	eveParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	eveParams.InviteCode = expiringInvite.Code
+	_, err = userService.CreateUser(ctx, *eveParams)
+	assert.ErrorIs(t, err, utils.WrongInviteCodeError{})
+
+	err = inviteService.DeleteInvite(ctx, expiringInvite.ID, bob.ID)
+	assert.ErrorIs(t, err, utils.EntityDoesNotBelongToUserError{})
+	err = inviteService.DeleteInvite(ctx, expiringInvite.ID, alice.ID)
+	assert.NoError(t, err)
+	err = inviteService.DeleteInvite(ctx, expiringInvite.ID, alice.ID)
+	assert.ErrorAs(t, err, &utils.NotFoundError{})
+}
+
+func TestInvitesOptional(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	ctx := context.Background()
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	userService := services.NewUserService(db, false, "", 60, notificationService)
+	inviteService := services.NewInviteService(db, "https://example.com")
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(ctx, *aliceParams)
+	assert.NoError(t, err)
+
+	// unknown codes do not prevent registrations if invites are not required
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bobParams.InviteCode = "mistyped"
+	bob, err := userService.CreateUser(ctx, *bobParams)
+	assert.NoError(t, err)
+	assert.False(t, bob.InviteID.Valid)
+
+	// valid invites are still tracked
+	invite, err := inviteService.CreateInvite(ctx, services.CreateInviteParams{OwnerId: alice.ID, MaxUses: 1})
+	assert.NoError(t, err)
+	carolParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	carolParams.InviteCode = invite.Code
+	carol, err := userService.CreateUser(ctx, *carolParams)
+	assert.NoError(t, err)
+	assert.Equal(t, invite.ID, carol.InviteID.String)
+}
@@ -0,0 +1,293 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/skip2/go-qrcode"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+)
+
+const (
+	LabelTypeThing    = "thing"
+	LabelTypeList     = "list"
+	LabelTypeLocation = "location"
+)
+
+// path segments of the frontend routes for each label type
+var labelTypePaths = map[string]string{
+	LabelTypeThing:    "things",
+	LabelTypeList:     "lists",
+	LabelTypeLocation: "locations",
+}
+
+// LabelLayout describes a sheet of labels, all values are in millimeters
+type LabelLayout struct {
+	PageWidth   float64
+	PageHeight  float64
+	Columns     int
+	Rows        int
+	LabelWidth  float64
+	LabelHeight float64
+	MarginLeft  float64
+	MarginTop   float64
+	// distance between the left (top) edges of two neighbouring labels
+	PitchX float64
+	PitchY float64
+}
+
+var LabelLayouts = map[string]LabelLayout{
+	// Avery L7159, 3x8 labels on A4
+	"avery-3x8": {
+		PageWidth:   210,
+		PageHeight:  297,
+		Columns:     3,
+		Rows:        8,
+		LabelWidth:  63.5,
+		LabelHeight: 33.9,
+		MarginLeft:  6.4,
+		MarginTop:   12.9,
+		PitchX:      66.0,
+		PitchY:      33.9,
+	},
+	// Avery 5160, 3x10 labels on US letter
+	"avery-5160": {
+		PageWidth:   215.9,
+		PageHeight:  279.4,
+		Columns:     3,
+		Rows:        10,
+		LabelWidth:  66.675,
+		LabelHeight: 25.4,
+		MarginLeft:  4.7625,
+		MarginTop:   12.7,
+		PitchX:      69.85,
+		PitchY:      25.4,
+	},
+}
+
+const DefaultLabelLayout = "avery-3x8"
+
+type LabelService struct {
+	db          *sql.DB
+	frontendUrl string
+}
+
+func NewLabelService(db *sql.DB, frontendUrl string) *LabelService {
+	return &LabelService{db, strings.TrimSuffix(frontendUrl, "/")}
+}
+
+type Label struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Url  string `json:"url"`
+}
+
+func (ls *LabelService) labelUrl(labelType string, id string) string {
+	return fmt.Sprintf("%s/%s/%s", ls.frontendUrl, labelTypePaths[labelType], id)
+}
+
+// getLabel loads the entity behind a label, enforcing the access rules of
+// things, lists and locations.
+func (ls *LabelService) getLabel(ctx context.Context, labelType string, id string, userId string) (*Label, error) {
+	var name string
+	switch labelType {
+	case LabelTypeThing:
+		thing, err := operations.GetThingChecked(ctx, ls.db, id, userId)
+		if err != nil {
+			return nil, err
+		}
+		name = thing.Name
+	case LabelTypeList:
+		list, err := operations.GetListUnchecked(ctx, ls.db, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, utils.NotFoundError{EntityName: "List"}
+			}
+			return nil, err
+		}
+		if list.OwnerID != userId {
+			sharedListIds, err := operations.GetSharedListIdsForUser(ctx, ls.db, userId)
+			if err != nil {
+				return nil, err
+			}
+			if !utils.Contains(sharedListIds, id) {
+				return nil, utils.UserHasNoAccessRightsError{}
+			}
+		}
+		name = list.Name
+	case LabelTypeLocation:
+		location, err := operations.GetLocationChecked(ctx, ls.db, id, userId)
+		if err != nil {
+			return nil, err
+		}
+		name = location.Name
+	default:
+		return nil, utils.NotFoundError{EntityName: "Label"}
+	}
+	return &Label{
+		Type: labelType,
+		Id:   id,
+		Name: name,
+		Url:  ls.labelUrl(labelType, id),
+	}, nil
+}
+
+type CreateLabelSheetParams struct {
+	UserId      string
+	ThingIds    []string
+	ListIds     []string
+	LocationIds []string
+	Layout      string
+	// number of labels to leave empty at the start of the first sheet, for
+	// sheets that were already partially used
+	Skip int
+}
+
+// CreateLabelSheet renders a PDF with one QR code label per thing, list and
+// location. Each code contains the deep link into the frontend.
+func (ls *LabelService) CreateLabelSheet(ctx context.Context, params CreateLabelSheetParams, w io.Writer) error {
+	layout, ok := LabelLayouts[params.Layout]
+	if !ok {
+		return utils.ParameterError{Err: fmt.Errorf("unknown label layout %s", params.Layout)}
+	}
+	labels := []*Label{}
+	for _, ids := range []struct {
+		labelType string
+		ids       []string
+	}{
+		{LabelTypeThing, params.ThingIds},
+		{LabelTypeList, params.ListIds},
+		{LabelTypeLocation, params.LocationIds},
+	} {
+		for _, id := range ids.ids {
+			label, err := ls.getLabel(ctx, ids.labelType, id, params.UserId)
+			if err != nil {
+				return err
+			}
+			labels = append(labels, label)
+		}
+	}
+	return renderLabelSheet(layout, labels, params.Skip, w)
+}
+
+func renderLabelSheet(layout LabelLayout, labels []*Label, skip int, w io.Writer) error {
+	pdf := fpdf.NewCustom(&fpdf.InitType{
+		UnitStr: "mm",
+		Size:    fpdf.SizeType{Wd: layout.PageWidth, Ht: layout.PageHeight},
+	})
+	pdf.SetMargins(0, 0, 0)
+	pdf.SetAutoPageBreak(false, 0)
+	// the core fonts only support cp1252
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	perPage := layout.Columns * layout.Rows
+	padding := 2.0
+	qrSize := layout.LabelHeight - 2*padding
+	textX := qrSize + 2*padding
+	textWidth := layout.LabelWidth - textX - padding
+	for i, label := range labels {
+		slot := i + skip
+		if i == 0 || slot%perPage == 0 {
+			pdf.AddPage()
+		}
+		slot = slot % perPage
+		x := layout.MarginLeft + float64(slot%layout.Columns)*layout.PitchX
+		y := layout.MarginTop + float64(slot/layout.Columns)*layout.PitchY
+
+		png, err := qrcode.Encode(label.Url, qrcode.Medium, 256)
+		if err != nil {
+			return err
+		}
+		imageName := fmt.Sprintf("%s-%s", label.Type, label.Id)
+		pdf.RegisterImageOptionsReader(imageName, fpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(png))
+		pdf.ImageOptions(imageName, x+padding, y+padding, qrSize, qrSize, false, fpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+
+		pdf.SetFont("Helvetica", "B", 10)
+		lines := splitLabelText(pdf, tr, label.Name, textWidth, 3)
+		pdf.SetXY(x+textX, y+padding+1)
+		pdf.MultiCell(textWidth, 4.5, strings.Join(lines, "\n"), "", "L", false)
+
+		pdf.SetFont("Helvetica", "", 7)
+		pdf.SetXY(x+textX, y+layout.LabelHeight-padding-4)
+		pdf.CellFormat(textWidth, 3, tr(label.Id), "", 0, "L", false, 0, "")
+	}
+	if len(labels) == 0 {
+		pdf.AddPage()
+	}
+	return pdf.Output(w)
+}
+
+// splitLabelText breaks the text into at most maxLines lines that fit into
+// width. Words longer than a line are cut. fpdf's SplitText can not be used as
+// it does not support the translated cp1252 strings.
+func splitLabelText(pdf *fpdf.Fpdf, tr func(string) string, text string, width float64, maxLines int) []string {
+	lines := []string{}
+	line := ""
+	fits := func(s string) bool {
+		return pdf.GetStringWidth(tr(s)) <= width
+	}
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if fits(candidate) {
+			line = candidate
+			continue
+		}
+		if line != "" {
+			lines = append(lines, tr(line))
+		}
+		line = ""
+		for _, r := range word {
+			if !fits(line + string(r)) {
+				lines = append(lines, tr(line))
+				line = ""
+			}
+			line += string(r)
+		}
+	}
+	if line != "" {
+		lines = append(lines, tr(line))
+	}
+	if len(lines) > maxLines {
+		lines = lines[:maxLines]
+	}
+	return lines
+}
+
+// ResolveLabel maps the content of a scanned label back to the thing, list or
+// location. Codes of other instances are not resolved.
+func (ls *LabelService) ResolveLabel(ctx context.Context, code string, userId string) (*Label, error) {
+	parsed, err := url.Parse(strings.TrimSpace(code))
+	if err != nil {
+		return nil, utils.ParameterError{Err: err}
+	}
+	frontend, err := url.Parse(ls.frontendUrl)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.Host != "" && parsed.Host != frontend.Host {
+		return nil, utils.NotFoundError{EntityName: "Label"}
+	}
+	path := strings.TrimPrefix(parsed.Path, frontend.Path)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) != 2 {
+		return nil, utils.NotFoundError{EntityName: "Label"}
+	}
+	for labelType, typePath := range labelTypePaths {
+		if typePath == segments[0] {
+			return ls.getLabel(ctx, labelType, segments[1], userId)
+		}
+	}
+	return nil, utils.NotFoundError{EntityName: "Label"}
+}
@@ -0,0 +1,80 @@
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabels(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	is, err := services.NewTmpImageService(db)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		os.Remove(is.StorePath())
+	})
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	thingService := services.NewThingService(db, is, notificationService)
+	labelService := services.NewLabelService(db, "https://example.com/")
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	thingParams := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+	thingParams.OwnerId = alice.ID
+	thing, err := thingService.CreateThing(context.Background(), *thingParams)
+	assert.NoError(t, err)
+
+	buf := bytes.Buffer{}
+	err = labelService.CreateLabelSheet(context.Background(), services.CreateLabelSheetParams{
+		UserId:   alice.ID,
+		ThingIds: []string{thing.ID},
+		Layout:   services.DefaultLabelLayout,
+	}, &buf)
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), []byte("%PDF")))
+
+	err = labelService.CreateLabelSheet(context.Background(), services.CreateLabelSheetParams{
+		UserId:   bob.ID,
+		ThingIds: []string{thing.ID},
+		Layout:   services.DefaultLabelLayout,
+	}, &bytes.Buffer{})
+	assert.Error(t, err)
+
+	label, err := labelService.ResolveLabel(context.Background(), "https://example.com/things/"+thing.ID, alice.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, services.LabelTypeThing, label.Type)
+	assert.Equal(t, thing.ID, label.Id)
+	assert.Equal(t, thing.Name, label.Name)
+
+	_, err = labelService.ResolveLabel(context.Background(), "https://example.com/things/"+thing.ID, bob.ID)
+	assert.ErrorIs(t, err, utils.UserHasNoAccessRightsError{})
+
+	// codes of other instances are not resolved
+	_, err = labelService.ResolveLabel(context.Background(), "https://other.example.org/things/"+thing.ID, alice.ID)
+	assert.ErrorAs(t, err, &utils.NotFoundError{})
+}
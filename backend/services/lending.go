@@ -0,0 +1,301 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+)
+
+// loans without an explicit due date are due after this duration
+const DefaultLoanDuration = 14 * 24 * time.Hour
+
+type LendingService struct {
+	db *sql.DB
+	ns *NotificationService
+}
+
+func NewLendingService(db *sql.DB, ns *NotificationService) *LendingService {
+	return &LendingService{db, ns}
+}
+
+type CheckoutCartParams struct {
+	UserId string
+	DueAt  *time.Time
+}
+
+// CheckoutCart turns the cart of a user into borrow requests, one per thing,
+// and notifies every owner once. Things owned by the user and things for
+// which a pending request already exists are skipped. The cart is emptied.
+func (ls *LendingService) CheckoutCart(ctx context.Context, params CheckoutCartParams) (models.BorrowRequestSlice, error) {
+	if params.DueAt != nil && params.DueAt.Before(time.Now()) {
+		return nil, utils.ParameterError{Err: errors.New("due date must be in the future")}
+	}
+	requestIds := []string{}
+	requestIdsByOwner := make(map[string][]string)
+	err := utils.Tx(ctx, ls.db, func(tx *sql.Tx) error {
+		entries, err := operations.GetCart(ctx, tx, params.UserId)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			thing, err := operations.GetThingChecked(ctx, tx, entry.ThingID, params.UserId)
+			if err != nil {
+				if errors.Is(err, utils.UserHasNoAccessRightsError{}) {
+					continue
+				}
+				return err
+			}
+			if thing.OwnerID == params.UserId {
+				continue
+			}
+			pending, err := operations.HasPendingBorrowRequest(ctx, tx, thing.ID, params.UserId)
+			if err != nil {
+				return err
+			}
+			if pending {
+				continue
+			}
+			request, err := operations.CreateBorrowRequest(ctx, tx, thing, params.UserId, params.DueAt)
+			if err != nil {
+				return err
+			}
+			requestIds = append(requestIds, request.ID)
+			requestIdsByOwner[thing.OwnerID] = append(requestIdsByOwner[thing.OwnerID], request.ID)
+		}
+		_, err = entries.DeleteAll(ctx, tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for ownerId, ownerRequestIds := range requestIdsByOwner {
+		err = ls.ns.BorrowRequested(ctx, BorrowRequestedParams{
+			OwnerId:    ownerId,
+			BorrowerId: params.UserId,
+			RequestIds: ownerRequestIds,
+		})
+		if err != nil {
+			log.Error().Msgf("Could not create notification: %v", err)
+		}
+	}
+	if len(requestIds) == 0 {
+		return models.BorrowRequestSlice{}, nil
+	}
+	return operations.GetBorrowRequests(ctx, ls.db, models.BorrowRequestWhere.ID.IN(requestIds))
+}
+
+type BorrowRequestsResult struct {
+	Received models.BorrowRequestSlice
+	Sent     models.BorrowRequestSlice
+}
+
+func (ls *LendingService) GetBorrowRequests(ctx context.Context, userId string) (*BorrowRequestsResult, error) {
+	received, err := operations.GetBorrowRequests(ctx, ls.db, models.BorrowRequestWhere.OwnerID.EQ(userId))
+	if err != nil {
+		return nil, err
+	}
+	sent, err := operations.GetBorrowRequests(ctx, ls.db, models.BorrowRequestWhere.BorrowerID.EQ(userId))
+	if err != nil {
+		return nil, err
+	}
+	return &BorrowRequestsResult{
+		Received: received,
+		Sent:     sent,
+	}, nil
+}
+
+type ReactBorrowRequestParams struct {
+	RequestId string
+	UserId    string
+	Accept    bool
+	// DueAt overrides the due date requested by the borrower
+	DueAt *time.Time
+}
+
+func (ls *LendingService) ReactBorrowRequest(ctx context.Context, params ReactBorrowRequestParams) (*models.BorrowRequest, error) {
+	if params.DueAt != nil && params.DueAt.Before(time.Now()) {
+		return nil, utils.ParameterError{Err: errors.New("due date must be in the future")}
+	}
+	var outerRequest *models.BorrowRequest
+	var outerLoan *models.Loan
+	err := utils.Tx(ctx, ls.db, func(tx *sql.Tx) error {
+		request, err := operations.GetBorrowRequest(ctx, tx, params.RequestId)
+		if err != nil {
+			return err
+		}
+		// only the owner can accept or reject a borrow request
+		if request.OwnerID != params.UserId {
+			return utils.EntityDoesNotBelongToUserError{}
+		}
+		if request.State != models.BorrowRequestStatePending {
+			return utils.BorrowRequestNotPendingError{}
+		}
+		if !params.Accept {
+			request.State = models.BorrowRequestStateRejected
+		} else {
+			request.State = models.BorrowRequestStateAccepted
+			dueAt := time.Now().Add(DefaultLoanDuration)
+			if params.DueAt != nil {
+				dueAt = *params.DueAt
+			} else if request.RequestedDueAt.Valid && request.RequestedDueAt.Time.After(time.Now()) {
+				dueAt = request.RequestedDueAt.Time
+			}
+			loan, err := operations.CreateLoan(ctx, tx, request, dueAt)
+			if err != nil {
+				return err
+			}
+			outerLoan = loan
+		}
+		_, err = request.Update(ctx, tx, boil.Whitelist(models.BorrowRequestColumns.State))
+		if err != nil {
+			return err
+		}
+		outerRequest = request
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notificationParams := BorrowRequestReactionParams{
+		RequestId:  outerRequest.ID,
+		ThingId:    outerRequest.ThingID,
+		ThingName:  outerRequest.R.Thing.Name,
+		OwnerId:    outerRequest.OwnerID,
+		BorrowerId: outerRequest.BorrowerID,
+		Accepted:   params.Accept,
+	}
+	if outerLoan != nil {
+		notificationParams.LoanId = &outerLoan.ID
+		notificationParams.DueAt = &outerLoan.DueAt
+	}
+	err = ls.ns.BorrowRequestReaction(ctx, notificationParams)
+	if err != nil {
+		log.Error().Msgf("Could not create notification: %v", err)
+	}
+	return operations.GetBorrowRequest(ctx, ls.db, outerRequest.ID)
+}
+
+type CancelBorrowRequestParams struct {
+	RequestId string
+	UserId    string
+}
+
+func (ls *LendingService) CancelBorrowRequest(ctx context.Context, params CancelBorrowRequestParams) error {
+	return utils.Tx(ctx, ls.db, func(tx *sql.Tx) error {
+		request, err := operations.GetBorrowRequest(ctx, tx, params.RequestId)
+		if err != nil {
+			return err
+		}
+		if request.BorrowerID != params.UserId {
+			return utils.EntityDoesNotBelongToUserError{}
+		}
+		if request.State != models.BorrowRequestStatePending {
+			return utils.BorrowRequestNotPendingError{}
+		}
+		_, err = request.Delete(ctx, tx)
+		return err
+	})
+}
+
+type LoansResult struct {
+	Lent     models.LoanSlice
+	Borrowed models.LoanSlice
+}
+
+func (ls *LendingService) GetLoans(ctx context.Context, userId string) (*LoansResult, error) {
+	lent, err := operations.GetLoans(ctx, ls.db, models.LoanWhere.OwnerID.EQ(userId))
+	if err != nil {
+		return nil, err
+	}
+	borrowed, err := operations.GetLoans(ctx, ls.db, models.LoanWhere.BorrowerID.EQ(userId))
+	if err != nil {
+		return nil, err
+	}
+	return &LoansResult{
+		Lent:     lent,
+		Borrowed: borrowed,
+	}, nil
+}
+
+type ReturnLoanParams struct {
+	LoanId string
+	UserId string
+}
+
+// ReturnLoan is called by the owner once the thing is back
+func (ls *LendingService) ReturnLoan(ctx context.Context, params ReturnLoanParams) (*models.Loan, error) {
+	var outerLoan *models.Loan
+	err := utils.Tx(ctx, ls.db, func(tx *sql.Tx) error {
+		loan, err := operations.GetLoan(ctx, tx, params.LoanId)
+		if err != nil {
+			return err
+		}
+		if loan.OwnerID != params.UserId {
+			return utils.EntityDoesNotBelongToUserError{}
+		}
+		if loan.State == models.LoanStateReturned {
+			return utils.LoanNotActiveError{}
+		}
+		loan.State = models.LoanStateReturned
+		loan.ReturnedAt = null.TimeFrom(time.Now())
+		_, err = loan.Update(ctx, tx, boil.Whitelist(models.LoanColumns.State, models.LoanColumns.ReturnedAt))
+		if err != nil {
+			return err
+		}
+		outerLoan = loan
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = ls.ns.LoanReturned(ctx, LoanReturnedParams{
+		LoanId:     outerLoan.ID,
+		ThingId:    outerLoan.ThingID,
+		ThingName:  outerLoan.R.Thing.Name,
+		OwnerId:    outerLoan.OwnerID,
+		BorrowerId: outerLoan.BorrowerID,
+	})
+	if err != nil {
+		log.Error().Msgf("Could not create notification: %v", err)
+	}
+	return outerLoan, nil
+}
+
+// MarkOverdueLoans flags all loans past their due date as overdue and
+// notifies borrower and owner. Returns the number of affected loans.
+func (ls *LendingService) MarkOverdueLoans(ctx context.Context) (int, error) {
+	var loans models.LoanSlice
+	err := utils.Tx(ctx, ls.db, func(tx *sql.Tx) error {
+		var err error
+		loans, err = operations.MarkOverdueLoans(ctx, tx, time.Now())
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, loan := range loans {
+		err = ls.ns.LoanOverdue(ctx, LoanOverdueParams{
+			LoanId:     loan.ID,
+			ThingId:    loan.ThingID,
+			ThingName:  loan.R.Thing.Name,
+			OwnerId:    loan.OwnerID,
+			BorrowerId: loan.BorrowerID,
+			DueAt:      loan.DueAt,
+		})
+		if err != nil {
+			log.Error().Msgf("Could not create notification: %v", err)
+		}
+	}
+	return len(loans), nil
+}
@@ -0,0 +1,245 @@
+package services_test
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/notifications"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLendingWorkflow(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	is, err := services.NewTmpImageService(db)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		os.Remove(is.StorePath())
+	})
+	userService := services.NewUserService(db, false, "", 60, nil)
+	cartService := services.NewCartService(db)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	thingService := services.NewThingService(db, is, notificationService)
+	shareService := services.NewShareService(db, notificationService)
+	lendingService := services.NewLendingService(db, notificationService)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	bobThings := []string{}
+	for range 2 {
+		thingParams := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+		thingParams.OwnerId = bob.ID
+		thing, err := thingService.CreateThing(context.Background(), *thingParams)
+		assert.NoError(t, err)
+		bobThings = append(bobThings, thing.ID)
+		_, err = shareService.CreateThingShare(context.Background(), services.CreateThingShareParams{
+			ThingId:      thing.ID,
+			OwnerId:      bob.ID,
+			TargetUserId: alice.ID,
+		})
+		assert.NoError(t, err)
+	}
+
+	_, err = cartService.UpdateCart(context.Background(), services.UpdateCartParams{
+		UserId:   alice.ID,
+		ThingIds: bobThings,
+	})
+	assert.NoError(t, err)
+
+	emailService.Clear()
+	requests, err := lendingService.CheckoutCart(context.Background(), services.CheckoutCartParams{
+		UserId: alice.ID,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, requests, 2)
+	for _, request := range requests {
+		assert.Equal(t, models.BorrowRequestStatePending, request.State)
+		assert.Equal(t, bob.ID, request.OwnerID)
+		assert.Equal(t, alice.ID, request.BorrowerID)
+	}
+	// bob is notified once for both things
+	assert.Len(t, emailService.Mails, 1)
+	assert.Equal(t, bob.Email, emailService.Mails[0].To)
+
+	cart, err := cartService.GetCart(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, cart, "checkout empties the cart")
+
+	// alice cannot react to her own request
+	_, err = lendingService.ReactBorrowRequest(context.Background(), services.ReactBorrowRequestParams{
+		RequestId: requests[0].ID,
+		UserId:    alice.ID,
+		Accept:    true,
+	})
+	assert.ErrorIs(t, err, utils.EntityDoesNotBelongToUserError{})
+
+	dueAt := time.Now().Add(48 * time.Hour)
+	accepted, err := lendingService.ReactBorrowRequest(context.Background(), services.ReactBorrowRequestParams{
+		RequestId: requests[0].ID,
+		UserId:    bob.ID,
+		Accept:    true,
+		DueAt:     &dueAt,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.BorrowRequestStateAccepted, accepted.State)
+
+	_, err = lendingService.ReactBorrowRequest(context.Background(), services.ReactBorrowRequestParams{
+		RequestId: requests[0].ID,
+		UserId:    bob.ID,
+		Accept:    false,
+	})
+	assert.ErrorIs(t, err, utils.BorrowRequestNotPendingError{})
+
+	rejected, err := lendingService.ReactBorrowRequest(context.Background(), services.ReactBorrowRequestParams{
+		RequestId: requests[1].ID,
+		UserId:    bob.ID,
+		Accept:    false,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.BorrowRequestStateRejected, rejected.State)
+
+	loans, err := lendingService.GetLoans(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Len(t, loans.Borrowed, 1)
+	assert.Empty(t, loans.Lent)
+	loan := loans.Borrowed[0]
+	assert.Equal(t, models.LoanStateLent, loan.State)
+	assert.Equal(t, requests[0].ThingID, loan.ThingID)
+
+	_, err = lendingService.ReturnLoan(context.Background(), services.ReturnLoanParams{
+		LoanId: loan.ID,
+		UserId: alice.ID,
+	})
+	assert.ErrorIs(t, err, utils.EntityDoesNotBelongToUserError{})
+
+	returned, err := lendingService.ReturnLoan(context.Background(), services.ReturnLoanParams{
+		LoanId: loan.ID,
+		UserId: bob.ID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.LoanStateReturned, returned.State)
+	assert.True(t, returned.ReturnedAt.Valid)
+
+	_, err = lendingService.ReturnLoan(context.Background(), services.ReturnLoanParams{
+		LoanId: loan.ID,
+		UserId: bob.ID,
+	})
+	assert.ErrorIs(t, err, utils.LoanNotActiveError{})
+}
+
+func TestLendingOverdue(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	is, err := services.NewTmpImageService(db)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		os.Remove(is.StorePath())
+	})
+	userService := services.NewUserService(db, false, "", 60, nil)
+	cartService := services.NewCartService(db)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	thingService := services.NewThingService(db, is, notificationService)
+	shareService := services.NewShareService(db, notificationService)
+	lendingService := services.NewLendingService(db, notificationService)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	thingParams := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+	thingParams.OwnerId = bob.ID
+	thing, err := thingService.CreateThing(context.Background(), *thingParams)
+	assert.NoError(t, err)
+	_, err = shareService.CreateThingShare(context.Background(), services.CreateThingShareParams{
+		ThingId:      thing.ID,
+		OwnerId:      bob.ID,
+		TargetUserId: alice.ID,
+	})
+	assert.NoError(t, err)
+
+	_, err = cartService.UpdateCart(context.Background(), services.UpdateCartParams{
+		UserId:   alice.ID,
+		ThingIds: []string{thing.ID},
+	})
+	assert.NoError(t, err)
+	requests, err := lendingService.CheckoutCart(context.Background(), services.CheckoutCartParams{
+		UserId: alice.ID,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, requests, 1)
+
+	_, err = lendingService.ReactBorrowRequest(context.Background(), services.ReactBorrowRequestParams{
+		RequestId: requests[0].ID,
+		UserId:    bob.ID,
+		Accept:    true,
+	})
+	assert.NoError(t, err)
+
+	// nothing is overdue yet
+	count, err := lendingService.MarkOverdueLoans(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	loan, err := models.Loans(models.LoanWhere.ThingID.EQ(thing.ID)).One(context.Background(), db)
+	assert.NoError(t, err)
+	loan.DueAt = time.Now().Add(-time.Hour)
+	_, err = loan.Update(context.Background(), db, boil.Whitelist(models.LoanColumns.DueAt))
+	assert.NoError(t, err)
+
+	emailService.Clear()
+	count, err = lendingService.MarkOverdueLoans(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	// borrower and owner are notified
+	assert.Len(t, emailService.Mails, 2)
+
+	_, aliceNotifications, err := notificationService.GetNotifications(context.Background(), services.GetNotificationsForUserParams{
+		UserId:   alice.ID,
+		Paginate: false,
+		PerPage:  50,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, notifications.NotifyLoanOverdue, aliceNotifications[0].ContentType)
+
+	err = loan.Reload(context.Background(), db)
+	assert.NoError(t, err)
+	assert.Equal(t, models.LoanStateOverdue, loan.State)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"math"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -89,6 +88,10 @@ func (ls *ListService) CreateList(ctx context.Context, params CreateListParams)
 			}
 		}
 
+		err = operations.EnqueueListWebhookEvent(ctx, tx, operations.WebhookListCreated, &list)
+		if err != nil {
+			return err
+		}
 		outerList = &list
 		return nil
 	})
@@ -251,6 +254,10 @@ func (ls *ListService) UpdateList(ctx context.Context, listId string, userId str
 		if err != nil {
 			return err
 		}
+		err = operations.EnqueueListWebhookEvent(ctx, tx, operations.WebhookListUpdated, list)
+		if err != nil {
+			return err
+		}
 
 		outerList = list
 		return nil
@@ -281,22 +288,26 @@ type GetListsForUserParams struct {
 	Page           uint64
 	Paginate       bool
 	FilterOwnerIds []string
+	Sort           operations.SortParams
+	// nil uses offset pagination, an empty cursor requests the first page in
+	// cursor mode
+	Cursor *string
 }
 
-func (ls *ListService) GetListsForUser(ctx context.Context, params GetListsForUserParams) (uint64, uint64, models.ListSlice, error) {
+func (ls *ListService) GetListsForUser(ctx context.Context, params GetListsForUserParams) (PageInfo, models.ListSlice, error) {
 	userId, perPage, page, paginate, filterUserIds := params.UserId, params.PerPage, params.Page, params.Paginate, params.FilterOwnerIds
 
 	tx, err := ls.db.BeginTx(ctx, &sql.TxOptions{
 		ReadOnly: true,
 	})
 	if err != nil {
-		return 0, 0, nil, err
+		return PageInfo{}, nil, err
 	}
 	defer tx.Rollback()
 
 	sharedListIds, err := operations.GetSharedListIdsForUser(ctx, tx, userId)
 	if err != nil {
-		return 0, 0, nil, err
+		return PageInfo{}, nil, err
 	}
 	interfaceIds := make([]interface{}, len(sharedListIds))
 	for i, s := range sharedListIds {
@@ -316,35 +327,57 @@ func (ls *ListService) GetListsForUser(ctx context.Context, params GetListsForUs
 		searchCond = qm.Expr(searchCond, qm.AndIn("owner_id in ?", filterUserInterfaceIds...))
 	}
 
-	listCount, err := models.Lists(searchCond).Count(ctx, tx)
+	sortOrder, err := operations.ListSortOrder(params.Sort)
 	if err != nil {
-		return 0, 0, nil, err
+		return PageInfo{}, nil, utils.ParameterError{Err: err}
 	}
 
 	// empty expr for no pagination
 	listQuery := []qm.QueryMod{}
-	if paginate {
-		listQuery = append(listQuery, qm.Offset(int(perPage*page)), qm.Limit(int(perPage)))
+	var listCount int64
+	if params.Cursor != nil {
+		cursorMods, err := cursorQueryMods(sortOrder, *params.Cursor, perPage)
+		if err != nil {
+			return PageInfo{}, nil, err
+		}
+		listQuery = append(listQuery, cursorMods...)
+	} else {
+		listCount, err = models.Lists(searchCond).Count(ctx, tx)
+		if err != nil {
+			return PageInfo{}, nil, err
+		}
+		if paginate {
+			listQuery = append(listQuery, qm.Offset(int(perPage*page)), qm.Limit(int(perPage)))
+		}
 	}
 
-	sortCond := qm.OrderBy(models.ThingColumns.CreatedAt)
-
 	listQuery = append(listQuery,
 		qm.Load(qm.Rels(models.ListRels.Things, models.ThingRels.Owner)),
 		qm.Load(qm.Rels(models.ListRels.Things, models.ThingRels.ImagesThings, models.ImagesThingRels.Image)),
 		qm.Load(models.ListRels.Owner),
 		searchCond,
-		sortCond,
+		sortOrder.QueryMod(),
 	)
 
 	lists, err := models.Lists(listQuery...).All(ctx, tx)
 	if err != nil {
-		return 0, 0, nil, err
+		return PageInfo{}, nil, err
 	}
 
-	totalPages := uint64(math.Ceil(float64(listCount) / float64(perPage)))
-
-	return uint64(listCount), totalPages, lists, nil
+	pageInfo := offsetPageInfo(listCount, perPage)
+	if params.Cursor != nil {
+		ids := make([]string, len(lists))
+		for i, list := range lists {
+			ids[i] = list.ID
+		}
+		var n int
+		n, pageInfo, err = cursorPageInfo(ctx, tx, sortOrder, perPage, ids)
+		if err != nil {
+			return PageInfo{}, nil, err
+		}
+		lists = lists[:n]
+	}
+	return pageInfo, lists, nil
 }
 
 func (ls *ListService) GetListsWhereThingIsPartOf(ctx context.Context, thingId string) (models.ListSlice, error) {
@@ -374,6 +407,10 @@ func (ls *ListService) GetList(ctx context.Context, listId string, userId string
 	if !authorized {
 		return nil, utils.UserHasNoAccessRightsError{}
 	}
+	err = operations.LoadLocationPaths(ctx, ls.db, userId, list.R.Things...)
+	if err != nil {
+		return nil, err
+	}
 	return list, nil
 }
 
@@ -394,6 +431,10 @@ func (ts *ListService) DeleteList(ctx context.Context, listId string, userId str
 			return utils.EntityDoesNotBelongToUserError{}
 		}
 
+		err = operations.EnqueueListWebhookEvent(ctx, tx, operations.WebhookListDeleted, list)
+		if err != nil {
+			return err
+		}
 		return operations.DeleteList(ctx, tx, list)
 	})
 	return err
@@ -741,7 +741,7 @@ func TestGetListsForUserThingInMultipleListsHasImages(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Fetch all lists via GetListsForUser
-	_, _, lists, err := listService.GetListsForUser(context.Background(), services.GetListsForUserParams{
+	_, lists, err := listService.GetListsForUser(context.Background(), services.GetListsForUserParams{
 		UserId:   alice.ID,
 		PerPage:  50,
 		Page:     0,
@@ -822,7 +822,7 @@ func TestGetListsForUserFilterOwnerIds(t *testing.T) {
 	assert.NoError(t, err)
 
 	// alice should see all 3 lists without filter
-	_, _, lists, err := listService.GetListsForUser(context.Background(), services.GetListsForUserParams{
+	_, lists, err := listService.GetListsForUser(context.Background(), services.GetListsForUserParams{
 		UserId:   alice.ID,
 		PerPage:  50,
 		Page:     0,
@@ -832,7 +832,7 @@ func TestGetListsForUserFilterOwnerIds(t *testing.T) {
 	assert.Len(t, lists, 3, "alice should see all 3 lists without filter")
 
 	// filter by bob's ID - should only return bob's list
-	_, _, lists, err = listService.GetListsForUser(context.Background(), services.GetListsForUserParams{
+	_, lists, err = listService.GetListsForUser(context.Background(), services.GetListsForUserParams{
 		UserId:         alice.ID,
 		PerPage:        50,
 		Page:           0,
@@ -844,7 +844,7 @@ func TestGetListsForUserFilterOwnerIds(t *testing.T) {
 	assert.Equal(t, bobList.ID, lists[0].ID)
 
 	// filter by charlie's ID - should only return charlie's list
-	_, _, lists, err = listService.GetListsForUser(context.Background(), services.GetListsForUserParams{
+	_, lists, err = listService.GetListsForUser(context.Background(), services.GetListsForUserParams{
 		UserId:         alice.ID,
 		PerPage:        50,
 		Page:           0,
@@ -856,7 +856,7 @@ func TestGetListsForUserFilterOwnerIds(t *testing.T) {
 	assert.Equal(t, charlieList.ID, lists[0].ID)
 
 	// filter by bob and charlie - should return both their lists but not alice's
-	_, _, lists, err = listService.GetListsForUser(context.Background(), services.GetListsForUserParams{
+	_, lists, err = listService.GetListsForUser(context.Background(), services.GetListsForUserParams{
 		UserId:         alice.ID,
 		PerPage:        50,
 		Page:           0,
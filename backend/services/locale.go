@@ -0,0 +1,35 @@
+package services
+
+import (
+	"time"
+
+	"github.com/stashsphere/backend/utils"
+)
+
+// date layouts used in emails, by locale
+var dateLayouts = map[string]string{
+	"en": "January 2, 2006",
+	"de": "2.1.2006",
+	"fr": "02/01/2006",
+}
+
+var dateTimeLayouts = map[string]string{
+	"en": "January 2, 2006 at 15:04 MST",
+	"de": "2.1.2006 um 15:04 MST",
+	"fr": "02/01/2006 à 15:04 MST",
+}
+
+func localizedLayout(layouts map[string]string, locale string) string {
+	if layout, ok := layouts[locale]; ok {
+		return layout
+	}
+	return layouts[utils.DefaultLocale]
+}
+
+func formatDate(locale string, t time.Time) string {
+	return t.Format(localizedLayout(dateLayouts, locale))
+}
+
+func formatDateTime(locale string, t time.Time) string {
+	return t.Format(localizedLayout(dateTimeLayouts, locale))
+}
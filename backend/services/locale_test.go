@@ -0,0 +1,121 @@
+package services_test
+
+import (
+	"context"
+	"io/fs"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/notifications/templates"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreferredLocale(t *testing.T) {
+	assert.Equal(t, "en", utils.PreferredLocale(""))
+	assert.Equal(t, "de", utils.PreferredLocale("de-DE,de;q=0.9,en;q=0.8"))
+	assert.Equal(t, "fr", utils.PreferredLocale("es, fr-CH;q=0.7, en;q=0.5"))
+	assert.Equal(t, "en", utils.PreferredLocale("de;q=0.2, EN-us"))
+	assert.Equal(t, "en", utils.PreferredLocale("ja, de;q=0"))
+}
+
+func TestTemplateTranslations(t *testing.T) {
+	textNames, err := fs.Glob(templates.FS, "*.txt")
+	assert.NoError(t, err)
+	htmlNames, err := fs.Glob(templates.FS, "*.html")
+	assert.NoError(t, err)
+	for _, locale := range utils.SupportedLocales[1:] {
+		for _, name := range textNames {
+			_, err := fs.Stat(templates.FS, path.Join(locale, name))
+			assert.NoError(t, err, "missing %s translation of %s", locale, name)
+			templ, err := templates.ParseText(locale, name)
+			assert.NoError(t, err)
+			assert.Equal(t, name, templ.Name())
+		}
+		for _, name := range htmlNames {
+			_, err := fs.Stat(templates.FS, path.Join(locale, name))
+			assert.NoError(t, err, "missing %s translation of %s", locale, name)
+			templ, err := templates.ParseHTML(locale, name)
+			assert.NoError(t, err)
+			assert.Equal(t, name, templ.Name())
+		}
+	}
+	// every email has an HTML body next to its plain text body
+	for _, name := range textNames {
+		if !strings.HasSuffix(name, ".body.txt") {
+			continue
+		}
+		_, err := fs.Stat(templates.FS, strings.TrimSuffix(name, ".txt")+".html")
+		assert.NoError(t, err, "missing HTML body for %s", name)
+	}
+	// unknown locales fall back to English
+	_, err = templates.ParseText("xx", "friend_request.body.txt")
+	assert.NoError(t, err)
+	_, err = templates.ParseHTML("xx", "friend_request.body.html")
+	assert.NoError(t, err)
+}
+
+func TestLocalizedEmails(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+		ApiUrl:       "https://api.example.com",
+	}, &emailService)
+	friendService := services.NewFriendService(db, notificationService)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+	assert.Equal(t, "en", alice.Locale)
+
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bobParams.Locale = "xx"
+	_, err = userService.CreateUser(context.Background(), *bobParams)
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+	bobParams.Locale = "de"
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	_, err = friendService.CreateFriendRequest(context.Background(), services.CreateFriendRequestParams{
+		UserId:     alice.ID,
+		ReceiverId: bob.ID,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, emailService.Mails, 1)
+	assert.Equal(t, bob.Email, emailService.Mails[0].To)
+	assert.Equal(t, "[StashsphereTest] Du hast eine neue Freundschaftsanfrage", emailService.Mails[0].Subject)
+	assert.Contains(t, emailService.Mails[0].HTML, "du hast eine neue Freundschaftsanfrage")
+	assert.Contains(t, emailService.Mails[0].HTML, "Benachrichtigungseinstellungen")
+	assert.True(t, strings.HasPrefix(emailService.Mails[0].UnsubscribeUrl, "https://api.example.com/api/notifications/unsubscribe?"))
+	assert.Contains(t, emailService.Mails[0].UnsubscribeUrl, "contentType=FRIEND_REQUEST")
+
+	french := "fr"
+	_, err = userService.UpdateUser(context.Background(), services.UpdateUserParams{
+		UserId: alice.ID,
+		Name:   alice.Name,
+		Locale: &french,
+	})
+	assert.NoError(t, err)
+	emailService.Mails = nil
+	err = notificationService.ThingShared(context.Background(), services.ThingSharedParams{
+		ThingId:      "thing",
+		SharerId:     bob.ID,
+		TargetUserId: alice.ID,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, emailService.Mails, 1)
+	assert.Equal(t, "[StashsphereTest] Un objet a été partagé avec vous", emailService.Mails[0].Subject)
+}
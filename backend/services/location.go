@@ -0,0 +1,348 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+)
+
+type LocationService struct {
+	db *sql.DB
+}
+
+func NewLocationService(db *sql.DB) *LocationService {
+	return &LocationService{db}
+}
+
+func sharingStateFromString(state string) models.SharingState {
+	switch state {
+	case "friends":
+		return models.SharingStateFriends
+	case "friends-of-friends":
+		return models.SharingStateFriendsOfFriends
+	}
+	return models.SharingStatePrivate
+}
+
+// checkParentLocation makes sure the parent exists and belongs to the user
+func checkParentLocation(ctx context.Context, exec boil.ContextExecutor, parentId string, userId string) error {
+	parent, err := operations.GetLocationUnchecked(ctx, exec, parentId)
+	if err != nil {
+		return err
+	}
+	if parent.OwnerID != userId {
+		return utils.EntityDoesNotBelongToUserError{}
+	}
+	return nil
+}
+
+type CreateLocationParams struct {
+	Name         string
+	Description  string
+	OwnerId      string
+	ParentId     *string
+	SharingState string
+}
+
+func (ls *LocationService) CreateLocation(ctx context.Context, params CreateLocationParams) (*models.Location, error) {
+	var outerLocation *models.Location
+	err := utils.Tx(ctx, ls.db, func(tx *sql.Tx) error {
+		if params.ParentId != nil {
+			err := checkParentLocation(ctx, tx, *params.ParentId, params.OwnerId)
+			if err != nil {
+				return err
+			}
+		}
+		locationId, err := gonanoid.New()
+		if err != nil {
+			return err
+		}
+		location := models.Location{
+			ID:           locationId,
+			Name:         params.Name,
+			Description:  params.Description,
+			OwnerID:      params.OwnerId,
+			ParentID:     null.StringFromPtr(params.ParentId),
+			SharingState: sharingStateFromString(params.SharingState),
+		}
+		err = location.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+		outerLocation = &location
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ls.GetLocation(ctx, outerLocation.ID, outerLocation.OwnerID)
+}
+
+type UpdateLocationParams struct {
+	Name         string
+	Description  string
+	ParentId     *string
+	SharingState string
+}
+
+// UpdateLocation edits a location. Changing the parent moves the location
+// together with everything inside it.
+func (ls *LocationService) UpdateLocation(ctx context.Context, locationId string, userId string, params UpdateLocationParams) (*models.Location, error) {
+	err := utils.Tx(ctx, ls.db, func(tx *sql.Tx) error {
+		location, err := operations.GetLocationUnchecked(ctx, tx, locationId)
+		if err != nil {
+			return err
+		}
+		if location.OwnerID != userId {
+			return utils.EntityDoesNotBelongToUserError{}
+		}
+		if params.ParentId != nil {
+			err := checkParentLocation(ctx, tx, *params.ParentId, userId)
+			if err != nil {
+				return err
+			}
+			subtreeIds, err := operations.GetLocationSubtreeIds(ctx, tx, locationId)
+			if err != nil {
+				return err
+			}
+			if utils.Contains(subtreeIds, *params.ParentId) {
+				return utils.StashSphereValidationError{Errors: map[string]string{
+					"parentId": "a location can not be moved into itself",
+				}}
+			}
+		}
+		location.Name = params.Name
+		location.Description = params.Description
+		location.ParentID = null.StringFromPtr(params.ParentId)
+		location.SharingState = sharingStateFromString(params.SharingState)
+		_, err = location.Update(ctx, tx, boil.Infer())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ls.GetLocation(ctx, locationId, userId)
+}
+
+// GetLocation returns the location with its path loaded
+func (ls *LocationService) GetLocation(ctx context.Context, locationId string, userId string) (*models.Location, error) {
+	location, err := operations.GetLocationChecked(ctx, ls.db, locationId, userId)
+	if err != nil {
+		return nil, err
+	}
+	err = operations.LoadLocationPath(ctx, ls.db, userId, location)
+	if err != nil {
+		return nil, err
+	}
+	return location, nil
+}
+
+// GetLocationsForUser returns all own and shared locations of the user. The
+// tree can be rebuilt from the parent ids.
+func (ls *LocationService) GetLocationsForUser(ctx context.Context, userId string) (models.LocationSlice, error) {
+	sharedLocationIds, err := operations.GetSharedLocationIdsForUser(ctx, ls.db, userId)
+	if err != nil {
+		return nil, err
+	}
+	locations, err := models.Locations(
+		qm.Expr(
+			models.LocationWhere.OwnerID.EQ(userId),
+			qm.Or2(models.LocationWhere.ID.IN(sharedLocationIds)),
+		),
+		qm.Load(models.LocationRels.Owner),
+		qm.OrderBy(models.LocationColumns.Name),
+	).All(ctx, ls.db)
+	if err != nil {
+		return nil, err
+	}
+	err = operations.LoadLocationParentPaths(ctx, ls.db, userId, locations...)
+	if err != nil {
+		return nil, err
+	}
+	return locations, nil
+}
+
+type LocationTree struct {
+	Location *models.Location
+	Children []*LocationTree
+	Things   models.ThingSlice
+}
+
+// GetLocationTree returns the location and everything below it. Locations and
+// things the user cannot see are left out, including everything below a
+// hidden location.
+func (ls *LocationService) GetLocationTree(ctx context.Context, locationId string, userId string) (*LocationTree, error) {
+	tx, err := ls.db.BeginTx(ctx, &sql.TxOptions{
+		ReadOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	root, err := operations.GetLocationChecked(ctx, tx, locationId, userId)
+	if err != nil {
+		return nil, err
+	}
+	err = operations.LoadLocationPath(ctx, tx, userId, root)
+	if err != nil {
+		return nil, err
+	}
+
+	subtreeIds, err := operations.GetLocationSubtreeIds(ctx, tx, locationId)
+	if err != nil {
+		return nil, err
+	}
+	locations, err := models.Locations(
+		models.LocationWhere.ID.IN(subtreeIds),
+		models.LocationWhere.ID.NEQ(locationId),
+		qm.Load(models.LocationRels.Owner),
+		qm.OrderBy(models.LocationColumns.Name),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	things, err := models.Things(
+		models.ThingWhere.LocationID.IN(subtreeIds),
+		qm.Load(models.ThingRels.Owner),
+		qm.Load(models.ThingRels.QuantityEntries),
+		qm.OrderBy(models.ThingColumns.Name),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedLocationIds := []string{}
+	sharedThingIds := []string{}
+	if root.OwnerID != userId {
+		sharedLocationIds, err = operations.GetSharedLocationIdsForUser(ctx, tx, userId)
+		if err != nil {
+			return nil, err
+		}
+		sharedThingIds, err = operations.GetSharedThingIdsForUser(ctx, tx, userId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	nodes := map[string]*LocationTree{locationId: {Location: root}}
+	childrenByParent := make(map[string]models.LocationSlice)
+	for _, location := range locations {
+		if location.OwnerID != userId && !utils.Contains(sharedLocationIds, location.ID) {
+			continue
+		}
+		childrenByParent[location.ParentID.String] = append(childrenByParent[location.ParentID.String], location)
+	}
+	var attach func(node *LocationTree)
+	attach = func(node *LocationTree) {
+		node.Children = []*LocationTree{}
+		node.Things = models.ThingSlice{}
+		for _, child := range childrenByParent[node.Location.ID] {
+			child.R.Parent = node.Location
+			childNode := &LocationTree{Location: child}
+			nodes[child.ID] = childNode
+			node.Children = append(node.Children, childNode)
+			attach(childNode)
+		}
+	}
+	attach(nodes[locationId])
+
+	for _, thing := range things {
+		node, ok := nodes[thing.LocationID.String]
+		if !ok {
+			continue
+		}
+		if thing.OwnerID != userId && !utils.Contains(sharedThingIds, thing.ID) {
+			continue
+		}
+		node.Things = append(node.Things, thing)
+	}
+	return nodes[locationId], nil
+}
+
+// DeleteLocation deletes a location, nested locations and things move up to
+// its parent.
+func (ls *LocationService) DeleteLocation(ctx context.Context, locationId string, userId string) error {
+	return utils.Tx(ctx, ls.db, func(tx *sql.Tx) error {
+		location, err := operations.GetLocationUnchecked(ctx, tx, locationId)
+		if err != nil {
+			return err
+		}
+		if location.OwnerID != userId {
+			return utils.EntityDoesNotBelongToUserError{}
+		}
+		_, err = models.Locations(models.LocationWhere.ParentID.EQ(null.StringFrom(locationId))).
+			UpdateAll(ctx, tx, models.M{models.LocationColumns.ParentID: location.ParentID})
+		if err != nil {
+			return err
+		}
+		things, err := models.Things(models.ThingWhere.LocationID.EQ(null.StringFrom(locationId))).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+		_, err = things.UpdateAll(ctx, tx, models.M{models.ThingColumns.LocationID: location.ParentID})
+		if err != nil {
+			return err
+		}
+		for _, thing := range things {
+			err = operations.EnqueueThingWebhookEvent(ctx, tx, operations.WebhookEventThingUpdated, thing)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = location.Delete(ctx, tx)
+		return err
+	})
+}
+
+type MoveThingsParams struct {
+	UserId   string
+	ThingIds []string
+	// nil removes the things from their location
+	LocationId *string
+}
+
+// MoveThings puts things of the user into one of their locations
+func (ls *LocationService) MoveThings(ctx context.Context, params MoveThingsParams) error {
+	return utils.Tx(ctx, ls.db, func(tx *sql.Tx) error {
+		if params.LocationId != nil {
+			location, err := operations.GetLocationUnchecked(ctx, tx, *params.LocationId)
+			if err != nil {
+				return err
+			}
+			if location.OwnerID != params.UserId {
+				return utils.EntityDoesNotBelongToUserError{}
+			}
+		}
+		things, err := models.Things(models.ThingWhere.ID.IN(params.ThingIds)).All(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if len(things) != len(params.ThingIds) {
+			return utils.NotFoundError{EntityName: "Thing"}
+		}
+		for _, thing := range things {
+			if thing.OwnerID != params.UserId {
+				return utils.EntityDoesNotBelongToUserError{}
+			}
+		}
+		_, err = things.UpdateAll(ctx, tx, models.M{models.ThingColumns.LocationID: null.StringFromPtr(params.LocationId)})
+		if err != nil {
+			return err
+		}
+		for _, thing := range things {
+			err = operations.EnqueueThingWebhookEvent(ctx, tx, operations.WebhookEventThingUpdated, thing)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,163 @@
+package services_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/resources"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocationTree(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	is, err := services.NewTmpImageService(db)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		os.Remove(is.StorePath())
+	})
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	thingService := services.NewThingService(db, is, notificationService)
+	locationService := services.NewLocationService(db)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+
+	house, err := locationService.CreateLocation(context.Background(), services.CreateLocationParams{
+		Name:    "House",
+		OwnerId: alice.ID,
+	})
+	assert.NoError(t, err)
+	room, err := locationService.CreateLocation(context.Background(), services.CreateLocationParams{
+		Name:     "Kitchen",
+		OwnerId:  alice.ID,
+		ParentId: &house.ID,
+	})
+	assert.NoError(t, err)
+	box, err := locationService.CreateLocation(context.Background(), services.CreateLocationParams{
+		Name:     "Box",
+		OwnerId:  alice.ID,
+		ParentId: &room.ID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []resources.ReducedLocation{
+		{ID: house.ID, Name: "House"},
+		{ID: room.ID, Name: "Kitchen"},
+		{ID: box.ID, Name: "Box"},
+	}, resources.LocationPathFromModel(box))
+
+	thingParams := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+	thingParams.OwnerId = alice.ID
+	thing, err := thingService.CreateThing(context.Background(), *thingParams)
+	assert.NoError(t, err)
+
+	err = locationService.MoveThings(context.Background(), services.MoveThingsParams{
+		UserId:     alice.ID,
+		ThingIds:   []string{thing.ID},
+		LocationId: &box.ID,
+	})
+	assert.NoError(t, err)
+	thing, err = thingService.GetThing(context.Background(), thing.ID, alice.ID)
+	assert.NoError(t, err)
+	path := resources.ThingFromModel(thing, alice.ID, []string{}).LocationPath
+	assert.Len(t, path, 3)
+	assert.Equal(t, box.ID, path[2].ID)
+
+	// a location can not be moved below itself
+	_, err = locationService.UpdateLocation(context.Background(), house.ID, alice.ID, services.UpdateLocationParams{
+		Name:     "House",
+		ParentId: &box.ID,
+	})
+	assert.ErrorAs(t, err, &utils.StashSphereValidationError{})
+
+	tree, err := locationService.GetLocationTree(context.Background(), house.ID, alice.ID)
+	assert.NoError(t, err)
+	assert.Len(t, tree.Children, 1)
+	assert.Len(t, tree.Children[0].Children, 1)
+	assert.Len(t, tree.Children[0].Children[0].Things, 1)
+
+	// deleting the room moves the box up into the house
+	err = locationService.DeleteLocation(context.Background(), room.ID, alice.ID)
+	assert.NoError(t, err)
+	tree, err = locationService.GetLocationTree(context.Background(), house.ID, alice.ID)
+	assert.NoError(t, err)
+	assert.Len(t, tree.Children, 1)
+	assert.Equal(t, box.ID, tree.Children[0].Location.ID)
+	assert.Len(t, tree.Children[0].Things, 1)
+}
+
+func TestLocationSharingState(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	locationService := services.NewLocationService(db)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	createFriendShip(t, db, alice.ID, bob.ID)
+
+	house, err := locationService.CreateLocation(context.Background(), services.CreateLocationParams{
+		Name:         "House",
+		OwnerId:      alice.ID,
+		SharingState: "private",
+	})
+	assert.NoError(t, err)
+	garage, err := locationService.CreateLocation(context.Background(), services.CreateLocationParams{
+		Name:         "Garage",
+		OwnerId:      alice.ID,
+		ParentId:     &house.ID,
+		SharingState: "friends",
+	})
+	assert.NoError(t, err)
+	_, err = locationService.CreateLocation(context.Background(), services.CreateLocationParams{
+		Name:         "Safe",
+		OwnerId:      alice.ID,
+		ParentId:     &garage.ID,
+		SharingState: "private",
+	})
+	assert.NoError(t, err)
+
+	_, err = locationService.GetLocation(context.Background(), house.ID, bob.ID)
+	assert.ErrorIs(t, err, utils.UserHasNoAccessRightsError{})
+
+	// the private parent is not part of the path
+	shared, err := locationService.GetLocation(context.Background(), garage.ID, bob.ID)
+	assert.NoError(t, err)
+	assert.Len(t, resources.LocationPathFromModel(shared), 1)
+
+	tree, err := locationService.GetLocationTree(context.Background(), garage.ID, bob.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, tree.Children)
+
+	locations, err := locationService.GetLocationsForUser(context.Background(), bob.ID)
+	assert.NoError(t, err)
+	assert.Len(t, locations, 1)
+}
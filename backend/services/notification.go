@@ -1,39 +1,47 @@
 package services
 
 import (
-	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"html/template"
-	"math"
+	"io"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/rs/zerolog/log"
 	"github.com/stashsphere/backend/models"
 	"github.com/stashsphere/backend/notifications"
-	"github.com/stashsphere/backend/notifications/templates"
 	"github.com/stashsphere/backend/operations"
 	"github.com/stashsphere/backend/utils"
 )
 
+// width of the thing images attached to emails
+const emailThumbnailWidth = 320
+
 type NotificationData struct {
 	FrontendUrl  string
 	InstanceName string
+	// base of the unsubscribe links, emails carry none if empty
+	ApiUrl string
+	// store of the images attached to emails as thumbnails
+	ImagePath string
 }
 
 type NotificationService struct {
-	db           *sql.DB
-	data         NotificationData
-	emailService EmailService
+	db     *sql.DB
+	data   NotificationData
+	outbox *EmailOutboxService
 }
 
 func NewNotificationService(db *sql.DB, data NotificationData, emailService EmailService) *NotificationService {
-	return &NotificationService{db, data, emailService}
+	return &NotificationService{db, data, NewEmailOutboxService(db, emailService)}
 }
 
 type CreateNotification struct {
@@ -42,6 +50,10 @@ type CreateNotification struct {
 }
 
 func (ns *NotificationService) CreateNotification(ctx context.Context, params CreateNotification) (*models.Notification, error) {
+	return createNotification(ctx, ns.db, params)
+}
+
+func createNotification(ctx context.Context, exec boil.ContextExecutor, params CreateNotification) (*models.Notification, error) {
 	notificationId, err := gonanoid.New()
 	if err != nil {
 		return nil, err
@@ -55,26 +67,52 @@ func (ns *NotificationService) CreateNotification(ctx context.Context, params Cr
 	if err != nil {
 		return nil, err
 	}
-	err = notification.Insert(ctx, ns.db, boil.Infer())
+	err = notification.Insert(ctx, exec, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	err = notification.Reload(ctx, exec)
 	if err != nil {
 		return nil, err
 	}
-	err = notification.Reload(ctx, ns.db)
+	err = operations.EnqueueWebhookEvent(ctx, exec, notification.RecipientID, operations.WebhookEventNotificationCreated, operations.WebhookNotificationData{
+		NotificationId: notification.ID,
+		ContentType:    notification.ContentType,
+		Content:        json.RawMessage(notification.Content),
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = operations.PublishNotificationEvent(ctx, exec, operations.NotificationEvent{
+		Event:          operations.NotificationEventCreated,
+		NotificationId: notification.ID,
+		RecipientId:    notification.RecipientID,
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &notification, nil
 }
 
+// sendEmail queues an email which is not accompanied by a notification
+func (ns *NotificationService) sendEmail(ctx context.Context, message EmailMessage) error {
+	return ns.outbox.withEmailQueue(ctx, func(tx *sql.Tx, queue *emailQueue) error {
+		return queue.add(ctx, message)
+	})
+}
+
 type GetNotificationsForUserParams struct {
 	UserId             string
 	PerPage            uint64
 	Page               uint64
 	Paginate           bool
 	OnlyUnacknowledged bool
+	// nil uses offset pagination, an empty cursor requests the first page in
+	// cursor mode
+	Cursor *string
 }
 
-func (ns *NotificationService) GetNotifications(ctx context.Context, params GetNotificationsForUserParams) (uint64, uint64, models.NotificationSlice, error) {
+func (ns *NotificationService) GetNotifications(ctx context.Context, params GetNotificationsForUserParams) (PageInfo, models.NotificationSlice, error) {
 	userId, perPage, page, paginate, onlyUnacknowledged := params.UserId, params.PerPage, params.Page, params.Paginate, params.OnlyUnacknowledged
 
 	searchCond := []qm.QueryMod{
@@ -84,26 +122,47 @@ func (ns *NotificationService) GetNotifications(ctx context.Context, params GetN
 		searchCond = append(searchCond, models.NotificationWhere.AcknowledgedAt.IsNull())
 	}
 
-	notificationCount, err := models.Notifications(searchCond...).Count(ctx, ns.db)
-	if err != nil {
-		return 0, 0, models.NotificationSlice{}, err
-	}
-
 	notificationQuery := []qm.QueryMod{
-		qm.OrderBy(`created_at desc`),
-	}
-	if paginate {
-		notificationQuery = append(notificationQuery, qm.Offset(int(perPage*page)), qm.Limit(int(perPage)))
+		operations.NotificationSortOrder.QueryMod(),
+	}
+	var notificationCount int64
+	var err error
+	if params.Cursor != nil {
+		cursorMods, err := cursorQueryMods(operations.NotificationSortOrder, *params.Cursor, perPage)
+		if err != nil {
+			return PageInfo{}, models.NotificationSlice{}, err
+		}
+		notificationQuery = append(notificationQuery, cursorMods...)
+	} else {
+		notificationCount, err = models.Notifications(searchCond...).Count(ctx, ns.db)
+		if err != nil {
+			return PageInfo{}, models.NotificationSlice{}, err
+		}
+		if paginate {
+			notificationQuery = append(notificationQuery, qm.Offset(int(perPage*page)), qm.Limit(int(perPage)))
+		}
 	}
 	for _, s := range searchCond {
 		notificationQuery = append(notificationQuery, s)
 	}
 	notifications, err := models.Notifications(notificationQuery...).All(ctx, ns.db)
 	if err != nil {
-		return 0, 0, models.NotificationSlice{}, err
+		return PageInfo{}, models.NotificationSlice{}, err
+	}
+	pageInfo := offsetPageInfo(notificationCount, perPage)
+	if params.Cursor != nil {
+		ids := make([]string, len(notifications))
+		for i, notification := range notifications {
+			ids[i] = notification.ID
+		}
+		var n int
+		n, pageInfo, err = cursorPageInfo(ctx, ns.db, operations.NotificationSortOrder, perPage, ids)
+		if err != nil {
+			return PageInfo{}, models.NotificationSlice{}, err
+		}
+		notifications = notifications[:n]
 	}
-	totalPages := uint64(math.Ceil(float64(notificationCount) / float64(perPage)))
-	return uint64(notificationCount), totalPages, notifications, nil
+	return pageInfo, notifications, nil
 }
 
 type AcknowledgeNotificationParams struct {
@@ -124,7 +183,14 @@ func (ns *NotificationService) AcknowledgeNotification(ctx context.Context, para
 	}
 	notification.AcknowledgedAt = null.NewTime(time.Now(), true)
 	_, err = notification.Update(ctx, ns.db, boil.Whitelist(models.NotificationColumns.AcknowledgedAt))
-	return err
+	if err != nil {
+		return err
+	}
+	return operations.PublishNotificationEvent(ctx, ns.db, operations.NotificationEvent{
+		Event:          operations.NotificationEventAcknowledged,
+		NotificationId: notification.ID,
+		RecipientId:    notification.RecipientID,
+	})
 }
 
 type CreateFriendRequestNotificationParams struct {
@@ -134,11 +200,12 @@ type CreateFriendRequestNotificationParams struct {
 }
 
 type createFriendRequestNotificationParamsFull struct {
-	ReceiverId    string
-	ReceiverName  string
-	ReceiverEmail string
-	RequestId     string
-	SenderId      string
+	ReceiverId     string
+	ReceiverName   string
+	ReceiverEmail  string
+	ReceiverLocale string
+	RequestId      string
+	SenderId       string
 }
 
 func (ns *NotificationService) CreateFriendRequest(ctx context.Context, params CreateFriendRequestNotificationParams) error {
@@ -147,63 +214,53 @@ func (ns *NotificationService) CreateFriendRequest(ctx context.Context, params C
 		return err
 	}
 	return ns.createFriendRequest(ctx, createFriendRequestNotificationParamsFull{
-		ReceiverId:    params.ReceiverId,
-		ReceiverName:  receiver.Name,
-		ReceiverEmail: receiver.Email,
-		RequestId:     params.RequestId,
-		SenderId:      params.SenderId,
+		ReceiverId:     params.ReceiverId,
+		ReceiverName:   receiver.Name,
+		ReceiverEmail:  receiver.Email,
+		ReceiverLocale: receiver.Locale,
+		RequestId:      params.RequestId,
+		SenderId:       params.SenderId,
 	})
 }
 
 func (ns *NotificationService) createFriendRequest(ctx context.Context, params createFriendRequestNotificationParamsFull) error {
-	_, err := ns.CreateNotification(ctx, CreateNotification{
-		RecipientId: params.ReceiverId,
-		Content: notifications.FriendRequest{
+	return ns.outbox.withEmailQueue(ctx, func(tx *sql.Tx, queue *emailQueue) error {
+		sendEmail, err := ns.notify(ctx, tx, params.ReceiverId, notifications.FriendRequest{
 			RequestId: params.RequestId,
 			SenderId:  params.SenderId,
-		},
-	})
-	if err != nil {
-		return err
-	}
-
-	bodyTempl, err := template.ParseFS(templates.FS, "friend_request.body.txt")
-	if err != nil {
-		return err
-	}
+		})
+		if err != nil || !sendEmail {
+			return err
+		}
 
-	subjectTempl, err := template.ParseFS(templates.FS, "friend_request.subject.txt")
-	if err != nil {
-		return err
-	}
+		type BodyData struct {
+			RecipientName string
+			FrontendUrl   string
+		}
 
-	type BodyData struct {
-		RecipientName string
-		FrontendUrl   string
-	}
+		type SubjectData struct {
+			InstanceName string
+		}
 
-	type SubjectData struct {
-		InstanceName string
-	}
+		bodyData := BodyData{
+			RecipientName: params.ReceiverName,
+			FrontendUrl:   ns.data.FrontendUrl,
+		}
 
-	var body bytes.Buffer
-	err = bodyTempl.Execute(&body, BodyData{
-		RecipientName: params.ReceiverName,
-		FrontendUrl:   ns.data.FrontendUrl,
-	})
-	if err != nil {
-		return err
-	}
+		subjectData := SubjectData{
+			InstanceName: ns.data.InstanceName,
+		}
 
-	var subject bytes.Buffer
-	err = subjectTempl.Execute(&subject, SubjectData{
-		InstanceName: ns.data.InstanceName,
+		message, err := ns.renderEmail(params.ReceiverEmail, params.ReceiverLocale, "friend_request", subjectData, bodyData)
+		if err != nil {
+			return err
+		}
+		message.UnsubscribeUrl, err = ns.unsubscribeUrl(ctx, tx, params.ReceiverId, notifications.NotifyFriendRequestSent)
+		if err != nil {
+			return err
+		}
+		return queue.add(ctx, message)
 	})
-	if err != nil {
-		return err
-	}
-
-	return ns.emailService.Deliver(params.ReceiverEmail, subject.String(), body.String())
 }
 
 type CreateFriendRequestReactionParams struct {
@@ -218,6 +275,7 @@ type createFriendRequestReactionParamsFull struct {
 	ReceiverId   string
 	SenderId     string
 	SenderEmail  string
+	SenderLocale string
 	ReceiverName string
 	Accepted     bool
 	SenderName   string
@@ -237,6 +295,7 @@ func (ns *NotificationService) CreateFriendRequestReaction(ctx context.Context,
 		ReceiverId:   params.ReceiverId,
 		SenderId:     params.SenderId,
 		SenderEmail:  sender.Email,
+		SenderLocale: sender.Locale,
 		ReceiverName: receiver.Name,
 		Accepted:     params.Accepted,
 		SenderName:   sender.Name,
@@ -244,68 +303,55 @@ func (ns *NotificationService) CreateFriendRequestReaction(ctx context.Context,
 }
 
 func (ns *NotificationService) createFriendRequestReaction(ctx context.Context, params createFriendRequestReactionParamsFull) error {
-	_, err := ns.CreateNotification(ctx, CreateNotification{
-		RecipientId: params.ReceiverId,
-		Content: notifications.FriendRequestReaction{
+	// the receiver reacted to the request, only the sender gets an email
+	return ns.outbox.withEmailQueue(ctx, func(tx *sql.Tx, queue *emailQueue) error {
+		_, err := ns.notify(ctx, tx, params.ReceiverId, notifications.FriendRequestReaction{
 			RequestId: params.RequestId,
 			Accepted:  params.Accepted,
-		},
-	})
-	if err != nil {
-		return err
-	}
+		})
+		if err != nil {
+			return err
+		}
 
-	_, err = ns.CreateNotification(ctx, CreateNotification{
-		RecipientId: params.SenderId,
-		Content: notifications.FriendRequestReaction{
+		sendEmail, err := ns.notify(ctx, tx, params.SenderId, notifications.FriendRequestReaction{
 			RequestId: params.RequestId,
 			Accepted:  params.Accepted,
-		},
-	})
-	if err != nil {
-		return err
-	}
-
-	bodyTempl, err := template.ParseFS(templates.FS, "friend_request_reaction.body.txt")
-	if err != nil {
-		return err
-	}
-
-	subjectTempl, err := template.ParseFS(templates.FS, "friend_request_reaction.subject.txt")
-	if err != nil {
-		return err
-	}
+		})
+		if err != nil || !sendEmail {
+			return err
+		}
 
-	type BodyData struct {
-		Accepted      bool
-		RecipientName string
-		SenderName    string
-	}
+		type BodyData struct {
+			Accepted      bool
+			RecipientName string
+			SenderName    string
+		}
 
-	type SubjectData struct {
-		Accepted     bool
-		InstanceName string
-	}
+		type SubjectData struct {
+			Accepted     bool
+			InstanceName string
+		}
 
-	var body bytes.Buffer
-	err = bodyTempl.Execute(&body, BodyData{
-		RecipientName: params.ReceiverName,
-		SenderName:    params.SenderName,
-		Accepted:      params.Accepted,
-	})
-	if err != nil {
-		return err
-	}
+		bodyData := BodyData{
+			RecipientName: params.ReceiverName,
+			SenderName:    params.SenderName,
+			Accepted:      params.Accepted,
+		}
 
-	var subject bytes.Buffer
-	err = subjectTempl.Execute(&subject, SubjectData{
-		InstanceName: ns.data.InstanceName,
-		Accepted:     params.Accepted,
+		subjectData := SubjectData{
+			InstanceName: ns.data.InstanceName,
+			Accepted:     params.Accepted,
+		}
+		message, err := ns.renderEmail(params.SenderEmail, params.SenderLocale, "friend_request_reaction", subjectData, bodyData)
+		if err != nil {
+			return err
+		}
+		message.UnsubscribeUrl, err = ns.unsubscribeUrl(ctx, tx, params.SenderId, notifications.NotifyFriendRequestReaction)
+		if err != nil {
+			return err
+		}
+		return queue.add(ctx, message)
 	})
-	if err != nil {
-		return err
-	}
-	return ns.emailService.Deliver(params.SenderEmail, subject.String(), body.String())
 }
 
 type ThingSharedParams struct {
@@ -315,12 +361,13 @@ type ThingSharedParams struct {
 }
 
 type thingSharedParamsFull struct {
-	ThingId         string
-	SharerName      string
-	SharerId        string
-	TargetUserId    string
-	TargetUserName  string
-	TargetUserEmail string
+	ThingId          string
+	SharerName       string
+	SharerId         string
+	TargetUserId     string
+	TargetUserName   string
+	TargetUserEmail  string
+	TargetUserLocale string
 }
 
 func (ns *NotificationService) ThingShared(ctx context.Context, params ThingSharedParams) error {
@@ -333,65 +380,101 @@ func (ns *NotificationService) ThingShared(ctx context.Context, params ThingShar
 		return err
 	}
 	return ns.thingShared(ctx, thingSharedParamsFull{
-		ThingId:         params.ThingId,
-		SharerName:      sharer.Name,
-		SharerId:        params.SharerId,
-		TargetUserId:    params.TargetUserId,
-		TargetUserName:  targetUser.Name,
-		TargetUserEmail: targetUser.Email,
+		ThingId:          params.ThingId,
+		SharerName:       sharer.Name,
+		SharerId:         params.SharerId,
+		TargetUserId:     params.TargetUserId,
+		TargetUserName:   targetUser.Name,
+		TargetUserEmail:  targetUser.Email,
+		TargetUserLocale: targetUser.Locale,
 	})
 }
 
-func (ns *NotificationService) thingShared(ctx context.Context, params thingSharedParamsFull) error {
-	_, err := ns.CreateNotification(ctx, CreateNotification{
-		RecipientId: params.TargetUserId,
-		Content: notifications.ThingShared{
-			ThingId:  params.ThingId,
-			SharerId: params.SharerId,
-		},
-	})
+// thingThumbnail returns a small version of the first image of the thing to
+// show in emails. Emails are sent without it if there is none or it can not be
+// read.
+func (ns *NotificationService) thingThumbnail(ctx context.Context, exec boil.ContextExecutor, thingId string) *InlineImage {
+	if ns.data.ImagePath == "" {
+		return nil
+	}
+	imageThing, err := models.ImagesThings(
+		models.ImagesThingWhere.ThingID.EQ(thingId),
+		qm.Load(models.ImagesThingRels.Image),
+		qm.OrderBy(models.ImagesThingColumns.Pos+" ASC"),
+	).One(ctx, exec)
 	if err != nil {
-		return err
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.Warn().Err(err).Str("thingId", thingId).Msg("Could not load thumbnail for email")
+		}
+		return nil
 	}
-
-	bodyTempl, err := template.ParseFS(templates.FS, "thing_shared.body.txt")
+	image := imageThing.R.Image
+	if image.Mime != "image/jpeg" && image.Mime != "image/png" {
+		return nil
+	}
+	file, err := os.Open(filepath.Join(ns.data.ImagePath, image.Hash))
 	if err != nil {
-		return err
+		log.Warn().Err(err).Str("imageId", image.ID).Msg("Could not open thumbnail for email")
+		return nil
 	}
-
-	subjectTempl, err := template.ParseFS(templates.FS, "thing_shared.subject.txt")
+	defer file.Close()
+	resized, err := operations.ResizeImage(file, emailThumbnailWidth)
 	if err != nil {
-		return err
+		log.Warn().Err(err).Str("imageId", image.ID).Msg("Could not resize thumbnail for email")
+		return nil
 	}
-
-	type BodyData struct {
-		TargetUserName string
-		SharerName     string
-		FrontendUrl    string
+	content, err := io.ReadAll(resized)
+	if err != nil {
+		log.Warn().Err(err).Str("imageId", image.ID).Msg("Could not resize thumbnail for email")
+		return nil
 	}
+	return &InlineImage{Mime: image.Mime, Content: content}
+}
 
-	type SubjectData struct {
-		InstanceName string
-	}
+func (ns *NotificationService) thingShared(ctx context.Context, params thingSharedParamsFull) error {
+	return ns.outbox.withEmailQueue(ctx, func(tx *sql.Tx, queue *emailQueue) error {
+		sendEmail, err := ns.notify(ctx, tx, params.TargetUserId, notifications.ThingShared{
+			ThingId:  params.ThingId,
+			SharerId: params.SharerId,
+		})
+		if err != nil || !sendEmail {
+			return err
+		}
 
-	var body bytes.Buffer
-	err = bodyTempl.Execute(&body, BodyData{
-		TargetUserName: params.TargetUserName,
-		SharerName:     params.SharerName,
-		FrontendUrl:    ns.data.FrontendUrl,
-	})
-	if err != nil {
-		return err
-	}
+		type BodyData struct {
+			TargetUserName string
+			SharerName     string
+			FrontendUrl    string
+			Thumbnail      bool
+		}
 
-	var subject bytes.Buffer
-	err = subjectTempl.Execute(&subject, SubjectData{
-		InstanceName: ns.data.InstanceName,
+		type SubjectData struct {
+			InstanceName string
+		}
+
+		thumbnail := ns.thingThumbnail(ctx, tx, params.ThingId)
+
+		bodyData := BodyData{
+			TargetUserName: params.TargetUserName,
+			SharerName:     params.SharerName,
+			FrontendUrl:    ns.data.FrontendUrl,
+			Thumbnail:      thumbnail != nil,
+		}
+
+		subjectData := SubjectData{
+			InstanceName: ns.data.InstanceName,
+		}
+		message, err := ns.renderEmail(params.TargetUserEmail, params.TargetUserLocale, "thing_shared", subjectData, bodyData)
+		if err != nil {
+			return err
+		}
+		message.UnsubscribeUrl, err = ns.unsubscribeUrl(ctx, tx, params.TargetUserId, notifications.NotifyThingShared)
+		if err != nil {
+			return err
+		}
+		message.InlineImage = thumbnail
+		return queue.add(ctx, message)
 	})
-	if err != nil {
-		return err
-	}
-	return ns.emailService.Deliver(params.TargetUserEmail, subject.String(), body.String())
 }
 
 type ListSharedParams struct {
@@ -401,12 +484,13 @@ type ListSharedParams struct {
 }
 
 type listSharedParamsFull struct {
-	ListId          string
-	SharerName      string
-	SharedId        string
-	TargetUserId    string
-	TargetUserName  string
-	TargetUserEmail string
+	ListId           string
+	SharerName       string
+	SharedId         string
+	TargetUserId     string
+	TargetUserName   string
+	TargetUserEmail  string
+	TargetUserLocale string
 }
 
 func (ns *NotificationService) ListShared(ctx context.Context, params ListSharedParams) error {
@@ -419,65 +503,55 @@ func (ns *NotificationService) ListShared(ctx context.Context, params ListShared
 		return err
 	}
 	return ns.listShared(ctx, listSharedParamsFull{
-		ListId:          params.ListId,
-		SharerName:      sharer.Name,
-		SharedId:        sharer.ID,
-		TargetUserName:  targetUser.Name,
-		TargetUserId:    params.TargetUserId,
-		TargetUserEmail: targetUser.Email,
+		ListId:           params.ListId,
+		SharerName:       sharer.Name,
+		SharedId:         sharer.ID,
+		TargetUserName:   targetUser.Name,
+		TargetUserId:     params.TargetUserId,
+		TargetUserEmail:  targetUser.Email,
+		TargetUserLocale: targetUser.Locale,
 	})
 }
 
 func (ns *NotificationService) listShared(ctx context.Context, params listSharedParamsFull) error {
-	_, err := ns.CreateNotification(ctx, CreateNotification{
-		RecipientId: params.TargetUserId,
-		Content: notifications.ListShared{
+	return ns.outbox.withEmailQueue(ctx, func(tx *sql.Tx, queue *emailQueue) error {
+		sendEmail, err := ns.notify(ctx, tx, params.TargetUserId, notifications.ListShared{
 			ListId:   params.ListId,
 			SharerId: params.SharedId,
-		},
-	})
-	if err != nil {
-		return err
-	}
-
-	bodyTempl, err := template.ParseFS(templates.FS, "list_shared.body.txt")
-	if err != nil {
-		return err
-	}
-
-	subjectTempl, err := template.ParseFS(templates.FS, "list_shared.subject.txt")
-	if err != nil {
-		return err
-	}
+		})
+		if err != nil || !sendEmail {
+			return err
+		}
 
-	type BodyData struct {
-		TargetUserName string
-		SharerName     string
-		FrontendUrl    string
-	}
+		type BodyData struct {
+			TargetUserName string
+			SharerName     string
+			FrontendUrl    string
+		}
 
-	type SubjectData struct {
-		InstanceName string
-	}
+		type SubjectData struct {
+			InstanceName string
+		}
 
-	var body bytes.Buffer
-	err = bodyTempl.Execute(&body, BodyData{
-		TargetUserName: params.TargetUserName,
-		SharerName:     params.SharerName,
-		FrontendUrl:    ns.data.FrontendUrl,
-	})
-	if err != nil {
-		return err
-	}
+		bodyData := BodyData{
+			TargetUserName: params.TargetUserName,
+			SharerName:     params.SharerName,
+			FrontendUrl:    ns.data.FrontendUrl,
+		}
 
-	var subject bytes.Buffer
-	err = subjectTempl.Execute(&subject, SubjectData{
-		InstanceName: ns.data.InstanceName,
+		subjectData := SubjectData{
+			InstanceName: ns.data.InstanceName,
+		}
+		message, err := ns.renderEmail(params.TargetUserEmail, params.TargetUserLocale, "list_shared", subjectData, bodyData)
+		if err != nil {
+			return err
+		}
+		message.UnsubscribeUrl, err = ns.unsubscribeUrl(ctx, tx, params.TargetUserId, notifications.NotifyListShared)
+		if err != nil {
+			return err
+		}
+		return queue.add(ctx, message)
 	})
-	if err != nil {
-		return err
-	}
-	return ns.emailService.Deliver(params.TargetUserEmail, subject.String(), body.String())
 }
 
 type ThingsAddedToListParams struct {
@@ -496,100 +570,189 @@ func (ns *NotificationService) ThingsAddedToList(ctx context.Context, params Thi
 		return err
 	}
 	return ns.thingsAddedToList(ctx, thingsAddedToListParamsFull{
-		OwnerId:         params.OwnerId,
-		ListId:          params.ListId,
-		OwnerName:       owner.Name,
-		TargetUserId:    params.TargetUserId,
-		TargetUserName:  targetUser.Name,
-		TargetUserEmail: targetUser.Email,
+		OwnerId:          params.OwnerId,
+		ListId:           params.ListId,
+		OwnerName:        owner.Name,
+		TargetUserId:     params.TargetUserId,
+		TargetUserName:   targetUser.Name,
+		TargetUserEmail:  targetUser.Email,
+		TargetUserLocale: targetUser.Locale,
 	})
 }
 
 type thingsAddedToListParamsFull struct {
-	ListId          string
-	OwnerId         string
-	OwnerName       string
-	TargetUserId    string
-	TargetUserName  string
-	TargetUserEmail string
+	ListId           string
+	OwnerId          string
+	OwnerName        string
+	TargetUserId     string
+	TargetUserName   string
+	TargetUserEmail  string
+	TargetUserLocale string
 }
 
 func (ns *NotificationService) thingsAddedToList(ctx context.Context, params thingsAddedToListParamsFull) error {
-	_, err := ns.CreateNotification(ctx, CreateNotification{
-		RecipientId: params.TargetUserId,
-		Content: notifications.ThingsAddedToList{
+	return ns.outbox.withEmailQueue(ctx, func(tx *sql.Tx, queue *emailQueue) error {
+		sendEmail, err := ns.notify(ctx, tx, params.TargetUserId, notifications.ThingsAddedToList{
 			ListId:    params.ListId,
 			AddedById: params.OwnerId,
-		},
+		})
+		if err != nil || !sendEmail {
+			return err
+		}
+
+		type BodyData struct {
+			TargetUserName string
+			OwnerName      string
+			FrontendUrl    string
+		}
+
+		type SubjectData struct {
+			InstanceName string
+			OwnerName    string
+		}
+
+		bodyData := BodyData{
+			TargetUserName: params.TargetUserName,
+			FrontendUrl:    ns.data.FrontendUrl,
+			OwnerName:      params.OwnerName,
+		}
+
+		subjectData := SubjectData{
+			InstanceName: ns.data.InstanceName,
+			OwnerName:    params.OwnerName,
+		}
+
+		message, err := ns.renderEmail(params.TargetUserEmail, params.TargetUserLocale, "things_added_to_list", subjectData, bodyData)
+		if err != nil {
+			return err
+		}
+		message.UnsubscribeUrl, err = ns.unsubscribeUrl(ctx, tx, params.TargetUserId, notifications.NotifyThingsAddedToList)
+		if err != nil {
+			return err
+		}
+		return queue.add(ctx, message)
 	})
-	if err != nil {
-		return err
+}
+
+type AccountDeletionScheduledParams struct {
+	UserId     string
+	UserName   string
+	UserEmail  string
+	UserLocale string
+	PurgeAt    time.Time
+}
+
+func (ns *NotificationService) AccountDeletionScheduled(ctx context.Context, params AccountDeletionScheduledParams) error {
+	type BodyData struct {
+		UserName    string
+		PurgeAt     string
+		FrontendUrl string
 	}
 
-	bodyTempl, err := template.ParseFS(templates.FS, "things_added_to_list.body.txt")
-	if err != nil {
-		return err
+	type SubjectData struct {
+		InstanceName string
+	}
+
+	bodyData := BodyData{
+		UserName:    params.UserName,
+		PurgeAt:     formatDateTime(params.UserLocale, params.PurgeAt),
+		FrontendUrl: ns.data.FrontendUrl,
 	}
 
-	subjectTempl, err := template.ParseFS(templates.FS, "things_added_to_list.subject.txt")
+	subjectData := SubjectData{
+		InstanceName: ns.data.InstanceName,
+	}
+
+	message, err := ns.renderEmail(params.UserEmail, params.UserLocale, "account_deletion_scheduled", subjectData, bodyData)
 	if err != nil {
 		return err
 	}
+	return ns.sendEmail(ctx, message)
+}
+
+type EmailVerificationParams struct {
+	UserName   string
+	UserEmail  string
+	UserLocale string
+	DigitCode  string
+}
 
+func (ns *NotificationService) EmailVerification(ctx context.Context, params EmailVerificationParams) error {
 	type BodyData struct {
-		TargetUserName string
-		OwnerName      string
-		FrontendUrl    string
+		UserName        string
+		DigitCode       string
+		VerificationUrl string
 	}
 
 	type SubjectData struct {
 		InstanceName string
-		OwnerName    string
 	}
 
-	var body bytes.Buffer
-	err = bodyTempl.Execute(&body, BodyData{
-		TargetUserName: params.TargetUserName,
-		FrontendUrl:    ns.data.FrontendUrl,
-		OwnerName:      params.OwnerName,
-	})
-	if err != nil {
-		return err
+	bodyData := BodyData{
+		UserName:        params.UserName,
+		DigitCode:       params.DigitCode,
+		VerificationUrl: fmt.Sprintf("%s/user/verify-email#%s", ns.data.FrontendUrl, params.DigitCode),
 	}
 
-	var subject bytes.Buffer
-	err = subjectTempl.Execute(&subject, SubjectData{
+	subjectData := SubjectData{
 		InstanceName: ns.data.InstanceName,
-		OwnerName:    params.OwnerName,
-	})
+	}
+
+	message, err := ns.renderEmail(params.UserEmail, params.UserLocale, "email_verification", subjectData, bodyData)
 	if err != nil {
 		return err
 	}
-
-	return ns.emailService.Deliver(params.TargetUserEmail, subject.String(), body.String())
+	return ns.sendEmail(ctx, message)
 }
 
-type AccountDeletionScheduledParams struct {
-	UserId    string
-	UserName  string
-	UserEmail string
-	PurgeAt   time.Time
+type EmailChangeVerificationParams struct {
+	UserName   string
+	NewEmail   string
+	UserLocale string
+	DigitCode  string
 }
 
-func (ns *NotificationService) AccountDeletionScheduled(ctx context.Context, params AccountDeletionScheduledParams) error {
-	bodyTempl, err := template.ParseFS(templates.FS, "account_deletion_scheduled.body.txt")
-	if err != nil {
-		return err
+func (ns *NotificationService) EmailChangeVerification(ctx context.Context, params EmailChangeVerificationParams) error {
+	type BodyData struct {
+		UserName        string
+		DigitCode       string
+		VerificationUrl string
+	}
+
+	type SubjectData struct {
+		InstanceName string
+	}
+
+	bodyData := BodyData{
+		UserName:        params.UserName,
+		DigitCode:       params.DigitCode,
+		VerificationUrl: fmt.Sprintf("%s/user/change-email#%s", ns.data.FrontendUrl, params.DigitCode),
+	}
+
+	subjectData := SubjectData{
+		InstanceName: ns.data.InstanceName,
 	}
 
-	subjectTempl, err := template.ParseFS(templates.FS, "account_deletion_scheduled.subject.txt")
+	message, err := ns.renderEmail(params.NewEmail, params.UserLocale, "email_change", subjectData, bodyData)
 	if err != nil {
 		return err
 	}
+	return ns.sendEmail(ctx, message)
+}
+
+type EmailChangedParams struct {
+	UserName   string
+	OldEmail   string
+	NewEmail   string
+	UserLocale string
+}
 
+// EmailChanged informs the previous address, so a takeover of the account
+// does not go unnoticed
+func (ns *NotificationService) EmailChanged(ctx context.Context, params EmailChangedParams) error {
 	type BodyData struct {
 		UserName    string
-		PurgeAt     string
+		NewEmail    string
 		FrontendUrl string
 	}
 
@@ -597,71 +760,414 @@ func (ns *NotificationService) AccountDeletionScheduled(ctx context.Context, par
 		InstanceName string
 	}
 
-	var body bytes.Buffer
-	err = bodyTempl.Execute(&body, BodyData{
+	bodyData := BodyData{
 		UserName:    params.UserName,
-		PurgeAt:     params.PurgeAt.Format("January 2, 2006 at 15:04 MST"),
+		NewEmail:    params.NewEmail,
 		FrontendUrl: ns.data.FrontendUrl,
-	})
+	}
+
+	subjectData := SubjectData{
+		InstanceName: ns.data.InstanceName,
+	}
+
+	message, err := ns.renderEmail(params.OldEmail, params.UserLocale, "email_changed", subjectData, bodyData)
 	if err != nil {
 		return err
 	}
+	return ns.sendEmail(ctx, message)
+}
+
+type PasswordResetParams struct {
+	UserName   string
+	UserEmail  string
+	UserLocale string
+	Token      string
+}
+
+func (ns *NotificationService) PasswordReset(ctx context.Context, params PasswordResetParams) error {
+	type BodyData struct {
+		UserName string
+		ResetUrl string
+	}
+
+	type SubjectData struct {
+		InstanceName string
+	}
 
-	var subject bytes.Buffer
-	err = subjectTempl.Execute(&subject, SubjectData{
+	bodyData := BodyData{
+		UserName: params.UserName,
+		ResetUrl: fmt.Sprintf("%s/user/reset-password#%s", ns.data.FrontendUrl, params.Token),
+	}
+
+	subjectData := SubjectData{
 		InstanceName: ns.data.InstanceName,
-	})
+	}
+
+	message, err := ns.renderEmail(params.UserEmail, params.UserLocale, "password_reset", subjectData, bodyData)
 	if err != nil {
 		return err
 	}
+	return ns.sendEmail(ctx, message)
+}
 
-	return ns.emailService.Deliver(params.UserEmail, subject.String(), body.String())
+type BorrowRequestedParams struct {
+	OwnerId    string
+	BorrowerId string
+	RequestIds []string
 }
 
-type EmailVerificationParams struct {
-	UserName  string
-	UserEmail string
-	DigitCode string
+type borrowRequestedParamsFull struct {
+	OwnerId      string
+	OwnerName    string
+	OwnerEmail   string
+	OwnerLocale  string
+	BorrowerId   string
+	BorrowerName string
+	RequestIds   []string
 }
 
-func (ns *NotificationService) EmailVerification(ctx context.Context, params EmailVerificationParams) error {
-	bodyTempl, err := template.ParseFS(templates.FS, "email_verification.body.txt")
+func (ns *NotificationService) BorrowRequested(ctx context.Context, params BorrowRequestedParams) error {
+	owner, err := operations.FindUserByID(ctx, ns.db, params.OwnerId)
 	if err != nil {
 		return err
 	}
-
-	subjectTempl, err := template.ParseFS(templates.FS, "email_verification.subject.txt")
+	borrower, err := operations.FindUserByID(ctx, ns.db, params.BorrowerId)
 	if err != nil {
 		return err
 	}
+	return ns.borrowRequested(ctx, borrowRequestedParamsFull{
+		OwnerId:      params.OwnerId,
+		OwnerName:    owner.Name,
+		OwnerEmail:   owner.Email,
+		OwnerLocale:  owner.Locale,
+		BorrowerId:   params.BorrowerId,
+		BorrowerName: borrower.Name,
+		RequestIds:   params.RequestIds,
+	})
+}
 
-	type BodyData struct {
-		UserName        string
-		DigitCode       string
-		VerificationUrl string
+func (ns *NotificationService) borrowRequested(ctx context.Context, params borrowRequestedParamsFull) error {
+	return ns.outbox.withEmailQueue(ctx, func(tx *sql.Tx, queue *emailQueue) error {
+		sendEmail, err := ns.notify(ctx, tx, params.OwnerId, notifications.BorrowRequested{
+			RequestIds: params.RequestIds,
+			BorrowerId: params.BorrowerId,
+		})
+		if err != nil || !sendEmail {
+			return err
+		}
+
+		type BodyData struct {
+			OwnerName    string
+			BorrowerName string
+			ThingCount   int
+			FrontendUrl  string
+		}
+
+		type SubjectData struct {
+			InstanceName string
+			BorrowerName string
+		}
+
+		bodyData := BodyData{
+			OwnerName:    params.OwnerName,
+			BorrowerName: params.BorrowerName,
+			ThingCount:   len(params.RequestIds),
+			FrontendUrl:  ns.data.FrontendUrl,
+		}
+
+		subjectData := SubjectData{
+			InstanceName: ns.data.InstanceName,
+			BorrowerName: params.BorrowerName,
+		}
+
+		message, err := ns.renderEmail(params.OwnerEmail, params.OwnerLocale, "borrow_requested", subjectData, bodyData)
+		if err != nil {
+			return err
+		}
+		message.UnsubscribeUrl, err = ns.unsubscribeUrl(ctx, tx, params.OwnerId, notifications.NotifyBorrowRequested)
+		if err != nil {
+			return err
+		}
+		return queue.add(ctx, message)
+	})
+}
+
+type BorrowRequestReactionParams struct {
+	RequestId  string
+	ThingId    string
+	ThingName  string
+	OwnerId    string
+	BorrowerId string
+	Accepted   bool
+	LoanId     *string
+	DueAt      *time.Time
+}
+
+func (ns *NotificationService) BorrowRequestReaction(ctx context.Context, params BorrowRequestReactionParams) error {
+	owner, err := operations.FindUserByID(ctx, ns.db, params.OwnerId)
+	if err != nil {
+		return err
+	}
+	borrower, err := operations.FindUserByID(ctx, ns.db, params.BorrowerId)
+	if err != nil {
+		return err
 	}
 
-	type SubjectData struct {
-		InstanceName string
+	return ns.outbox.withEmailQueue(ctx, func(tx *sql.Tx, queue *emailQueue) error {
+		sendEmail, err := ns.notify(ctx, tx, params.BorrowerId, notifications.BorrowRequestReaction{
+			RequestId: params.RequestId,
+			ThingId:   params.ThingId,
+			Accepted:  params.Accepted,
+			LoanId:    params.LoanId,
+		})
+		if err != nil || !sendEmail {
+			return err
+		}
+
+		type BodyData struct {
+			Accepted     bool
+			BorrowerName string
+			OwnerName    string
+			ThingName    string
+			DueAt        string
+		}
+
+		type SubjectData struct {
+			Accepted     bool
+			InstanceName string
+		}
+
+		dueAt := ""
+		if params.DueAt != nil {
+			dueAt = formatDate(borrower.Locale, *params.DueAt)
+		}
+
+		bodyData := BodyData{
+			Accepted:     params.Accepted,
+			BorrowerName: borrower.Name,
+			OwnerName:    owner.Name,
+			ThingName:    params.ThingName,
+			DueAt:        dueAt,
+		}
+
+		subjectData := SubjectData{
+			InstanceName: ns.data.InstanceName,
+			Accepted:     params.Accepted,
+		}
+		message, err := ns.renderEmail(borrower.Email, borrower.Locale, "borrow_request_reaction", subjectData, bodyData)
+		if err != nil {
+			return err
+		}
+		message.UnsubscribeUrl, err = ns.unsubscribeUrl(ctx, tx, params.BorrowerId, notifications.NotifyBorrowRequestReaction)
+		if err != nil {
+			return err
+		}
+		return queue.add(ctx, message)
+	})
+}
+
+type LoanReturnedParams struct {
+	LoanId     string
+	ThingId    string
+	ThingName  string
+	OwnerId    string
+	BorrowerId string
+}
+
+func (ns *NotificationService) LoanReturned(ctx context.Context, params LoanReturnedParams) error {
+	owner, err := operations.FindUserByID(ctx, ns.db, params.OwnerId)
+	if err != nil {
+		return err
+	}
+	borrower, err := operations.FindUserByID(ctx, ns.db, params.BorrowerId)
+	if err != nil {
+		return err
 	}
 
-	var body bytes.Buffer
-	err = bodyTempl.Execute(&body, BodyData{
-		UserName:        params.UserName,
-		DigitCode:       params.DigitCode,
-		VerificationUrl: fmt.Sprintf("%s/user/verify-email#%s", ns.data.FrontendUrl, params.DigitCode),
+	return ns.outbox.withEmailQueue(ctx, func(tx *sql.Tx, queue *emailQueue) error {
+		sendEmail, err := ns.notify(ctx, tx, params.BorrowerId, notifications.LoanReturned{
+			LoanId:  params.LoanId,
+			ThingId: params.ThingId,
+		})
+		if err != nil || !sendEmail {
+			return err
+		}
+
+		type BodyData struct {
+			BorrowerName string
+			OwnerName    string
+			ThingName    string
+		}
+
+		type SubjectData struct {
+			InstanceName string
+			ThingName    string
+		}
+
+		bodyData := BodyData{
+			BorrowerName: borrower.Name,
+			OwnerName:    owner.Name,
+			ThingName:    params.ThingName,
+		}
+
+		subjectData := SubjectData{
+			InstanceName: ns.data.InstanceName,
+			ThingName:    params.ThingName,
+		}
+		message, err := ns.renderEmail(borrower.Email, borrower.Locale, "loan_returned", subjectData, bodyData)
+		if err != nil {
+			return err
+		}
+		message.UnsubscribeUrl, err = ns.unsubscribeUrl(ctx, tx, params.BorrowerId, notifications.NotifyLoanReturned)
+		if err != nil {
+			return err
+		}
+		return queue.add(ctx, message)
 	})
+}
+
+type LoanOverdueParams struct {
+	LoanId     string
+	ThingId    string
+	ThingName  string
+	OwnerId    string
+	BorrowerId string
+	DueAt      time.Time
+}
+
+// LoanOverdue notifies both the borrower and the owner of a loan that
+// has passed its due date.
+func (ns *NotificationService) LoanOverdue(ctx context.Context, params LoanOverdueParams) error {
+	owner, err := operations.FindUserByID(ctx, ns.db, params.OwnerId)
+	if err != nil {
+		return err
+	}
+	borrower, err := operations.FindUserByID(ctx, ns.db, params.BorrowerId)
 	if err != nil {
 		return err
 	}
 
-	var subject bytes.Buffer
-	err = subjectTempl.Execute(&subject, SubjectData{
-		InstanceName: ns.data.InstanceName,
+	type BodyData struct {
+		RecipientName string
+		BorrowerName  string
+		OwnerName     string
+		ThingName     string
+		DueAt         string
+		FrontendUrl   string
+	}
+
+	type SubjectData struct {
+		InstanceName string
+		ThingName    string
+	}
+
+	return ns.outbox.withEmailQueue(ctx, func(tx *sql.Tx, queue *emailQueue) error {
+		for _, recipient := range []*models.User{borrower, owner} {
+			sendEmail, err := ns.notify(ctx, tx, recipient.ID, notifications.LoanOverdue{
+				LoanId:  params.LoanId,
+				ThingId: params.ThingId,
+				DueAt:   params.DueAt,
+			})
+			if err != nil {
+				return err
+			}
+			if !sendEmail {
+				continue
+			}
+
+			subjectData := SubjectData{
+				InstanceName: ns.data.InstanceName,
+				ThingName:    params.ThingName,
+			}
+
+			bodyData := BodyData{
+				RecipientName: recipient.Name,
+				BorrowerName:  borrower.Name,
+				OwnerName:     owner.Name,
+				ThingName:     params.ThingName,
+				DueAt:         formatDate(recipient.Locale, params.DueAt),
+				FrontendUrl:   ns.data.FrontendUrl,
+			}
+
+			message, err := ns.renderEmail(recipient.Email, recipient.Locale, "loan_overdue", subjectData, bodyData)
+			if err != nil {
+				return err
+			}
+			message.UnsubscribeUrl, err = ns.unsubscribeUrl(ctx, tx, recipient.ID, notifications.NotifyLoanOverdue)
+			if err != nil {
+				return err
+			}
+			err = queue.add(ctx, message)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
 	})
+}
+
+type LowStockParams struct {
+	ThingId      string
+	ThingName    string
+	OwnerId      string
+	Quantity     int64
+	QuantityUnit string
+	Threshold    int64
+}
+
+// LowStock notifies the owner that the quantity of a thing dropped below
+// its low-stock threshold.
+func (ns *NotificationService) LowStock(ctx context.Context, params LowStockParams) error {
+	owner, err := operations.FindUserByID(ctx, ns.db, params.OwnerId)
 	if err != nil {
 		return err
 	}
 
-	return ns.emailService.Deliver(params.UserEmail, subject.String(), body.String())
+	return ns.outbox.withEmailQueue(ctx, func(tx *sql.Tx, queue *emailQueue) error {
+		sendEmail, err := ns.notify(ctx, tx, params.OwnerId, notifications.LowStock{
+			ThingId:   params.ThingId,
+			Quantity:  params.Quantity,
+			Threshold: params.Threshold,
+		})
+		if err != nil || !sendEmail {
+			return err
+		}
+
+		type BodyData struct {
+			OwnerName    string
+			ThingName    string
+			Quantity     int64
+			QuantityUnit string
+			Threshold    int64
+			FrontendUrl  string
+		}
+
+		type SubjectData struct {
+			InstanceName string
+			ThingName    string
+		}
+
+		bodyData := BodyData{
+			OwnerName:    owner.Name,
+			ThingName:    params.ThingName,
+			Quantity:     params.Quantity,
+			QuantityUnit: params.QuantityUnit,
+			Threshold:    params.Threshold,
+			FrontendUrl:  ns.data.FrontendUrl,
+		}
+
+		subjectData := SubjectData{
+			InstanceName: ns.data.InstanceName,
+			ThingName:    params.ThingName,
+		}
+		message, err := ns.renderEmail(owner.Email, owner.Locale, "low_stock", subjectData, bodyData)
+		if err != nil {
+			return err
+		}
+		message.UnsubscribeUrl, err = ns.unsubscribeUrl(ctx, tx, params.OwnerId, notifications.NotifyLowStock)
+		if err != nil {
+			return err
+		}
+		return queue.add(ctx, message)
+	})
 }
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/notifications"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+)
+
+// labels of the content types listed in the digest, by locale
+var digestLabels = map[string]map[string]string{
+	"en": {
+		notifications.NotifyFriendRequestSent:     "New friend requests",
+		notifications.NotifyFriendRequestReaction: "Answered friend requests",
+		notifications.NotifyThingShared:           "Things shared with you",
+		notifications.NotifyListShared:            "Lists shared with you",
+		notifications.NotifyThingsAddedToList:     "Things added to lists shared with you",
+		notifications.NotifyBorrowRequested:       "Borrow requests for your things",
+		notifications.NotifyBorrowRequestReaction: "Answered borrow requests",
+		notifications.NotifyLoanReturned:          "Returned loans",
+		notifications.NotifyLoanOverdue:           "Overdue loans",
+		notifications.NotifyLowStock:              "Things running low",
+	},
+	"de": {
+		notifications.NotifyFriendRequestSent:     "Neue Freundschaftsanfragen",
+		notifications.NotifyFriendRequestReaction: "Beantwortete Freundschaftsanfragen",
+		notifications.NotifyThingShared:           "Mit dir geteilte Dinge",
+		notifications.NotifyListShared:            "Mit dir geteilte Listen",
+		notifications.NotifyThingsAddedToList:     "Neue Dinge in mit dir geteilten Listen",
+		notifications.NotifyBorrowRequested:       "Ausleihanfragen für deine Dinge",
+		notifications.NotifyBorrowRequestReaction: "Beantwortete Ausleihanfragen",
+		notifications.NotifyLoanReturned:          "Zurückgegebene Ausleihen",
+		notifications.NotifyLoanOverdue:           "Überfällige Ausleihen",
+		notifications.NotifyLowStock:              "Dinge, die zur Neige gehen",
+	},
+	"fr": {
+		notifications.NotifyFriendRequestSent:     "Nouvelles demandes d'ami",
+		notifications.NotifyFriendRequestReaction: "Demandes d'ami traitées",
+		notifications.NotifyThingShared:           "Objets partagés avec vous",
+		notifications.NotifyListShared:            "Listes partagées avec vous",
+		notifications.NotifyThingsAddedToList:     "Objets ajoutés aux listes partagées avec vous",
+		notifications.NotifyBorrowRequested:       "Demandes d'emprunt pour vos objets",
+		notifications.NotifyBorrowRequestReaction: "Demandes d'emprunt traitées",
+		notifications.NotifyLoanReturned:          "Prêts rendus",
+		notifications.NotifyLoanOverdue:           "Prêts en retard",
+		notifications.NotifyLowStock:              "Objets en stock bas",
+	},
+}
+
+func digestLabel(locale string, contentType string) string {
+	if label, ok := digestLabels[locale][contentType]; ok {
+		return label
+	}
+	return digestLabels[utils.DefaultLocale][contentType]
+}
+
+// SendDueDigests sends a summary email to every user whose digest is due. It
+// returns the number of digests sent.
+func (ns *NotificationService) SendDueDigests(ctx context.Context) (int, error) {
+	users, err := operations.UsersWithDueDigest(ctx, ns.db)
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	for _, user := range users {
+		err := ns.outbox.withEmailQueue(ctx, func(tx *sql.Tx, queue *emailQueue) error {
+			return ns.sendDigest(ctx, tx, queue, user.ID)
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// digested by another instance in the meantime
+				continue
+			}
+			log.Error().Err(err).Str("userId", user.ID).Msg("Failed to send digest")
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+func (ns *NotificationService) sendDigest(ctx context.Context, tx *sql.Tx, queue *emailQueue, userId string) error {
+	user, err := operations.LockUserForDigest(ctx, tx, userId)
+	if err != nil {
+		return err
+	}
+	digestNotifications, err := operations.DigestNotifications(ctx, tx, userId)
+	if err != nil {
+		return err
+	}
+	if len(digestNotifications) == 0 {
+		return sql.ErrNoRows
+	}
+
+	type Group struct {
+		Label string
+		Count int
+	}
+	groups := []Group{}
+	for _, contentType := range notifications.ContentTypes {
+		count := 0
+		for _, notification := range digestNotifications {
+			if notification.ContentType == contentType {
+				count++
+			}
+		}
+		if count > 0 {
+			groups = append(groups, Group{Label: digestLabel(user.Locale, contentType), Count: count})
+		}
+	}
+
+	type BodyData struct {
+		RecipientName string
+		Frequency     string
+		InstanceName  string
+		Groups        []Group
+		FrontendUrl   string
+	}
+
+	type SubjectData struct {
+		InstanceName string
+		Frequency    string
+		Count        int
+	}
+
+	bodyData := BodyData{
+		RecipientName: user.Name,
+		Frequency:     string(user.DigestFrequency),
+		InstanceName:  ns.data.InstanceName,
+		Groups:        groups,
+		FrontendUrl:   ns.data.FrontendUrl,
+	}
+
+	subjectData := SubjectData{
+		InstanceName: ns.data.InstanceName,
+		Frequency:    string(user.DigestFrequency),
+		Count:        len(digestNotifications),
+	}
+
+	err = operations.MarkDigested(ctx, tx, userId, digestNotifications)
+	if err != nil {
+		return err
+	}
+	message, err := ns.renderEmail(user.Email, user.Locale, "digest", subjectData, bodyData)
+	if err != nil {
+		return err
+	}
+	message.UnsubscribeUrl, err = ns.unsubscribeUrl(ctx, tx, user.ID, "")
+	if err != nil {
+		return err
+	}
+	return queue.add(ctx, message)
+}
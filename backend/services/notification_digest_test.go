@@ -0,0 +1,105 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/notifications"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationDigest(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	weekly := models.DigestFrequencyWeekly
+	preferences, err := notificationService.UpdateNotificationPreferences(context.Background(), services.UpdateNotificationPreferencesParams{
+		UserId: bob.ID,
+		Channels: map[string]models.NotificationChannel{
+			notifications.NotifyThingShared: models.NotificationChannelDigest,
+		},
+		DigestFrequency: &weekly,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.DigestFrequencyWeekly, preferences.DigestFrequency)
+
+	shareThing := func(sharerId string, targetUserId string) {
+		err := notificationService.ThingShared(context.Background(), services.ThingSharedParams{
+			ThingId:      "thing",
+			SharerId:     sharerId,
+			TargetUserId: targetUserId,
+		})
+		assert.NoError(t, err)
+	}
+
+	shareThing(alice.ID, bob.ID)
+	shareThing(alice.ID, bob.ID)
+	// alice gets emails right away and no digest
+	shareThing(bob.ID, alice.ID)
+	assert.Len(t, emailService.Mails, 1)
+	assert.Equal(t, alice.Email, emailService.Mails[0].To)
+	emailService.Clear()
+
+	sent, err := notificationService.SendDueDigests(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Len(t, emailService.Mails, 1)
+	assert.Equal(t, bob.Email, emailService.Mails[0].To)
+	assert.Contains(t, emailService.Mails[0].Subject, "weekly")
+	assert.Contains(t, emailService.Mails[0].Subject, "2 new notifications")
+	assert.Contains(t, emailService.Mails[0].Body, "Things shared with you: 2")
+	emailService.Clear()
+
+	// nothing is sent twice and the next digest is only due in a week
+	sent, err = notificationService.SendDueDigests(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	shareThing(alice.ID, bob.ID)
+	sent, err = notificationService.SendDueDigests(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+
+	_, err = db.Exec("UPDATE users SET last_digest_at = last_digest_at - interval '8 days' WHERE id = $1", bob.ID)
+	assert.NoError(t, err)
+	sent, err = notificationService.SendDueDigests(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Contains(t, emailService.Mails[0].Subject, "1 new notification")
+	assert.Contains(t, emailService.Mails[0].Body, "Things shared with you: 1")
+	emailService.Clear()
+
+	// a notification committed after the digest query ran may have been
+	// created before the digest, it is part of the next one
+	shareThing(alice.ID, bob.ID)
+	_, err = db.Exec("UPDATE users SET last_digest_at = last_digest_at - interval '8 days' WHERE id = $1", bob.ID)
+	assert.NoError(t, err)
+	_, err = db.Exec(`UPDATE notifications SET created_at = users.last_digest_at - interval '1 hour' FROM users
+		WHERE users.id = notifications.recipient_id AND notifications.recipient_id = $1 AND notifications.digested_at IS NULL`, bob.ID)
+	assert.NoError(t, err)
+	sent, err = notificationService.SendDueDigests(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Contains(t, emailService.Mails[0].Subject, "1 new notification")
+}
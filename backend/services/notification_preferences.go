@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/notifications"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+)
+
+// notify stores the in-app notification unless the recipient turned the
+// content type off. It returns whether an email should be sent right away,
+// notifications on the digest channel are only sent with the digest.
+func (ns *NotificationService) notify(ctx context.Context, exec boil.ContextExecutor, recipientId string, content notifications.StashsphereNotification) (bool, error) {
+	channel, err := operations.NotificationChannelForUser(ctx, exec, recipientId, content.ContentType())
+	if err != nil {
+		return false, err
+	}
+	if channel == models.NotificationChannelOff {
+		return false, nil
+	}
+	_, err = createNotification(ctx, exec, CreateNotification{
+		RecipientId: recipientId,
+		Content:     content,
+	})
+	if err != nil {
+		return false, err
+	}
+	return channel == models.NotificationChannelEmail, nil
+}
+
+type NotificationPreferences struct {
+	Channels        map[string]models.NotificationChannel
+	DigestFrequency models.DigestFrequency
+}
+
+func getNotificationPreferences(ctx context.Context, exec boil.ContextExecutor, userId string) (*NotificationPreferences, error) {
+	user, err := operations.FindUserByID(ctx, exec, userId)
+	if err != nil {
+		return nil, err
+	}
+	channels, err := operations.NotificationChannelsForUser(ctx, exec, userId)
+	if err != nil {
+		return nil, err
+	}
+	return &NotificationPreferences{
+		Channels:        channels,
+		DigestFrequency: user.DigestFrequency,
+	}, nil
+}
+
+func (ns *NotificationService) GetNotificationPreferences(ctx context.Context, userId string) (*NotificationPreferences, error) {
+	return getNotificationPreferences(ctx, ns.db, userId)
+}
+
+type UpdateNotificationPreferencesParams struct {
+	UserId string
+	// content types not included keep their channel
+	Channels map[string]models.NotificationChannel
+	// nil keeps the frequency
+	DigestFrequency *models.DigestFrequency
+}
+
+func (ns *NotificationService) UpdateNotificationPreferences(ctx context.Context, params UpdateNotificationPreferencesParams) (*NotificationPreferences, error) {
+	for contentType, channel := range params.Channels {
+		if !slices.Contains(notifications.ContentTypes, contentType) {
+			return nil, utils.ParameterError{Err: fmt.Errorf("unknown content type %s", contentType)}
+		}
+		if err := channel.IsValid(); err != nil {
+			return nil, utils.ParameterError{Err: err}
+		}
+	}
+	if params.DigestFrequency != nil {
+		if err := params.DigestFrequency.IsValid(); err != nil {
+			return nil, utils.ParameterError{Err: err}
+		}
+	}
+	var preferences *NotificationPreferences
+	err := utils.Tx(ctx, ns.db, func(tx *sql.Tx) error {
+		for contentType, channel := range params.Channels {
+			err := operations.SetNotificationChannel(ctx, tx, params.UserId, contentType, channel)
+			if err != nil {
+				return err
+			}
+		}
+		if params.DigestFrequency != nil {
+			_, err := models.Users(models.UserWhere.ID.EQ(params.UserId)).UpdateAll(ctx, tx, models.M{
+				models.UserColumns.DigestFrequency: *params.DigestFrequency,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		var err error
+		preferences, err = getNotificationPreferences(ctx, tx, params.UserId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
+
+// unsubscribeUrl returns the link placed in the List-Unsubscribe header of
+// notification emails. An empty content type unsubscribes from all emails,
+// which is used for the digest. No link is returned if the api url is unknown.
+func (ns *NotificationService) unsubscribeUrl(ctx context.Context, exec boil.ContextExecutor, userId string, contentType string) (string, error) {
+	if ns.data.ApiUrl == "" {
+		return "", nil
+	}
+	token, err := operations.UnsubscribeToken(ctx, exec, userId)
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{}
+	query.Set("token", token)
+	if contentType != "" {
+		query.Set("contentType", contentType)
+	}
+	return strings.TrimSuffix(ns.data.ApiUrl, "/") + "/api/notifications/unsubscribe?" + query.Encode(), nil
+}
+
+// Unsubscribe stops the emails of the content type for the owner of the
+// token, the notifications are still shown in-app. Without a content type all
+// email and digest channels are switched to in-app.
+func (ns *NotificationService) Unsubscribe(ctx context.Context, token string, contentType string) error {
+	if contentType != "" && !slices.Contains(notifications.ContentTypes, contentType) {
+		return utils.ParameterError{Err: fmt.Errorf("unknown content type %s", contentType)}
+	}
+	return utils.Tx(ctx, ns.db, func(tx *sql.Tx) error {
+		user, err := operations.FindUserByUnsubscribeToken(ctx, tx, token)
+		if err != nil {
+			return err
+		}
+		if contentType != "" {
+			return operations.SetNotificationChannel(ctx, tx, user.ID, contentType, models.NotificationChannelInApp)
+		}
+		channels, err := operations.NotificationChannelsForUser(ctx, tx, user.ID)
+		if err != nil {
+			return err
+		}
+		for contentType, channel := range channels {
+			if channel != models.NotificationChannelEmail && channel != models.NotificationChannelDigest {
+				continue
+			}
+			err = operations.SetNotificationChannel(ctx, tx, user.ID, contentType, models.NotificationChannelInApp)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
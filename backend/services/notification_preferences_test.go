@@ -0,0 +1,97 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/notifications"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationPreferences(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	preferences, err := notificationService.GetNotificationPreferences(context.Background(), bob.ID)
+	assert.NoError(t, err)
+	assert.Len(t, preferences.Channels, len(notifications.ContentTypes))
+	assert.Equal(t, models.NotificationChannelEmail, preferences.Channels[notifications.NotifyThingShared])
+
+	shareThing := func() {
+		err := notificationService.ThingShared(context.Background(), services.ThingSharedParams{
+			ThingId:      "thing",
+			SharerId:     alice.ID,
+			TargetUserId: bob.ID,
+		})
+		assert.NoError(t, err)
+	}
+	countNotifications := func() int64 {
+		count, err := models.Notifications(models.NotificationWhere.RecipientID.EQ(bob.ID)).Count(context.Background(), db)
+		assert.NoError(t, err)
+		return count
+	}
+
+	shareThing()
+	assert.Len(t, emailService.Mails, 1)
+	assert.Equal(t, int64(1), countNotifications())
+
+	preferences, err = notificationService.UpdateNotificationPreferences(context.Background(), services.UpdateNotificationPreferencesParams{
+		UserId: bob.ID,
+		Channels: map[string]models.NotificationChannel{
+			notifications.NotifyThingShared: models.NotificationChannelInApp,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, models.NotificationChannelInApp, preferences.Channels[notifications.NotifyThingShared])
+	assert.Equal(t, models.NotificationChannelEmail, preferences.Channels[notifications.NotifyListShared])
+	shareThing()
+	assert.Len(t, emailService.Mails, 1)
+	assert.Equal(t, int64(2), countNotifications())
+
+	_, err = notificationService.UpdateNotificationPreferences(context.Background(), services.UpdateNotificationPreferencesParams{
+		UserId: bob.ID,
+		Channels: map[string]models.NotificationChannel{
+			notifications.NotifyThingShared: models.NotificationChannelOff,
+		},
+	})
+	assert.NoError(t, err)
+	shareThing()
+	assert.Len(t, emailService.Mails, 1)
+	assert.Equal(t, int64(2), countNotifications())
+
+	// preferences of other users are not affected
+	preferences, err = notificationService.GetNotificationPreferences(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, models.NotificationChannelEmail, preferences.Channels[notifications.NotifyThingShared])
+
+	_, err = notificationService.UpdateNotificationPreferences(context.Background(), services.UpdateNotificationPreferencesParams{
+		UserId: bob.ID,
+		Channels: map[string]models.NotificationChannel{
+			"UNKNOWN": models.NotificationChannelOff,
+		},
+	})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+)
+
+// NotificationStreamResync is sent when events might have been lost, clients
+// should fetch the notifications again
+const NotificationStreamResync = "resync"
+
+// events buffered per subscription, a subscriber not keeping up misses events
+const notificationStreamBufferSize = 16
+
+type NotificationStreamEvent struct {
+	Event        string
+	Notification *models.Notification
+}
+
+type NotificationSubscription struct {
+	Events <-chan NotificationStreamEvent
+	events chan NotificationStreamEvent
+	userId string
+}
+
+// NotificationStreamService distributes notification events received from
+// Postgres to the open streams of the recipient on this instance.
+type NotificationStreamService struct {
+	db            *sql.DB
+	mu            sync.Mutex
+	subscriptions map[string]map[*NotificationSubscription]struct{}
+}
+
+func NewNotificationStreamService(db *sql.DB) *NotificationStreamService {
+	return &NotificationStreamService{
+		db:            db,
+		subscriptions: make(map[string]map[*NotificationSubscription]struct{}),
+	}
+}
+
+func (ns *NotificationStreamService) Subscribe(userId string) *NotificationSubscription {
+	events := make(chan NotificationStreamEvent, notificationStreamBufferSize)
+	subscription := &NotificationSubscription{
+		Events: events,
+		events: events,
+		userId: userId,
+	}
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	if ns.subscriptions[userId] == nil {
+		ns.subscriptions[userId] = make(map[*NotificationSubscription]struct{})
+	}
+	ns.subscriptions[userId][subscription] = struct{}{}
+	return subscription
+}
+
+func (ns *NotificationStreamService) Unsubscribe(subscription *NotificationSubscription) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	subscriptions := ns.subscriptions[subscription.userId]
+	if _, ok := subscriptions[subscription]; !ok {
+		return
+	}
+	delete(subscriptions, subscription)
+	if len(subscriptions) == 0 {
+		delete(ns.subscriptions, subscription.userId)
+	}
+	close(subscription.events)
+}
+
+func (ns *NotificationStreamService) hasSubscriptions(userId string) bool {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	return len(ns.subscriptions[userId]) > 0
+}
+
+func (ns *NotificationStreamService) publish(userId string, event NotificationStreamEvent) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	for subscription := range ns.subscriptions[userId] {
+		select {
+		case subscription.events <- event:
+		default:
+			log.Warn().Str("userId", userId).Msg("Notification stream is full, dropping event")
+		}
+	}
+}
+
+// Resync tells all subscribers that events might have been lost, e.g. after
+// the connection to the database was reestablished
+func (ns *NotificationStreamService) Resync() {
+	ns.mu.Lock()
+	userIds := make([]string, 0, len(ns.subscriptions))
+	for userId := range ns.subscriptions {
+		userIds = append(userIds, userId)
+	}
+	ns.mu.Unlock()
+	for _, userId := range userIds {
+		ns.publish(userId, NotificationStreamEvent{Event: NotificationStreamResync})
+	}
+}
+
+// Dispatch handles a payload received on operations.NotificationEventsChannel
+func (ns *NotificationStreamService) Dispatch(ctx context.Context, payload string) error {
+	var event operations.NotificationEvent
+	err := json.Unmarshal([]byte(payload), &event)
+	if err != nil {
+		return err
+	}
+	if !ns.hasSubscriptions(event.RecipientId) {
+		return nil
+	}
+	notification, err := models.FindNotification(ctx, ns.db, event.NotificationId)
+	if err != nil {
+		// the notification was deleted in the meantime
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return err
+	}
+	ns.publish(event.RecipientId, NotificationStreamEvent{
+		Event:        event.Event,
+		Notification: notification,
+	})
+	return nil
+}
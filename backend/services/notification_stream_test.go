@@ -0,0 +1,81 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/notifications"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationStream(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	streamService := services.NewNotificationStreamService(db)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	// two sessions of alice and one of bob
+	aliceFirst := streamService.Subscribe(alice.ID)
+	aliceSecond := streamService.Subscribe(alice.ID)
+	bobSession := streamService.Subscribe(bob.ID)
+	t.Cleanup(func() {
+		streamService.Unsubscribe(aliceFirst)
+		streamService.Unsubscribe(aliceSecond)
+		streamService.Unsubscribe(bobSession)
+	})
+
+	notification, err := notificationService.CreateNotification(context.Background(), services.CreateNotification{
+		RecipientId: alice.ID,
+		Content:     notifications.FriendRequest{RequestId: "request", SenderId: bob.ID},
+	})
+	assert.NoError(t, err)
+
+	// the listener is not running in tests, dispatch the payload it would receive
+	payload, err := json.Marshal(operations.NotificationEvent{
+		Event:          operations.NotificationEventCreated,
+		NotificationId: notification.ID,
+		RecipientId:    alice.ID,
+	})
+	assert.NoError(t, err)
+	err = streamService.Dispatch(context.Background(), string(payload))
+	assert.NoError(t, err)
+
+	for _, subscription := range []*services.NotificationSubscription{aliceFirst, aliceSecond} {
+		event := <-subscription.Events
+		assert.Equal(t, operations.NotificationEventCreated, event.Event)
+		assert.Equal(t, notification.ID, event.Notification.ID)
+	}
+	assert.Empty(t, bobSession.Events)
+
+	streamService.Resync()
+	for _, subscription := range []*services.NotificationSubscription{aliceFirst, aliceSecond, bobSession} {
+		event := <-subscription.Events
+		assert.Equal(t, services.NotificationStreamResync, event.Event)
+	}
+
+	streamService.Unsubscribe(aliceFirst)
+	_, open := <-aliceFirst.Events
+	assert.False(t, open)
+}
@@ -0,0 +1,328 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+	"golang.org/x/oauth2"
+)
+
+// time to log in at the provider
+const oidcLoginLifetime = 10 * time.Minute
+
+type OIDCConfig struct {
+	// issuer url of the provider, single sign-on is disabled if empty
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// callback url registered at the provider
+	RedirectURL string
+	// requested in addition to the openid scope
+	Scopes []string
+	// create accounts for users of the provider without one
+	AutoProvision bool
+}
+
+// OIDCService logs users in with an OpenID Connect provider using the
+// authorization code flow with PKCE
+type OIDCService struct {
+	db          *sql.DB
+	config      OIDCConfig
+	authService *AuthService
+	userService *UserService
+
+	// discovered on first use, so the server starts while the provider is
+	// unreachable
+	providerLock sync.Mutex
+	provider     *oidc.Provider
+}
+
+func NewOIDCService(db *sql.DB, config OIDCConfig, authService *AuthService, userService *UserService) *OIDCService {
+	return &OIDCService{
+		db:          db,
+		config:      config,
+		authService: authService,
+		userService: userService,
+	}
+}
+
+func (o *OIDCService) Enabled() bool {
+	return o.config.Issuer != ""
+}
+
+var errOIDCDisabled = utils.ParameterError{Err: errors.New("single sign-on is not configured on this instance")}
+
+func (o *OIDCService) getProvider(ctx context.Context) (*oidc.Provider, error) {
+	o.providerLock.Lock()
+	defer o.providerLock.Unlock()
+	if o.provider != nil {
+		return o.provider, nil
+	}
+	provider, err := oidc.NewProvider(ctx, o.config.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("discovering OpenID Connect provider: %w", err)
+	}
+	o.provider = provider
+	return provider, nil
+}
+
+func (o *OIDCService) oauth2Config(provider *oidc.Provider) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     o.config.ClientID,
+		ClientSecret: o.config.ClientSecret,
+		RedirectURL:  o.config.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       append([]string{oidc.ScopeOpenID}, o.config.Scopes...),
+	}
+}
+
+// BeginLogin returns the url of the provider the browser is sent to and the
+// state, which has to be stored in the browser to finish the login. The invite
+// code is used if the login creates an account.
+func (o *OIDCService) BeginLogin(ctx context.Context, inviteCode string) (string, string, error) {
+	return o.begin(ctx, inviteCode, "")
+}
+
+// BeginLink starts a login at the provider which links the account there to
+// the logged in user instead of starting a session
+func (o *OIDCService) BeginLink(ctx context.Context, userId string) (string, string, error) {
+	return o.begin(ctx, "", userId)
+}
+
+func (o *OIDCService) begin(ctx context.Context, inviteCode string, linkUserId string) (string, string, error) {
+	if !o.Enabled() {
+		return "", "", errOIDCDisabled
+	}
+	provider, err := o.getProvider(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	state, err := gonanoid.New(32)
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := gonanoid.New(32)
+	if err != nil {
+		return "", "", err
+	}
+	verifier := oauth2.GenerateVerifier()
+	err = operations.CreateOidcLogin(ctx, o.db, operations.CreateOidcLoginParams{
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		InviteCode:   inviteCode,
+		LinkUserId:   linkUserId,
+		ExpiresAt:    time.Now().Add(oidcLoginLifetime),
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return o.oauth2Config(provider).AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)), state, nil
+}
+
+type oidcClaims struct {
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+}
+
+// FinishLogin exchanges the code the provider redirected to the callback with,
+// maps the subject of the ID token to a user and starts a session. The state
+// has to match the one stored in the browser, otherwise a callback url of a
+// login started by someone else could log the browser into their account. The
+// provider is responsible for further factors, so local two-factor
+// authentication is not required. Logins started with BeginLink link the
+// account and return the user without tokens.
+func (o *OIDCService) FinishLogin(ctx context.Context, state string, browserState string, code string, client operations.SessionClient) (*models.User, string, string, string, string, error) {
+	if !o.Enabled() {
+		return nil, "", "", "", "", errOIDCDisabled
+	}
+	if state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(browserState)) != 1 {
+		log.Info().Msg("OpenID Connect callback without the state of the browser")
+		return nil, "", "", "", "", utils.NotAuthenticatedError{}
+	}
+	provider, err := o.getProvider(ctx)
+	if err != nil {
+		return nil, "", "", "", "", err
+	}
+	login, err := operations.ConsumeOidcLogin(ctx, o.db, state)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "", "", "", "", utils.NotAuthenticatedError{}
+		}
+		return nil, "", "", "", "", err
+	}
+	token, err := o.oauth2Config(provider).Exchange(ctx, code, oauth2.VerifierOption(login.CodeVerifier))
+	if err != nil {
+		log.Info().Err(err).Msg("OpenID Connect code exchange failed")
+		return nil, "", "", "", "", utils.NotAuthenticatedError{}
+	}
+	rawIdToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		log.Info().Msg("OpenID Connect token response lacks an ID token")
+		return nil, "", "", "", "", utils.NotAuthenticatedError{}
+	}
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: o.config.ClientID}).Verify(ctx, rawIdToken)
+	if err != nil {
+		log.Info().Err(err).Msg("OpenID Connect ID token verification failed")
+		return nil, "", "", "", "", utils.NotAuthenticatedError{}
+	}
+	if idToken.Nonce != login.Nonce {
+		return nil, "", "", "", "", utils.NotAuthenticatedError{}
+	}
+	if login.LinkUserID.Valid {
+		user, err := o.linkUser(ctx, login.LinkUserID.String, idToken.Subject)
+		if err != nil {
+			return nil, "", "", "", "", err
+		}
+		return user, "", "", "", "", nil
+	}
+	var claims oidcClaims
+	err = idToken.Claims(&claims)
+	if err != nil {
+		return nil, "", "", "", "", err
+	}
+	user, err := o.findOrProvisionUser(ctx, idToken.Subject, claims, login.InviteCode.String)
+	if err != nil {
+		return nil, "", "", "", "", err
+	}
+	accessToken, infoToken, refreshToken, refreshInfoToken, err := o.authService.StartSession(ctx, user, client)
+	if err != nil {
+		return nil, "", "", "", "", err
+	}
+	return user, accessToken, infoToken, refreshToken, refreshInfoToken, nil
+}
+
+// linkUser connects the subject with the user unless another user is linked
+// to it
+func (o *OIDCService) linkUser(ctx context.Context, userId string, subject string) (*models.User, error) {
+	var user *models.User
+	err := utils.Tx(ctx, o.db, func(tx *sql.Tx) error {
+		linked, err := operations.FindUserByOidcSubject(ctx, tx, subject)
+		if err == nil && linked.ID != userId {
+			return utils.ParameterError{Err: errors.New("the account at the provider is linked to another user")}
+		}
+		if err != nil && !errors.As(err, &utils.NotFoundError{}) {
+			return err
+		}
+		user, err = operations.FindUserByID(ctx, tx, userId)
+		if err != nil {
+			return err
+		}
+		return operations.LinkOidcSubject(ctx, tx, user, subject)
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Str("userId", user.ID).Msg("Linked account at OpenID Connect provider")
+	return user, nil
+}
+
+// canAutoLink reports whether a login at the provider may be linked to the
+// user by the email address. The address has to be verified locally, so an
+// account registered with someone else's address is not taken over, and the
+// user must not have second factors the provider would bypass.
+func (o *OIDCService) canAutoLink(ctx context.Context, user *models.User) (bool, error) {
+	if user.TotpEnabledAt.Valid {
+		return false, nil
+	}
+	hasPasskeys, err := models.Passkeys(models.PasskeyWhere.OwnerID.EQ(user.ID)).Exists(ctx, o.db)
+	if err != nil {
+		return false, err
+	}
+	if hasPasskeys {
+		return false, nil
+	}
+	verification, err := operations.GetEmailVerificationStatus(ctx, o.db, user.ID)
+	if err != nil {
+		return false, err
+	}
+	return verification != nil && verification.VerifiedAt.Valid, nil
+}
+
+// findOrProvisionUser returns the user linked to the subject. Otherwise a user
+// with the verified email address is linked if allowed, or a new account is
+// created if auto-provisioning is enabled.
+func (o *OIDCService) findOrProvisionUser(ctx context.Context, subject string, claims oidcClaims, inviteCode string) (*models.User, error) {
+	user, err := operations.FindUserByOidcSubject(ctx, o.db, subject)
+	if err == nil {
+		return user, nil
+	}
+	if !errors.As(err, &utils.NotFoundError{}) {
+		return nil, err
+	}
+	if claims.Email == "" || !claims.EmailVerified {
+		log.Info().Str("subject", subject).Msg("OpenID Connect login without a verified email address")
+		return nil, utils.NotAuthenticatedError{}
+	}
+
+	user, err = operations.FindUserByEmail(ctx, o.db, claims.Email)
+	if err == nil {
+		autoLink, err := o.canAutoLink(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		if !autoLink {
+			log.Info().Str("userId", user.ID).Msg("OpenID Connect login matches an account which has to be linked explicitly")
+			return nil, utils.OidcLinkRequiredError{}
+		}
+		err = operations.LinkOidcSubject(ctx, o.db, user, subject)
+		if err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+	if !errors.As(err, &utils.NotFoundError{}) {
+		return nil, err
+	}
+	if !o.config.AutoProvision {
+		return nil, utils.NotFoundError{EntityName: "user"}
+	}
+
+	name := claims.Name
+	if name == "" {
+		name = claims.PreferredUsername
+	}
+	if name == "" {
+		name = claims.Email
+	}
+	// the account is used with the provider, the password is only known
+	// after a password reset
+	password, err := gonanoid.New(32)
+	if err != nil {
+		return nil, err
+	}
+	user, err = o.userService.CreateUser(ctx, CreateUserParams{
+		Name:       name,
+		Email:      claims.Email,
+		Password:   password,
+		InviteCode: inviteCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = utils.Tx(ctx, o.db, func(tx *sql.Tx) error {
+		err := operations.LinkOidcSubject(ctx, tx, user, subject)
+		if err != nil {
+			return err
+		}
+		return operations.MarkEmailVerified(ctx, tx, user.ID, user.Email, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Str("userId", user.ID).Msg("Provisioned account for OpenID Connect user")
+	return user, nil
+}
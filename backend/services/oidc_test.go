@@ -0,0 +1,263 @@
+package services_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockOIDCProvider issues ID tokens for codes granted by the test
+type mockOIDCProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	lock   sync.Mutex
+	grants map[string]mockOIDCGrant
+}
+
+type mockOIDCGrant struct {
+	codeChallenge string
+	claims        jwt.MapClaims
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	provider := &mockOIDCProvider{key: key, grants: map[string]mockOIDCGrant{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                provider.server.URL,
+			"authorization_endpoint":                provider.server.URL + "/authorize",
+			"token_endpoint":                        provider.server.URL + "/token",
+			"jwks_uri":                              provider.server.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"alg": "RS256",
+				"use": "sig",
+				"kid": "test",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		provider.lock.Lock()
+		grant, ok := provider.grants[r.PostForm.Get("code")]
+		delete(provider.grants, r.PostForm.Get("code"))
+		provider.lock.Unlock()
+		challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(challenge[:]) != grant.codeChallenge {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, grant.claims)
+		token.Header["kid"] = "test"
+		idToken, err := token.SignedString(key)
+		assert.NoError(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		})
+	})
+	provider.server = httptest.NewServer(mux)
+	t.Cleanup(provider.server.Close)
+	return provider
+}
+
+// authorize grants a code for the login started with the authorization url,
+// as the provider does after the user logged in
+func (p *mockOIDCProvider) authorize(t *testing.T, authorizationUrl string, subject string, email string, emailVerified bool) (string, string) {
+	parsed, err := url.Parse(authorizationUrl)
+	assert.NoError(t, err)
+	query := parsed.Query()
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	code := rand.Text()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.grants[code] = mockOIDCGrant{
+		codeChallenge: query.Get("code_challenge"),
+		claims: jwt.MapClaims{
+			"iss":            p.server.URL,
+			"aud":            "stashsphere",
+			"sub":            subject,
+			"exp":            time.Now().Add(time.Minute).Unix(),
+			"iat":            time.Now().Unix(),
+			"nonce":          query.Get("nonce"),
+			"email":          email,
+			"email_verified": emailVerified,
+			"name":           "SSO User",
+		},
+	}
+	return query.Get("state"), code
+}
+
+func TestOIDCLogin(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	provider := newMockOIDCProvider(t)
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	authService := services.NewAuthService(db, privateKey, publicKey, time.Hour, 24*time.Hour, "", false)
+	userService := services.NewUserService(db, true, "invite", 60, nil)
+	client := operations.SessionClient{UserAgent: "Firefox", IPAddress: "192.0.2.1"}
+	config := services.OIDCConfig{
+		Issuer:       provider.server.URL,
+		ClientID:     "stashsphere",
+		ClientSecret: "secret",
+		RedirectURL:  "https://api.example.com/api/user/oidc/callback",
+		Scopes:       []string{"email", "profile"},
+	}
+	oidcService := services.NewOIDCService(db, config, authService, userService)
+	config.AutoProvision = true
+	provisioningService := services.NewOIDCService(db, config, authService, userService)
+	ctx := context.Background()
+
+	disabledService := services.NewOIDCService(db, services.OIDCConfig{}, authService, userService)
+	assert.False(t, disabledService.Enabled())
+	_, _, err = disabledService.BeginLogin(ctx, "")
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+
+	// callbacks are only accepted in the browser which started the login
+	authorizationUrl, browserState, err := oidcService.BeginLogin(ctx, "")
+	assert.NoError(t, err)
+	state, code := provider.authorize(t, authorizationUrl, "subject1", "sso@example.com", true)
+	assert.Equal(t, browserState, state)
+	_, _, _, _, _, err = oidcService.FinishLogin(ctx, state, "", code, client)
+	assert.ErrorIs(t, err, utils.NotAuthenticatedError{})
+	_, _, _, _, _, err = oidcService.FinishLogin(ctx, state, "other", code, client)
+	assert.ErrorIs(t, err, utils.NotAuthenticatedError{})
+
+	// unknown users are rejected without auto-provisioning
+	authorizationUrl, _, err = oidcService.BeginLogin(ctx, "")
+	assert.NoError(t, err)
+	state, code = provider.authorize(t, authorizationUrl, "subject1", "sso@example.com", true)
+	_, _, _, _, _, err = oidcService.FinishLogin(ctx, state, state, code, client)
+	assert.ErrorAs(t, err, &utils.NotFoundError{})
+
+	// auto-provisioned accounts require the invite code
+	authorizationUrl, _, err = provisioningService.BeginLogin(ctx, "wrong")
+	assert.NoError(t, err)
+	state, code = provider.authorize(t, authorizationUrl, "subject1", "sso@example.com", true)
+	_, _, _, _, _, err = provisioningService.FinishLogin(ctx, state, state, code, client)
+	assert.ErrorIs(t, err, utils.WrongInviteCodeError{})
+
+	authorizationUrl, _, err = provisioningService.BeginLogin(ctx, "invite")
+	assert.NoError(t, err)
+	state, code = provider.authorize(t, authorizationUrl, "subject1", "sso@example.com", true)
+	user, accessToken, _, _, _, err := provisioningService.FinishLogin(ctx, state, state, code, client)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, accessToken)
+	assert.Equal(t, "sso@example.com", user.Email)
+	assert.Equal(t, "SSO User", user.Name)
+	verification, err := userService.GetEmailVerificationStatus(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.True(t, verification.VerifiedAt.Valid)
+
+	// the state can not be used twice
+	_, _, _, _, _, err = provisioningService.FinishLogin(ctx, state, state, code, client)
+	assert.ErrorIs(t, err, utils.NotAuthenticatedError{})
+
+	// the subject identifies the user even if the email address changed
+	authorizationUrl, _, err = oidcService.BeginLogin(ctx, "")
+	assert.NoError(t, err)
+	state, code = provider.authorize(t, authorizationUrl, "subject1", "renamed@example.com", true)
+	sameUser, _, _, _, _, err := oidcService.FinishLogin(ctx, state, state, code, client)
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID, sameUser.ID)
+
+	// existing accounts are linked by their verified email address
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	aliceParams.InviteCode = "invite"
+	alice, err := userService.CreateUser(ctx, *aliceParams)
+	assert.NoError(t, err)
+	authorizationUrl, _, err = oidcService.BeginLogin(ctx, "")
+	assert.NoError(t, err)
+	state, code = provider.authorize(t, authorizationUrl, "subject2", alice.Email, false)
+	_, _, _, _, _, err = oidcService.FinishLogin(ctx, state, state, code, client)
+	assert.ErrorIs(t, err, utils.NotAuthenticatedError{})
+	// the address has to be verified locally as well
+	authorizationUrl, _, err = oidcService.BeginLogin(ctx, "")
+	assert.NoError(t, err)
+	state, code = provider.authorize(t, authorizationUrl, "subject2", alice.Email, true)
+	_, _, _, _, _, err = oidcService.FinishLogin(ctx, state, state, code, client)
+	assert.ErrorIs(t, err, utils.OidcLinkRequiredError{})
+	err = operations.MarkEmailVerified(ctx, db, alice.ID, alice.Email, time.Now())
+	assert.NoError(t, err)
+	authorizationUrl, _, err = oidcService.BeginLogin(ctx, "")
+	assert.NoError(t, err)
+	state, code = provider.authorize(t, authorizationUrl, "subject2", alice.Email, true)
+	linked, _, _, _, _, err := oidcService.FinishLogin(ctx, state, state, code, client)
+	assert.NoError(t, err)
+	assert.Equal(t, alice.ID, linked.ID)
+	assert.Equal(t, "subject2", linked.OidcSubject.String)
+
+	// accounts with two-factor authentication are only linked explicitly
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bobParams.InviteCode = "invite"
+	bob, err := userService.CreateUser(ctx, *bobParams)
+	assert.NoError(t, err)
+	err = operations.MarkEmailVerified(ctx, db, bob.ID, bob.Email, time.Now())
+	assert.NoError(t, err)
+	bob.TotpEnabledAt = null.TimeFrom(time.Now())
+	_, err = bob.Update(ctx, db, boil.Whitelist(models.UserColumns.TotpEnabledAt))
+	assert.NoError(t, err)
+	authorizationUrl, _, err = oidcService.BeginLogin(ctx, "")
+	assert.NoError(t, err)
+	state, code = provider.authorize(t, authorizationUrl, "subject3", bob.Email, true)
+	_, _, _, _, _, err = oidcService.FinishLogin(ctx, state, state, code, client)
+	assert.ErrorIs(t, err, utils.OidcLinkRequiredError{})
+
+	authorizationUrl, _, err = oidcService.BeginLink(ctx, bob.ID)
+	assert.NoError(t, err)
+	state, code = provider.authorize(t, authorizationUrl, "subject3", "other@example.com", false)
+	linked, accessToken, _, _, _, err = oidcService.FinishLogin(ctx, state, state, code, client)
+	assert.NoError(t, err)
+	assert.Empty(t, accessToken)
+	assert.Equal(t, bob.ID, linked.ID)
+	assert.Equal(t, "subject3", linked.OidcSubject.String)
+
+	// subjects linked to another user are not taken over
+	authorizationUrl, _, err = oidcService.BeginLink(ctx, bob.ID)
+	assert.NoError(t, err)
+	state, code = provider.authorize(t, authorizationUrl, "subject2", alice.Email, true)
+	_, _, _, _, _, err = oidcService.FinishLogin(ctx, state, state, code, client)
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+	"math"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+)
+
+// PageInfo describes a page of an index. The totals are only counted in offset
+// mode. In cursor mode NextCursor points to the following page and is nil on
+// the last page.
+type PageInfo struct {
+	TotalCount     uint64
+	TotalPageCount uint64
+	NextCursor     *string
+}
+
+func offsetPageInfo(count int64, perPage uint64) PageInfo {
+	return PageInfo{
+		TotalCount:     uint64(count),
+		TotalPageCount: uint64(math.Ceil(float64(count) / float64(perPage))),
+	}
+}
+
+// cursorQueryMods returns the mods selecting a page in cursor mode, an empty
+// cursor requests the first page. One row more than requested is fetched to
+// find out whether another page follows.
+func cursorQueryMods(order operations.SortOrder, cursor string, perPage uint64) ([]qm.QueryMod, error) {
+	mods := []qm.QueryMod{qm.Limit(int(perPage) + 1)}
+	if cursor != "" {
+		decoded, err := operations.DecodeCursor(cursor, order)
+		if err != nil {
+			return nil, utils.ParameterError{Err: err}
+		}
+		mods = append(mods, order.AfterCursor(decoded))
+	}
+	return mods, nil
+}
+
+// cursorPageInfo returns the number of fetched rows belonging to the page and
+// the cursor of the next page
+func cursorPageInfo(ctx context.Context, exec boil.ContextExecutor, order operations.SortOrder, perPage uint64, ids []string) (int, PageInfo, error) {
+	if uint64(len(ids)) <= perPage {
+		return len(ids), PageInfo{}, nil
+	}
+	next, err := order.CursorAt(ctx, exec, ids[perPage-1])
+	if err != nil {
+		return 0, PageInfo{}, err
+	}
+	return int(perPage), PageInfo{NextCursor: &next}, nil
+}
@@ -0,0 +1,283 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+)
+
+// time to complete a passkey registration or login in the browser
+const webauthnCeremonyLifetime = 5 * time.Minute
+
+type PasskeyConfig struct {
+	// domain the passkeys are bound to, passkeys are disabled if empty
+	RPID          string
+	RPDisplayName string
+	// origins of the frontend allowed to use the passkeys
+	Origins []string
+}
+
+// PasskeyService registers WebAuthn credentials of users and logs them in
+// with these
+type PasskeyService struct {
+	db          *sql.DB
+	webAuthn    *webauthn.WebAuthn
+	authService *AuthService
+}
+
+func NewPasskeyService(db *sql.DB, config PasskeyConfig, authService *AuthService) (*PasskeyService, error) {
+	ps := &PasskeyService{db: db, authService: authService}
+	if config.RPID == "" {
+		return ps, nil
+	}
+	timeout := webauthn.TimeoutConfig{
+		Enforce:    true,
+		Timeout:    webauthnCeremonyLifetime,
+		TimeoutUVD: webauthnCeremonyLifetime,
+	}
+	webAuthn, err := webauthn.New(&webauthn.Config{
+		RPID:          config.RPID,
+		RPDisplayName: config.RPDisplayName,
+		RPOrigins:     config.Origins,
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        timeout,
+			Registration: timeout,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ps.webAuthn = webAuthn
+	return ps, nil
+}
+
+func (ps *PasskeyService) Enabled() bool {
+	return ps.webAuthn != nil
+}
+
+var errPasskeysDisabled = utils.ParameterError{Err: errors.New("passkeys are not configured on this instance")}
+
+// webauthnUser provides a user with their credentials to the webauthn library.
+// The user id serves as user handle.
+type webauthnUser struct {
+	user        *models.User
+	credentials []webauthn.Credential
+}
+
+func (wu *webauthnUser) WebAuthnID() []byte                         { return []byte(wu.user.ID) }
+func (wu *webauthnUser) WebAuthnName() string                       { return wu.user.Email }
+func (wu *webauthnUser) WebAuthnDisplayName() string                { return wu.user.Name }
+func (wu *webauthnUser) WebAuthnCredentials() []webauthn.Credential { return wu.credentials }
+
+func (ps *PasskeyService) loadWebauthnUser(ctx context.Context, userId string) (*webauthnUser, error) {
+	user, err := operations.FindUserByID(ctx, ps.db, userId)
+	if err != nil {
+		return nil, err
+	}
+	passkeys, err := operations.GetPasskeysForUser(ctx, ps.db, userId)
+	if err != nil {
+		return nil, err
+	}
+	credentials := make([]webauthn.Credential, len(passkeys))
+	for i, passkey := range passkeys {
+		credentials[i], err = operations.PasskeyCredential(passkey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &webauthnUser{user, credentials}, nil
+}
+
+func (ps *PasskeyService) createCeremony(ctx context.Context, kind models.WebauthnCeremonyKind, userId string, session *webauthn.SessionData) (string, error) {
+	ceremonyId, err := gonanoid.New()
+	if err != nil {
+		return "", err
+	}
+	err = operations.CreateWebauthnCeremony(ctx, ps.db, ceremonyId, kind, userId, session, time.Now().Add(webauthnCeremonyLifetime))
+	if err != nil {
+		return "", err
+	}
+	return ceremonyId, nil
+}
+
+// BeginRegistration returns the options for creating a passkey in the browser
+// and the id of the ceremony to finish the registration with
+func (ps *PasskeyService) BeginRegistration(ctx context.Context, userId string) (string, *protocol.CredentialCreation, error) {
+	if !ps.Enabled() {
+		return "", nil, errPasskeysDisabled
+	}
+	user, err := ps.loadWebauthnUser(ctx, userId)
+	if err != nil {
+		return "", nil, err
+	}
+	creation, session, err := ps.webAuthn.BeginRegistration(user,
+		webauthn.WithExclusions(webauthn.Credentials(user.credentials).CredentialDescriptors()),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+	)
+	if err != nil {
+		return "", nil, err
+	}
+	ceremonyId, err := ps.createCeremony(ctx, models.WebauthnCeremonyKindRegistration, userId, session)
+	if err != nil {
+		return "", nil, err
+	}
+	return ceremonyId, creation, nil
+}
+
+// FinishRegistration verifies the credential created by the browser and stores
+// it under the given name
+func (ps *PasskeyService) FinishRegistration(ctx context.Context, userId string, ceremonyId string, name string, response []byte) (*models.Passkey, error) {
+	if !ps.Enabled() {
+		return nil, errPasskeysDisabled
+	}
+	if name == "" {
+		return nil, utils.ParameterError{Err: errors.New("passkey name must not be empty")}
+	}
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return nil, utils.ParameterError{Err: err}
+	}
+	session, err := operations.ConsumeWebauthnCeremony(ctx, ps.db, ceremonyId, models.WebauthnCeremonyKindRegistration, userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.ParameterError{Err: errors.New("passkey registration expired")}
+		}
+		return nil, err
+	}
+	user, err := ps.loadWebauthnUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := ps.webAuthn.CreateCredential(user, *session, parsed)
+	if err != nil {
+		return nil, utils.ParameterError{Err: err}
+	}
+	passkeyId, err := gonanoid.New()
+	if err != nil {
+		return nil, err
+	}
+	return operations.CreatePasskey(ctx, ps.db, passkeyId, userId, name, credential)
+}
+
+// BeginLogin returns the options for a login with any passkey of the instance
+// and the id of the ceremony to finish the login with
+func (ps *PasskeyService) BeginLogin(ctx context.Context) (string, *protocol.CredentialAssertion, error) {
+	if !ps.Enabled() {
+		return "", nil, errPasskeysDisabled
+	}
+	assertion, session, err := ps.webAuthn.BeginDiscoverableLogin(
+		webauthn.WithUserVerification(protocol.VerificationRequired),
+	)
+	if err != nil {
+		return "", nil, err
+	}
+	ceremonyId, err := ps.createCeremony(ctx, models.WebauthnCeremonyKindLogin, "", session)
+	if err != nil {
+		return "", nil, err
+	}
+	return ceremonyId, assertion, nil
+}
+
+// FinishLogin verifies the assertion of the browser and starts a session for
+// the owner of the passkey. Passkeys require user verification and replace
+// the password as well as the second factor.
+func (ps *PasskeyService) FinishLogin(ctx context.Context, ceremonyId string, response []byte, client operations.SessionClient) (*models.User, string, string, string, string, error) {
+	if !ps.Enabled() {
+		return nil, "", "", "", "", errPasskeysDisabled
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return nil, "", "", "", "", utils.ParameterError{Err: err}
+	}
+	session, err := operations.ConsumeWebauthnCeremony(ctx, ps.db, ceremonyId, models.WebauthnCeremonyKindLogin, "")
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, "", "", "", "", utils.NotAuthenticatedError{}
+		}
+		return nil, "", "", "", "", err
+	}
+	var passkey *models.Passkey
+	handler := func(rawID []byte, userHandle []byte) (webauthn.User, error) {
+		found, err := operations.FindPasskeyByCredentialId(ctx, ps.db, rawID)
+		if err != nil {
+			return nil, err
+		}
+		if found.OwnerID != string(userHandle) {
+			return nil, errors.New("user handle does not match the passkey")
+		}
+		passkey = found
+		return ps.loadWebauthnUser(ctx, found.OwnerID)
+	}
+	authenticated, credential, err := ps.webAuthn.ValidatePasskeyLogin(handler, *session, parsed)
+	if err != nil {
+		log.Info().Err(err).Msg("Passkey login failed")
+		return nil, "", "", "", "", utils.NotAuthenticatedError{}
+	}
+	user := authenticated.(*webauthnUser).user
+	if credential.Authenticator.CloneWarning {
+		log.Warn().Str("userId", user.ID).Str("passkeyId", passkey.ID).Msg("Passkey sign count decreased, the authenticator may be cloned")
+		return nil, "", "", "", "", utils.NotAuthenticatedError{}
+	}
+	err = operations.UpdatePasskeyCredential(ctx, ps.db, passkey, credential, time.Now())
+	if err != nil {
+		return nil, "", "", "", "", err
+	}
+	accessToken, infoToken, refreshToken, refreshInfoToken, err := ps.authService.StartSession(ctx, user, client)
+	if err != nil {
+		return nil, "", "", "", "", err
+	}
+	return user, accessToken, infoToken, refreshToken, refreshInfoToken, nil
+}
+
+func (ps *PasskeyService) GetPasskeysForUser(ctx context.Context, userId string) (models.PasskeySlice, error) {
+	return operations.GetPasskeysForUser(ctx, ps.db, userId)
+}
+
+func (ps *PasskeyService) findOwnPasskey(ctx context.Context, passkeyId string, userId string) (*models.Passkey, error) {
+	passkey, err := models.FindPasskey(ctx, ps.db, passkeyId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.NotFoundError{EntityName: "Passkey"}
+		}
+		return nil, err
+	}
+	if passkey.OwnerID != userId {
+		return nil, utils.EntityDoesNotBelongToUserError{}
+	}
+	return passkey, nil
+}
+
+func (ps *PasskeyService) RenamePasskey(ctx context.Context, passkeyId string, userId string, name string) (*models.Passkey, error) {
+	if name == "" {
+		return nil, utils.ParameterError{Err: errors.New("passkey name must not be empty")}
+	}
+	passkey, err := ps.findOwnPasskey(ctx, passkeyId, userId)
+	if err != nil {
+		return nil, err
+	}
+	passkey.Name = name
+	_, err = passkey.Update(ctx, ps.db, boil.Whitelist(models.PasskeyColumns.Name, models.PasskeyColumns.UpdatedAt))
+	if err != nil {
+		return nil, err
+	}
+	return passkey, nil
+}
+
+func (ps *PasskeyService) DeletePasskey(ctx context.Context, passkeyId string, userId string) error {
+	passkey, err := ps.findOwnPasskey(ctx, passkeyId, userId)
+	if err != nil {
+		return err
+	}
+	_, err = passkey.Delete(ctx, ps.db)
+	return err
+}
@@ -0,0 +1,90 @@
+package services_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasskeys(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	authService := services.NewAuthService(db, privateKey, publicKey, time.Hour, 24*time.Hour, "", false)
+	userService := services.NewUserService(db, false, "", 60, nil)
+
+	disabledService, err := services.NewPasskeyService(db, services.PasskeyConfig{}, authService)
+	assert.NoError(t, err)
+	assert.False(t, disabledService.Enabled())
+	_, _, err = disabledService.BeginLogin(context.Background())
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+
+	passkeyService, err := services.NewPasskeyService(db, services.PasskeyConfig{
+		RPID:          "localhost",
+		RPDisplayName: "StashsphereTest",
+		Origins:       []string{"http://localhost:5173"},
+	}, authService)
+	assert.NoError(t, err)
+	assert.True(t, passkeyService.Enabled())
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	ceremonyId, creation, err := passkeyService.BeginRegistration(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost", creation.Response.RelyingParty.ID)
+	_, err = passkeyService.FinishRegistration(context.Background(), alice.ID, ceremonyId, "Laptop", []byte("{}"))
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+
+	// ceremonies can only be consumed once and by the user who started them
+	ceremonyId, _, err = passkeyService.BeginRegistration(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	_, err = operations.ConsumeWebauthnCeremony(context.Background(), db, ceremonyId, models.WebauthnCeremonyKindRegistration, bob.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = operations.ConsumeWebauthnCeremony(context.Background(), db, ceremonyId, models.WebauthnCeremonyKindLogin, "")
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	_, err = operations.ConsumeWebauthnCeremony(context.Background(), db, ceremonyId, models.WebauthnCeremonyKindRegistration, alice.ID)
+	assert.NoError(t, err)
+	_, err = operations.ConsumeWebauthnCeremony(context.Background(), db, ceremonyId, models.WebauthnCeremonyKindRegistration, alice.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	passkey, err := operations.CreatePasskey(context.Background(), db, "passkey1", alice.ID, "Laptop", &webauthn.Credential{ID: []byte("credential1")})
+	assert.NoError(t, err)
+	passkeys, err := passkeyService.GetPasskeysForUser(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Len(t, passkeys, 1)
+
+	_, err = passkeyService.RenamePasskey(context.Background(), passkey.ID, bob.ID, "Stolen")
+	assert.ErrorIs(t, err, utils.EntityDoesNotBelongToUserError{})
+	renamed, err := passkeyService.RenamePasskey(context.Background(), passkey.ID, alice.ID, "Phone")
+	assert.NoError(t, err)
+	assert.Equal(t, "Phone", renamed.Name)
+
+	err = passkeyService.DeletePasskey(context.Background(), passkey.ID, bob.ID)
+	assert.ErrorIs(t, err, utils.EntityDoesNotBelongToUserError{})
+	err = passkeyService.DeletePasskey(context.Background(), passkey.ID, alice.ID)
+	assert.NoError(t, err)
+	err = passkeyService.DeletePasskey(context.Background(), passkey.ID, alice.ID)
+	assert.ErrorIs(t, err, utils.NotFoundError{EntityName: "Passkey"})
+}
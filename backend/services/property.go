@@ -4,6 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
+	"strings"
+	"unicode"
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -19,9 +22,20 @@ func NewPropertyService(db *sql.DB) *PropertyService {
 	return &PropertyService{db}
 }
 
+type PropertyFilterKey struct {
+	// the name as it is written in a property filter
+	Key       string   `json:"key"`
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Units     []string `json:"units"`
+	Operators []string `json:"operators"`
+}
+
 type PropertyAutoCompleteResult struct {
 	CompletionType string   `json:"completionType"`
 	Values         []string `json:"values"`
+	// only set when completing names
+	FilterKeys []PropertyFilterKey `json:"filterKeys,omitempty"`
 }
 
 type PropertyAutoCompleteParams struct {
@@ -80,7 +94,30 @@ func (ps *PropertyService) AutoComplete(ctx context.Context, params PropertyAuto
 		return nil, err
 	}
 	resultSet := make(map[string]bool)
+	filterKeys := []PropertyFilterKey{}
+	type nameType struct {
+		name         string
+		propertyType models.PropertyType
+	}
+	filterKeyIndex := make(map[nameType]int)
 	for _, property := range properties {
+		if completionType == "name" {
+			index, ok := filterKeyIndex[nameType{property.Name, property.Type}]
+			if !ok {
+				index = len(filterKeys)
+				filterKeyIndex[nameType{property.Name, property.Type}] = index
+				filterKeys = append(filterKeys, PropertyFilterKey{
+					Key:       propertyFilterKey(property.Name),
+					Name:      property.Name,
+					Type:      property.Type.String(),
+					Units:     []string{},
+					Operators: operations.PropertyFilterOperators[property.Type.String()],
+				})
+			}
+			if property.Unit.Valid && !slices.Contains(filterKeys[index].Units, property.Unit.String) {
+				filterKeys[index].Units = append(filterKeys[index].Units, property.Unit.String)
+			}
+		}
 		if completionType == "value" {
 			resultSet[property.ValueString.String] = true
 		} else {
@@ -91,8 +128,22 @@ func (ps *PropertyService) AutoComplete(ctx context.Context, params PropertyAuto
 	for key, _ := range resultSet {
 		result = append(result, key)
 	}
+	if completionType == "value" {
+		filterKeys = nil
+	}
 	return &PropertyAutoCompleteResult{
 		CompletionType: completionType,
 		Values:         result,
+		FilterKeys:     filterKeys,
 	}, nil
 }
+
+// propertyFilterKey writes spaces as underscores and quotes names that would
+// otherwise not be parsed as a single name
+func propertyFilterKey(name string) string {
+	key := strings.ReplaceAll(name, " ", "_")
+	if strings.ContainsAny(key, `()<>=`) || strings.IndexFunc(key, unicode.IsSpace) >= 0 {
+		return `"` + name + `"`
+	}
+	return key
+}
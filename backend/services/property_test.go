@@ -13,6 +13,7 @@ import (
 	"github.com/stashsphere/backend/operations"
 	"github.com/stashsphere/backend/services"
 	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -588,3 +589,95 @@ func TestPropertyAutoComplete_NoPropertiesOnThings(t *testing.T) {
 	assert.Equal(t, "name", result.CompletionType)
 	assert.Len(t, result.Values, 0)
 }
+
+func TestPropertyAutoComplete_FilterKeys(t *testing.T) {
+	env := setupTestEnv(t)
+	user := createTestUser(t, env.ctx, env.db)
+
+	kg := "kg"
+	g := "g"
+	createThingWithProperties(t, env.ctx, env.db, env.imageService, user.ID, []operations.CreatePropertyParams{
+		operations.CreatePropertyFloatParams{Name: "Weight", Value: 1.5, Unit: &kg},
+		operations.CreatePropertyDatetimeParams{Name: "Warranty until", Value: time.Now()},
+	})
+	createThingWithProperties(t, env.ctx, env.db, env.imageService, user.ID, []operations.CreatePropertyParams{
+		operations.CreatePropertyFloatParams{Name: "Weight", Value: 300, Unit: &g},
+	})
+
+	result, err := env.propertyService.AutoComplete(env.ctx, services.PropertyAutoCompleteParams{
+		UserId: user.ID,
+		Name:   "W",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, result.FilterKeys, 2)
+	for _, key := range result.FilterKeys {
+		switch key.Name {
+		case "Weight":
+			assert.Equal(t, "Weight", key.Key)
+			assert.Equal(t, "float", key.Type)
+			assertContainsString(t, key.Units, "kg")
+			assertContainsString(t, key.Units, "g")
+			assertContainsString(t, key.Operators, "<")
+		case "Warranty until":
+			assert.Equal(t, "Warranty_until", key.Key)
+			assert.Equal(t, "datetime", key.Type)
+			assert.Equal(t, []string{"before", "after"}, key.Operators)
+		default:
+			t.Errorf("unexpected filter key %s", key.Name)
+		}
+	}
+}
+
+func TestThingsPropertyFilter(t *testing.T) {
+	env := setupTestEnv(t)
+	user := createTestUser(t, env.ctx, env.db)
+
+	kg := "kg"
+	g := "g"
+	heavy := createThingWithProperties(t, env.ctx, env.db, env.imageService, user.ID, []operations.CreatePropertyParams{
+		operations.CreatePropertyFloatParams{Name: "Weight", Value: 3, Unit: &kg},
+		operations.CreatePropertyStringParams{Name: "Color", Value: "Green"},
+	})
+	light := createThingWithProperties(t, env.ctx, env.db, env.imageService, user.ID, []operations.CreatePropertyParams{
+		operations.CreatePropertyFloatParams{Name: "Weight", Value: 800, Unit: &g},
+		operations.CreatePropertyDatetimeParams{Name: "Warranty until", Value: time.Date(2028, 1, 1, 0, 0, 0, 0, time.UTC)},
+		operations.CreatePropertyStringParams{Name: "Color", Value: "Red"},
+	})
+
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(env.db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	thingService := services.NewThingService(env.db, env.imageService, notificationService)
+
+	filterThings := func(filter string) []string {
+		_, things, err := thingService.GetThingsForUser(env.ctx, services.GetThingsForUserParams{
+			UserId:         user.ID,
+			PropertyFilter: filter,
+		})
+		assert.NoError(t, err)
+		ids := []string{}
+		for _, thing := range things {
+			ids = append(ids, thing.ID)
+		}
+		return ids
+	}
+
+	// units of the same dimension are converted
+	assert.Equal(t, []string{light.ID}, filterThings("weight < 2 kg"))
+	assert.Equal(t, []string{heavy.ID}, filterThings("weight >= 1000 g"))
+	assert.Equal(t, []string{light.ID}, filterThings("warranty_until after 2027-01-01"))
+	assert.Empty(t, filterThings("warranty_until before 2027-01-01"))
+	assert.Equal(t, []string{heavy.ID}, filterThings("color = Green"))
+	assert.Equal(t, []string{light.ID}, filterThings("color prefix re"))
+	assertStringSliceEqual(t, []string{heavy.ID, light.ID}, filterThings("color = Green OR weight < 2 kg"))
+	assert.Empty(t, filterThings("color = Green AND weight < 2 kg"))
+	assert.Equal(t, []string{light.ID}, filterThings("(color = Green OR color = Red) AND \"Warranty until\" after 2027-01-01"))
+
+	_, _, err := thingService.GetThingsForUser(env.ctx, services.GetThingsForUserParams{
+		UserId:         user.ID,
+		PropertyFilter: "weight < heavy",
+	})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+}
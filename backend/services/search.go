@@ -3,9 +3,11 @@ package services
 import (
 	"context"
 	"database/sql"
-	"strings"
+	"math"
 
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
 )
 
 type SearchService struct {
@@ -23,48 +25,132 @@ func NewSearchService(db *sql.DB, thingService *ThingService, listService *ListS
 }
 
 type SearchParams struct {
-	Query string `json:"query"`
+	Query   string
+	PerPage uint64
+	Page    uint64
+}
+
+type ThingSearchHit struct {
+	Thing *models.Thing
+	Rank  float64
+	// HTML escaped, matches are wrapped in <mark>
+	Snippet string
+}
+
+type ListSearchHit struct {
+	List    *models.List
+	Rank    float64
+	Snippet string
 }
 
 type SearchResult struct {
-	Things []models.Thing `json:"things"`
-	Lists  []models.List  `json:"lists"`
+	Things         []ThingSearchHit
+	Lists          []ListSearchHit
+	ThingCount     uint64
+	ListCount      uint64
+	ThingPageCount uint64
+	ListPageCount  uint64
 }
 
-// Basic search using substring matchin
-// TODO add pagination
+// Search runs a full-text search over the things and lists visible to the user.
+// Things and lists are paginated independently with the same page size.
 func (sp *SearchService) Search(ctx context.Context, userId string, params *SearchParams) (*SearchResult, error) {
-	_, _, userThings, err := sp.thingService.GetThingsForUser(ctx, GetThingsForUserParams{
-		UserId:   userId,
-		Paginate: false,
+	result := &SearchResult{
+		Things: []ThingSearchHit{},
+		Lists:  []ListSearchHit{},
+	}
+	tsQuery := operations.SearchTsQuery(params.Query)
+	if tsQuery == "" {
+		return result, nil
+	}
+
+	tx, err := sp.db.BeginTx(ctx, &sql.TxOptions{
+		ReadOnly: true,
 	})
 	if err != nil {
 		return nil, err
 	}
-	_, _, userLists, err := sp.listService.GetListsForUser(ctx, GetListsForUserParams{
-		UserId:   userId,
-		Paginate: false,
-	})
+	defer tx.Rollback()
+
+	limit, offset := int(params.PerPage), int(params.PerPage*params.Page)
+
+	sharedThingIds, err := operations.GetSharedThingIdsForUser(ctx, tx, userId)
 	if err != nil {
 		return nil, err
 	}
-	filteredThings := []models.Thing{}
-	for _, thing := range userThings {
-		if strings.Contains(strings.ToLower(thing.Name), strings.ToLower(params.Query)) ||
-			strings.Contains(strings.ToLower(thing.Description), strings.ToLower(params.Query)) ||
-			(userId == thing.OwnerID && strings.Contains(strings.ToLower(thing.PrivateNote), strings.ToLower(params.Query))) {
-			filteredThings = append(filteredThings, *thing)
-		}
+	thingCount, thingHits, err := operations.SearchThings(ctx, tx, tsQuery, userId, sharedThingIds, limit, offset)
+	if err != nil {
+		return nil, err
 	}
-	filteredLists := []models.List{}
-	for _, list := range userLists {
-		if strings.Contains(strings.ToLower(list.Name), strings.ToLower(params.Query)) {
-			filteredLists = append(filteredLists, *list)
-		}
+	thingIds := make([]string, len(thingHits))
+	for i, hit := range thingHits {
+		thingIds[i] = hit.Id
 	}
-	result := &SearchResult{
-		Things: filteredThings,
-		Lists:  filteredLists,
+	things, err := models.Things(
+		models.ThingWhere.ID.IN(thingIds),
+		qm.Load(models.ThingRels.Properties),
+		qm.Load(models.ThingRels.QuantityEntries),
+		qm.Load(qm.Rels(models.ThingRels.Lists, models.ListRels.Owner)),
+		qm.Load(models.ThingRels.Owner),
+		qm.Load(qm.Rels(models.ThingRels.Shares, models.ShareRels.Owner)),
+		qm.Load(qm.Rels(models.ThingRels.Shares, models.ShareRels.TargetUser)),
+		qm.Load(qm.Rels(models.ThingRels.ImagesThings, models.ImagesThingRels.Image)),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, err
 	}
+	err = operations.LoadLocationPaths(ctx, tx, userId, things...)
+	if err != nil {
+		return nil, err
+	}
+	thingsById := make(map[string]*models.Thing)
+	for _, thing := range things {
+		thingsById[thing.ID] = thing
+	}
+	for _, hit := range thingHits {
+		result.Things = append(result.Things, ThingSearchHit{
+			Thing:   thingsById[hit.Id],
+			Rank:    hit.Rank,
+			Snippet: operations.SnippetToHTML(hit.Snippet),
+		})
+	}
+
+	sharedListIds, err := operations.GetSharedListIdsForUser(ctx, tx, userId)
+	if err != nil {
+		return nil, err
+	}
+	listCount, listHits, err := operations.SearchLists(ctx, tx, tsQuery, userId, sharedListIds, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	listIds := make([]string, len(listHits))
+	for i, hit := range listHits {
+		listIds[i] = hit.Id
+	}
+	lists, err := models.Lists(
+		models.ListWhere.ID.IN(listIds),
+		qm.Load(qm.Rels(models.ListRels.Things, models.ThingRels.Owner)),
+		qm.Load(qm.Rels(models.ListRels.Things, models.ThingRels.ImagesThings, models.ImagesThingRels.Image)),
+		qm.Load(models.ListRels.Owner),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	listsById := make(map[string]*models.List)
+	for _, list := range lists {
+		listsById[list.ID] = list
+	}
+	for _, hit := range listHits {
+		result.Lists = append(result.Lists, ListSearchHit{
+			List:    listsById[hit.Id],
+			Rank:    hit.Rank,
+			Snippet: operations.SnippetToHTML(hit.Snippet),
+		})
+	}
+
+	result.ThingCount = uint64(thingCount)
+	result.ListCount = uint64(listCount)
+	result.ThingPageCount = uint64(math.Ceil(float64(thingCount) / float64(params.PerPage)))
+	result.ListPageCount = uint64(math.Ceil(float64(listCount) / float64(params.PerPage)))
 	return result, nil
 }
@@ -0,0 +1,120 @@
+package services_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTsQuery(t *testing.T) {
+	assert.Equal(t, "cordless:* & drill:*", operations.SearchTsQuery("cordless drill"))
+	assert.Equal(t, "a:* & b:*", operations.SearchTsQuery("a & !b:*"))
+	assert.Equal(t, "", operations.SearchTsQuery(" '&| "))
+	assert.Equal(t, "a &lt;b&gt; <mark>c</mark>", operations.SnippetToHTML("a <b> c"))
+}
+
+func TestSearch(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	is, err := services.NewTmpImageService(db)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		os.Remove(is.StorePath())
+	})
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	thingService := services.NewThingService(db, is, notificationService)
+	listService := services.NewListService(db, notificationService)
+	searchService := services.NewSearchService(db, thingService, listService)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	createFriendShip(t, db, alice.ID, bob.ID)
+
+	drillParams := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+	drillParams.OwnerId = alice.ID
+	drillParams.Name = "Cordless Drill"
+	drillParams.Description = "18V with two batteries"
+	drillParams.PrivateNote = "borrowed from the neighbours"
+	drillParams.SharingState = "friends"
+	drillParams.Properties = []operations.CreatePropertyParams{
+		operations.CreatePropertyStringParams{Name: "Color", Value: "Turquoise"},
+	}
+	drill, err := thingService.CreateThing(context.Background(), *drillParams)
+	assert.NoError(t, err)
+
+	sawParams := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+	sawParams.OwnerId = alice.ID
+	sawParams.Name = "Saw"
+	sawParams.Description = "Fits the drill stand"
+	sawParams.PrivateNote = ""
+	sawParams.SharingState = "private"
+	sawParams.Properties = []operations.CreatePropertyParams{}
+	saw, err := thingService.CreateThing(context.Background(), *sawParams)
+	assert.NoError(t, err)
+
+	_, err = listService.CreateList(context.Background(), services.CreateListParams{
+		Name:         "Drills and bits",
+		OwnerId:      alice.ID,
+		ThingIds:     []string{drill.ID},
+		SharingState: "private",
+	})
+	assert.NoError(t, err)
+
+	params := &services.SearchParams{Query: "dri", PerPage: 50}
+	result, err := searchService.Search(context.Background(), alice.ID, params)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), result.ThingCount)
+	// a match in the name ranks higher than one in the description
+	assert.Equal(t, drill.ID, result.Things[0].Thing.ID)
+	assert.Equal(t, saw.ID, result.Things[1].Thing.ID)
+	assert.Contains(t, result.Things[1].Snippet, "<mark>drill</mark>")
+	assert.Equal(t, uint64(1), result.ListCount)
+
+	// pagination
+	result, err = searchService.Search(context.Background(), alice.ID, &services.SearchParams{Query: "dri", PerPage: 1, Page: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), result.ThingPageCount)
+	assert.Len(t, result.Things, 1)
+	assert.Equal(t, saw.ID, result.Things[0].Thing.ID)
+
+	// string properties
+	result, err = searchService.Search(context.Background(), bob.ID, &services.SearchParams{Query: "turquoise", PerPage: 50})
+	assert.NoError(t, err)
+	assert.Len(t, result.Things, 1)
+
+	// the private note is only searchable by the owner and only shared things
+	// or lists are found
+	result, err = searchService.Search(context.Background(), alice.ID, &services.SearchParams{Query: "neighbours", PerPage: 50})
+	assert.NoError(t, err)
+	assert.Len(t, result.Things, 1)
+	result, err = searchService.Search(context.Background(), bob.ID, &services.SearchParams{Query: "neighbours", PerPage: 50})
+	assert.NoError(t, err)
+	assert.Empty(t, result.Things)
+	result, err = searchService.Search(context.Background(), bob.ID, params)
+	assert.NoError(t, err)
+	assert.Len(t, result.Things, 1)
+	assert.Empty(t, result.Lists)
+}
@@ -61,6 +61,10 @@ func (ss *ShareService) CreateThingShare(ctx context.Context, params CreateThing
 		if err != nil {
 			return err
 		}
+		err = operations.EnqueueShareWebhookEvent(ctx, tx, share, &thing.ID, nil)
+		if err != nil {
+			return err
+		}
 		outerShare = share
 		return nil
 	})
@@ -114,6 +118,10 @@ func (ss *ShareService) CreateListShare(ctx context.Context, params CreateListSh
 		if err != nil {
 			return err
 		}
+		err = operations.EnqueueShareWebhookEvent(ctx, tx, share, nil, &list.ID)
+		if err != nil {
+			return err
+		}
 		outerShare = share
 		return nil
 	})
@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"math"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/rs/zerolog/log"
 	"github.com/stashsphere/backend/models"
 	"github.com/stashsphere/backend/operations"
 	"github.com/stashsphere/backend/utils"
@@ -35,6 +37,8 @@ type CreateThingParams struct {
 	Quantity     uint64
 	QuantityUnit string
 	SharingState string
+	// things below this quantity are reported as low on stock, nil disables it
+	LowStockThreshold *uint64
 }
 
 func (ts *ThingService) CreateThing(ctx context.Context, params CreateThingParams) (*models.Thing, error) {
@@ -90,6 +94,9 @@ func (ts *ThingService) CreateThing(ctx context.Context, params CreateThingParam
 			QuantityUnit: params.QuantityUnit,
 			SharingState: sharingState,
 		}
+		if params.LowStockThreshold != nil {
+			thing.LowStockThreshold = null.Int64From(int64(*params.LowStockThreshold))
+		}
 
 		err = thing.Insert(ctx, tx, boil.Infer())
 		if err != nil {
@@ -103,11 +110,10 @@ func (ts *ThingService) CreateThing(ctx context.Context, params CreateThingParam
 			}
 		}
 
-		quantityID, err := gonanoid.New()
-		if err != nil {
-			return err
-		}
-		err = thing.AddQuantityEntries(ctx, tx, true, &models.QuantityEntry{DeltaValue: int64(params.Quantity), ID: quantityID})
+		_, err = operations.AddQuantityEntry(ctx, tx, thing, operations.AddQuantityEntryParams{
+			Delta:       int64(params.Quantity),
+			CreatedById: params.OwnerId,
+		})
 		if err != nil {
 			return err
 		}
@@ -129,6 +135,10 @@ func (ts *ThingService) CreateThing(ctx context.Context, params CreateThingParam
 		if err != nil {
 			return err
 		}
+		err = operations.EnqueueThingWebhookEvent(ctx, tx, operations.WebhookEventThingCreated, thing)
+		if err != nil {
+			return err
+		}
 		outerThing = thing
 		return nil
 	})
@@ -147,22 +157,32 @@ func (ts *ThingService) CreateThing(ctx context.Context, params CreateThingParam
 }
 
 func (ts *ThingService) GetThing(ctx context.Context, thingId string, userId string) (*models.Thing, error) {
-	return operations.GetThingChecked(ctx, ts.db, thingId, userId)
+	thing, err := operations.GetThingChecked(ctx, ts.db, thingId, userId)
+	if err != nil {
+		return nil, err
+	}
+	err = operations.LoadLocationPaths(ctx, ts.db, userId, thing)
+	if err != nil {
+		return nil, err
+	}
+	return thing, nil
 }
 
 type UpdateThingParams struct {
-	Name         string
-	Description  string
-	PrivateNote  string
-	Properties   []operations.CreatePropertyParams
-	ImagesIds    []string
-	Quantity     uint64
-	QuantityUnit string
-	SharingState string
+	Name              string
+	Description       string
+	PrivateNote       string
+	Properties        []operations.CreatePropertyParams
+	ImagesIds         []string
+	Quantity          uint64
+	QuantityUnit      string
+	SharingState      string
+	LowStockThreshold *uint64
 }
 
 func (ts *ThingService) EditThing(ctx context.Context, thingId string, userId string, params UpdateThingParams) (*models.Thing, error) {
 	var outerThing *models.Thing
+	var lowStock *LowStockParams
 	targetUsersIds := []string{}
 	err := utils.Tx(ctx, ts.db, func(tx *sql.Tx) error {
 		thing, err := models.Things(
@@ -218,6 +238,10 @@ func (ts *ThingService) EditThing(ctx context.Context, thingId string, userId st
 		thing.Description = params.Description
 		thing.QuantityUnit = params.QuantityUnit
 		thing.SharingState = sharingState
+		thing.LowStockThreshold = null.Int64{}
+		if params.LowStockThreshold != nil {
+			thing.LowStockThreshold = null.Int64From(int64(*params.LowStockThreshold))
+		}
 
 		_, err = thing.Update(ctx, tx, boil.Infer())
 		if err != nil {
@@ -246,16 +270,20 @@ func (ts *ThingService) EditThing(ctx context.Context, thingId string, userId st
 			}
 		}
 
-		quantityID, err := gonanoid.New()
-		if err != nil {
-			return err
-		}
-		err = thing.AddQuantityEntries(ctx, tx, true, &models.QuantityEntry{
-			DeltaValue: operations.DeltaQuantity(thing, params.Quantity),
-			ID:         quantityID,
-		})
-		if err != nil {
-			return err
+		// only log actual changes, editing other fields keeps the quantity log clean
+		previousQuantity := operations.SumQuantity(thing)
+		delta := operations.DeltaQuantity(thing, params.Quantity)
+		if delta != 0 {
+			reason := models.QuantityReasonCorrection
+			_, err = operations.AddQuantityEntry(ctx, tx, thing, operations.AddQuantityEntryParams{
+				Delta:       delta,
+				Reason:      &reason,
+				CreatedById: userId,
+			})
+			if err != nil {
+				return err
+			}
+			lowStock = lowStockParams(thing, previousQuantity)
 		}
 
 		_, err = thing.R.ImagesThings.DeleteAll(ctx, tx)
@@ -280,6 +308,10 @@ func (ts *ThingService) EditThing(ctx context.Context, thingId string, userId st
 		if err != nil {
 			return err
 		}
+		err = operations.EnqueueThingWebhookEvent(ctx, tx, operations.WebhookEventThingUpdated, thing)
+		if err != nil {
+			return err
+		}
 		outerThing = thing
 		return nil
 	})
@@ -293,6 +325,7 @@ func (ts *ThingService) EditThing(ctx context.Context, thingId string, userId st
 			TargetUserId: targetUserId,
 		})
 	}
+	ts.notifyLowStock(ctx, lowStock)
 	return ts.GetThing(ctx, thingId, outerThing.OwnerID)
 }
 
@@ -356,22 +389,27 @@ type GetThingsForUserParams struct {
 	Paginate       bool
 	FilterOwnerIds []string
 	SearchTerm     string
+	PropertyFilter string
+	Sort           operations.SortParams
+	// nil uses offset pagination, an empty cursor requests the first page in
+	// cursor mode
+	Cursor *string
 }
 
-func (ts *ThingService) GetThingsForUser(ctx context.Context, params GetThingsForUserParams) (uint64, uint64, models.ThingSlice, error) {
+func (ts *ThingService) GetThingsForUser(ctx context.Context, params GetThingsForUserParams) (PageInfo, models.ThingSlice, error) {
 	userId, perPage, page, paginate, filterUserIds, searchTerm := params.UserId, params.PerPage, params.Page, params.Paginate, params.FilterOwnerIds, params.SearchTerm
 
 	tx, err := ts.db.BeginTx(ctx, &sql.TxOptions{
 		ReadOnly: true,
 	})
 	if err != nil {
-		return 0, 0, nil, err
+		return PageInfo{}, nil, err
 	}
 	defer tx.Rollback()
 
 	sharedThingIds, err := operations.GetSharedThingIdsForUser(ctx, tx, userId)
 	if err != nil {
-		return 0, 0, nil, err
+		return PageInfo{}, nil, err
 	}
 	interfaceIds := make([]interface{}, len(sharedThingIds))
 	for i, s := range sharedThingIds {
@@ -396,19 +434,38 @@ func (ts *ThingService) GetThingsForUser(ctx context.Context, params GetThingsFo
 		searchCond = qm.Expr(searchCond, qm.And("name ILIKE ?", likeNameExpr))
 	}
 
-	thingCount, err := models.Things(searchCond).Count(ctx, tx)
+	if len(params.PropertyFilter) > 0 {
+		propertyFilter, err := operations.ParsePropertyFilter(params.PropertyFilter)
+		if err != nil {
+			return PageInfo{}, nil, utils.ParameterError{Err: err}
+		}
+		searchCond = qm.Expr(searchCond, operations.PropertyFilterQueryMod(propertyFilter))
+	}
+
+	sortOrder, err := operations.ThingSortOrder(params.Sort)
 	if err != nil {
-		return 0, 0, models.ThingSlice{}, err
+		return PageInfo{}, nil, utils.ParameterError{Err: err}
 	}
 
 	// empty expr for no pagination
 	thingQuery := []qm.QueryMod{}
-	if paginate {
-		thingQuery = append(thingQuery, qm.Offset(int(perPage*page)), qm.Limit(int(perPage)))
+	var thingCount int64
+	if params.Cursor != nil {
+		cursorMods, err := cursorQueryMods(sortOrder, *params.Cursor, perPage)
+		if err != nil {
+			return PageInfo{}, nil, err
+		}
+		thingQuery = append(thingQuery, cursorMods...)
+	} else {
+		thingCount, err = models.Things(searchCond).Count(ctx, tx)
+		if err != nil {
+			return PageInfo{}, models.ThingSlice{}, err
+		}
+		if paginate {
+			thingQuery = append(thingQuery, qm.Offset(int(perPage*page)), qm.Limit(int(perPage)))
+		}
 	}
 
-	sortCond := qm.OrderBy(models.ThingColumns.CreatedAt)
-
 	thingQuery = append(thingQuery,
 		qm.Load(models.ThingRels.Properties),
 		qm.Load(models.ThingRels.QuantityEntries),
@@ -418,15 +475,173 @@ func (ts *ThingService) GetThingsForUser(ctx context.Context, params GetThingsFo
 		qm.Load(qm.Rels(models.ThingRels.Shares, models.ShareRels.TargetUser)),
 		qm.Load(qm.Rels(models.ThingRels.ImagesThings, models.ImagesThingRels.Image)),
 		searchCond,
-		sortCond,
+		sortOrder.QueryMod(),
 	)
 
 	things, err := models.Things(thingQuery...).All(ctx, tx)
 	if err != nil {
-		return 0, 0, models.ThingSlice{}, err
+		return PageInfo{}, models.ThingSlice{}, err
 	}
-	totalPages := uint64(math.Ceil(float64(thingCount) / float64(perPage)))
-	return uint64(thingCount), totalPages, things, nil
+	pageInfo := offsetPageInfo(thingCount, perPage)
+	if params.Cursor != nil {
+		ids := make([]string, len(things))
+		for i, thing := range things {
+			ids[i] = thing.ID
+		}
+		var n int
+		n, pageInfo, err = cursorPageInfo(ctx, tx, sortOrder, perPage, ids)
+		if err != nil {
+			return PageInfo{}, models.ThingSlice{}, err
+		}
+		things = things[:n]
+	}
+	err = operations.LoadLocationPaths(ctx, tx, userId, things...)
+	if err != nil {
+		return PageInfo{}, models.ThingSlice{}, err
+	}
+	return pageInfo, things, nil
+}
+
+type ChangeQuantityParams struct {
+	ThingId string
+	UserId  string
+	Delta   int64
+	Reason  *string
+	Note    string
+}
+
+// ChangeQuantity records a signed quantity change. Only the owner may change
+// the quantity, users the thing is shared with can only see it.
+func (ts *ThingService) ChangeQuantity(ctx context.Context, params ChangeQuantityParams) (*models.QuantityEntry, error) {
+	var outerEntry *models.QuantityEntry
+	var lowStock *LowStockParams
+	err := utils.Tx(ctx, ts.db, func(tx *sql.Tx) error {
+		thing, err := operations.GetThingChecked(ctx, tx, params.ThingId, params.UserId)
+		if err != nil {
+			return err
+		}
+		// users the thing is shared with can only read it
+		if thing.OwnerID != params.UserId {
+			return utils.UserHasNoAccessRightsError{}
+		}
+		previousQuantity := operations.SumQuantity(thing)
+		if previousQuantity+params.Delta < 0 {
+			return utils.StashSphereValidationError{Errors: map[string]string{
+				"delta": "quantity must not become negative",
+			}}
+		}
+		var reason *models.QuantityReason
+		if params.Reason != nil {
+			r := models.QuantityReason(*params.Reason)
+			if err := r.IsValid(); err != nil {
+				return utils.ParameterError{Err: err}
+			}
+			reason = &r
+		}
+		entry, err := operations.AddQuantityEntry(ctx, tx, thing, operations.AddQuantityEntryParams{
+			Delta:       params.Delta,
+			Reason:      reason,
+			Note:        params.Note,
+			CreatedById: params.UserId,
+		})
+		if err != nil {
+			return err
+		}
+		err = operations.EnqueueThingWebhookEvent(ctx, tx, operations.WebhookEventThingUpdated, thing)
+		if err != nil {
+			return err
+		}
+		outerEntry = entry
+		lowStock = lowStockParams(thing, previousQuantity)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ts.notifyLowStock(ctx, lowStock)
+	err = outerEntry.Reload(ctx, ts.db)
+	if err != nil {
+		return nil, err
+	}
+	err = outerEntry.L.LoadCreatedBy(ctx, ts.db, true, outerEntry, nil)
+	if err != nil {
+		return nil, err
+	}
+	return outerEntry, nil
+}
+
+// lowStockParams returns the notification parameters if the quantity of the
+// thing just dropped below its threshold. Staying below it does not notify again.
+func lowStockParams(thing *models.Thing, previousQuantity int64) *LowStockParams {
+	quantity := operations.SumQuantity(thing)
+	if !operations.BelowLowStockThreshold(thing, quantity) || operations.BelowLowStockThreshold(thing, previousQuantity) {
+		return nil
+	}
+	return &LowStockParams{
+		ThingId:      thing.ID,
+		ThingName:    thing.Name,
+		OwnerId:      thing.OwnerID,
+		Quantity:     quantity,
+		QuantityUnit: thing.QuantityUnit,
+		Threshold:    thing.LowStockThreshold.Int64,
+	}
+}
+
+func (ts *ThingService) notifyLowStock(ctx context.Context, params *LowStockParams) {
+	if params == nil {
+		return
+	}
+	err := ts.ns.LowStock(ctx, *params)
+	if err != nil {
+		log.Error().Msgf("Could not create notification: %v", err)
+	}
+}
+
+// GetLowStockThings returns the things of the user that need restocking
+func (ts *ThingService) GetLowStockThings(ctx context.Context, userId string) (models.ThingSlice, error) {
+	things, err := operations.GetLowStockThings(ctx, ts.db, userId,
+		qm.Load(models.ThingRels.Properties),
+		qm.Load(models.ThingRels.QuantityEntries),
+		qm.Load(qm.Rels(models.ThingRels.Lists, models.ListRels.Owner)),
+		qm.Load(models.ThingRels.Owner),
+		qm.Load(qm.Rels(models.ThingRels.Shares, models.ShareRels.Owner)),
+		qm.Load(qm.Rels(models.ThingRels.Shares, models.ShareRels.TargetUser)),
+		qm.Load(qm.Rels(models.ThingRels.ImagesThings, models.ImagesThingRels.Image)),
+		qm.OrderBy(models.ThingColumns.Name),
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = operations.LoadLocationPaths(ctx, ts.db, userId, things...)
+	if err != nil {
+		return nil, err
+	}
+	return things, nil
+}
+
+type GetQuantityEntriesParams struct {
+	ThingId string
+	UserId  string
+	PerPage uint64
+	Page    uint64
+}
+
+func (ts *ThingService) GetQuantityEntries(ctx context.Context, params GetQuantityEntriesParams) (uint64, uint64, models.QuantityEntrySlice, error) {
+	_, err := operations.GetThingChecked(ctx, ts.db, params.ThingId, params.UserId)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	count, err := operations.CountQuantityEntries(ctx, ts.db, params.ThingId)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	entries, err := operations.GetQuantityEntries(ctx, ts.db, params.ThingId,
+		qm.Offset(int(params.PerPage*params.Page)), qm.Limit(int(params.PerPage)))
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	totalPages := uint64(math.Ceil(float64(count) / float64(params.PerPage)))
+	return uint64(count), totalPages, entries, nil
 }
 
 func (ts *ThingService) DeleteThing(ctx context.Context, thingId string, userId string) error {
@@ -442,6 +657,10 @@ func (ts *ThingService) DeleteThing(ctx context.Context, thingId string, userId
 			return utils.EntityDoesNotBelongToUserError{}
 		}
 
+		err = operations.EnqueueThingWebhookEvent(ctx, tx, operations.WebhookEventThingDeleted, thing)
+		if err != nil {
+			return err
+		}
 		return operations.DeleteThing(ctx, tx, thing)
 	})
 	return err
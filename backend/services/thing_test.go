@@ -8,6 +8,7 @@ import (
 
 	"github.com/stashsphere/backend/factories"
 	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/notifications"
 	"github.com/stashsphere/backend/operations"
 	"github.com/stashsphere/backend/services"
 	testcommon "github.com/stashsphere/backend/test_common"
@@ -187,6 +188,192 @@ func TestThingQuantity(t *testing.T) {
 	assert.Equal(t, operations.SumQuantity(updatedThing), int64(1337))
 }
 
+func TestThingQuantityChanges(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	is, err := services.NewTmpImageService(db)
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		os.Remove(is.StorePath())
+	})
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	thingService := services.NewThingService(db, is, notificationService)
+	shareService := services.NewShareService(db, notificationService)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.Nil(t, err)
+
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.Nil(t, err)
+
+	thingParams := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+	thingParams.OwnerId = alice.ID
+	thingParams.Quantity = 10
+	thing, err := thingService.CreateThing(context.Background(), *thingParams)
+	assert.Nil(t, err)
+
+	_, err = thingService.ChangeQuantity(context.Background(), services.ChangeQuantityParams{
+		ThingId: thing.ID,
+		UserId:  bob.ID,
+		Delta:   -1,
+	})
+	assert.ErrorIs(t, err, utils.UserHasNoAccessRightsError{})
+
+	_, err = shareService.CreateThingShare(context.Background(), services.CreateThingShareParams{
+		ThingId:      thing.ID,
+		OwnerId:      alice.ID,
+		TargetUserId: bob.ID,
+	})
+	assert.Nil(t, err)
+
+	// read access does not allow changing the quantity
+	_, err = thingService.ChangeQuantity(context.Background(), services.ChangeQuantityParams{
+		ThingId: thing.ID,
+		UserId:  bob.ID,
+		Delta:   -1,
+	})
+	assert.ErrorIs(t, err, utils.UserHasNoAccessRightsError{})
+
+	consumed := "consumed"
+	entry, err := thingService.ChangeQuantity(context.Background(), services.ChangeQuantityParams{
+		ThingId: thing.ID,
+		UserId:  alice.ID,
+		Delta:   -3,
+		Reason:  &consumed,
+		Note:    "birthday party",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-3), entry.DeltaValue)
+	assert.Equal(t, models.QuantityReasonConsumed, entry.Reason.Val)
+	assert.Equal(t, alice.ID, entry.R.CreatedBy.ID)
+
+	_, err = thingService.ChangeQuantity(context.Background(), services.ChangeQuantityParams{
+		ThingId: thing.ID,
+		UserId:  alice.ID,
+		Delta:   -8,
+	})
+	assert.Error(t, err, "quantity must not become negative")
+
+	// editing without changing the quantity does not add an entry
+	_, err = thingService.EditThing(context.Background(), thing.ID, alice.ID, services.UpdateThingParams{
+		Name: thing.Name, Quantity: 7, QuantityUnit: "pcs",
+	})
+	assert.Nil(t, err)
+
+	totalCount, totalPages, entries, err := thingService.GetQuantityEntries(context.Background(), services.GetQuantityEntriesParams{
+		ThingId: thing.ID,
+		UserId:  bob.ID,
+		PerPage: 1,
+		Page:    0,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), totalCount)
+	assert.Equal(t, uint64(2), totalPages)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "birthday party", entries[0].Note)
+	assert.Equal(t, alice.ID, entries[0].R.CreatedBy.ID)
+
+	updatedThing, err := thingService.GetThing(context.Background(), thing.ID, alice.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), operations.SumQuantity(updatedThing))
+}
+
+func TestThingLowStock(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	is, err := services.NewTmpImageService(db)
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		os.Remove(is.StorePath())
+	})
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	thingService := services.NewThingService(db, is, notificationService)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.Nil(t, err)
+
+	threshold := uint64(5)
+	thingParams := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+	thingParams.OwnerId = alice.ID
+	thingParams.Quantity = 6
+	thingParams.LowStockThreshold = &threshold
+	thing, err := thingService.CreateThing(context.Background(), *thingParams)
+	assert.Nil(t, err)
+
+	lowStock, err := thingService.GetLowStockThings(context.Background(), alice.ID)
+	assert.Nil(t, err)
+	assert.Empty(t, lowStock)
+
+	emailService.Clear()
+	_, err = thingService.ChangeQuantity(context.Background(), services.ChangeQuantityParams{
+		ThingId: thing.ID,
+		UserId:  alice.ID,
+		Delta:   -2,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, emailService.Mails, 1)
+	assert.Equal(t, alice.Email, emailService.Mails[0].To)
+
+	// staying below the threshold does not notify again
+	_, err = thingService.ChangeQuantity(context.Background(), services.ChangeQuantityParams{
+		ThingId: thing.ID,
+		UserId:  alice.ID,
+		Delta:   -1,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, emailService.Mails, 1)
+
+	_, aliceNotifications, err := notificationService.GetNotifications(context.Background(), services.GetNotificationsForUserParams{
+		UserId:   alice.ID,
+		Paginate: false,
+		PerPage:  50,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, aliceNotifications, 1)
+	assert.Equal(t, notifications.NotifyLowStock, aliceNotifications[0].ContentType)
+
+	lowStock, err = thingService.GetLowStockThings(context.Background(), alice.ID)
+	assert.Nil(t, err)
+	assert.Len(t, lowStock, 1)
+	assert.Equal(t, thing.ID, lowStock[0].ID)
+
+	// restocking removes it from the low-stock view
+	_, err = thingService.ChangeQuantity(context.Background(), services.ChangeQuantityParams{
+		ThingId: thing.ID,
+		UserId:  alice.ID,
+		Delta:   10,
+	})
+	assert.Nil(t, err)
+	lowStock, err = thingService.GetLowStockThings(context.Background(), alice.ID)
+	assert.Nil(t, err)
+	assert.Empty(t, lowStock)
+}
+
 func createFriendShip(t *testing.T, db *sql.DB, userId1 string, userId2 string) {
 	emailService := services.TestEmailService{}
 	notificationService := services.NewNotificationService(db, services.NotificationData{
@@ -549,7 +736,7 @@ func TestGetThingsForUserSearchTerm(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Search for "Red" - should return 2 things
-	_, _, things, err := thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
+	_, things, err := thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
 		UserId:     alice.ID,
 		SearchTerm: "Red",
 	})
@@ -557,7 +744,7 @@ func TestGetThingsForUserSearchTerm(t *testing.T) {
 	assert.Len(t, things, 2, "should find 2 things starting with 'Red'")
 
 	// Search for "Blue" - should return 1 thing
-	_, _, things, err = thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
+	_, things, err = thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
 		UserId:     alice.ID,
 		SearchTerm: "Blue",
 	})
@@ -565,7 +752,7 @@ func TestGetThingsForUserSearchTerm(t *testing.T) {
 	assert.Len(t, things, 1, "should find 1 thing starting with 'Blue'")
 
 	// Search for "Green" - should return 0 things
-	_, _, things, err = thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
+	_, things, err = thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
 		UserId:     alice.ID,
 		SearchTerm: "Green",
 	})
@@ -573,7 +760,7 @@ func TestGetThingsForUserSearchTerm(t *testing.T) {
 	assert.Len(t, things, 0, "should find 0 things starting with 'Green'")
 
 	// Empty search term - should return all 3 things
-	_, _, things, err = thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
+	_, things, err = thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
 		UserId:     alice.ID,
 		SearchTerm: "",
 	})
@@ -581,10 +768,181 @@ func TestGetThingsForUserSearchTerm(t *testing.T) {
 	assert.Len(t, things, 3, "empty search term should return all things")
 
 	// Case insensitive search - "red" should also match
-	_, _, things, err = thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
+	_, things, err = thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
 		UserId:     alice.ID,
 		SearchTerm: "red",
 	})
 	assert.NoError(t, err)
 	assert.Len(t, things, 2, "search should be case insensitive")
 }
+
+func TestGetThingsForUserSort(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	is, err := services.NewTmpImageService(db)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		os.Remove(is.StorePath())
+	})
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	thingService := services.NewThingService(db, is, notificationService)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+
+	kg := "kg"
+	g := "g"
+	createThing := func(name string, quantity uint64, properties []operations.CreatePropertyParams) *models.Thing {
+		params := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+		params.OwnerId = alice.ID
+		params.Name = name
+		params.Quantity = quantity
+		params.Properties = properties
+		thing, err := thingService.CreateThing(context.Background(), *params)
+		assert.NoError(t, err)
+		return thing
+	}
+	brick := createThing("brick", 1, []operations.CreatePropertyParams{
+		operations.CreatePropertyFloatParams{Name: "Weight", Value: 2, Unit: &kg},
+	})
+	anvil := createThing("Anvil", 3, []operations.CreatePropertyParams{
+		operations.CreatePropertyFloatParams{Name: "Weight", Value: 50, Unit: &kg},
+	})
+	cable := createThing("cable", 2, []operations.CreatePropertyParams{
+		operations.CreatePropertyFloatParams{Name: "Weight", Value: 300, Unit: &g},
+	})
+	dust := createThing("Dust", 0, []operations.CreatePropertyParams{})
+
+	sortedIds := func(sort operations.SortParams, page uint64) []string {
+		_, things, err := thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
+			UserId:   alice.ID,
+			Sort:     sort,
+			Paginate: true,
+			PerPage:  2,
+			Page:     page,
+		})
+		assert.NoError(t, err)
+		ids := []string{}
+		for _, thing := range things {
+			ids = append(ids, thing.ID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{anvil.ID, brick.ID}, sortedIds(operations.SortParams{Sort: operations.SortByName}, 0))
+	assert.Equal(t, []string{cable.ID, dust.ID}, sortedIds(operations.SortParams{Sort: operations.SortByName}, 1))
+	assert.Equal(t, []string{anvil.ID, cable.ID}, sortedIds(operations.SortParams{Sort: operations.SortByQuantity, Order: operations.SortOrderDesc}, 0))
+	// units are converted and things without the property come last
+	assert.Equal(t, []string{cable.ID, brick.ID}, sortedIds(operations.SortParams{Sort: operations.SortByProperty, Property: "weight"}, 0))
+	assert.Equal(t, []string{anvil.ID, dust.ID}, sortedIds(operations.SortParams{Sort: operations.SortByProperty, Property: "weight"}, 1))
+	assert.Equal(t, []string{anvil.ID, brick.ID}, sortedIds(operations.SortParams{Sort: operations.SortByProperty, Property: "weight", Order: operations.SortOrderDesc}, 0))
+
+	_, _, err = thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
+		UserId: alice.ID,
+		Sort:   operations.SortParams{Sort: operations.SortByProperty},
+	})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+}
+
+func TestGetThingsForUserCursor(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	is, err := services.NewTmpImageService(db)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		os.Remove(is.StorePath())
+	})
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	thingService := services.NewThingService(db, is, notificationService)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+
+	expected := []string{}
+	for _, name := range []string{"a", "b", "b", "c", "d"} {
+		params := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+		params.OwnerId = alice.ID
+		params.Name = name
+		thing, err := thingService.CreateThing(context.Background(), *params)
+		assert.NoError(t, err)
+		expected = append(expected, thing.ID)
+	}
+
+	sort := operations.SortParams{Sort: operations.SortByName}
+	cursor := ""
+	ids := []string{}
+	pages := 0
+	for {
+		pageInfo, things, err := thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
+			UserId:   alice.ID,
+			Sort:     sort,
+			Paginate: true,
+			PerPage:  2,
+			Cursor:   &cursor,
+		})
+		assert.NoError(t, err)
+		pages++
+		for _, thing := range things {
+			ids = append(ids, thing.ID)
+		}
+		if pageInfo.NextCursor == nil {
+			break
+		}
+		cursor = *pageInfo.NextCursor
+		// a thing created between the pages sorts before the cursor and is skipped
+		if pages == 1 {
+			params := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+			params.OwnerId = alice.ID
+			params.Name = "0"
+			_, err := thingService.CreateThing(context.Background(), *params)
+			assert.NoError(t, err)
+		}
+	}
+	assert.Equal(t, 3, pages)
+	assert.ElementsMatch(t, expected[1:3], ids[1:3])
+	assert.Equal(t, expected[0], ids[0])
+	assert.Equal(t, expected[3:], ids[3:])
+
+	invalid := "invalid"
+	_, _, err = thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
+		UserId:   alice.ID,
+		Sort:     sort,
+		Paginate: true,
+		PerPage:  2,
+		Cursor:   &invalid,
+	})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+
+	// a cursor created for a different order is rejected
+	_, _, err = thingService.GetThingsForUser(context.Background(), services.GetThingsForUserParams{
+		UserId:   alice.ID,
+		Paginate: true,
+		PerPage:  2,
+		Cursor:   &cursor,
+	})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+}
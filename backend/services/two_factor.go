@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/skip2/go-qrcode"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+)
+
+// TwoFactorService manages the TOTP enrollment of users
+type TwoFactorService struct {
+	db *sql.DB
+	// shown as account issuer in authenticator apps
+	issuer string
+}
+
+func NewTwoFactorService(db *sql.DB, issuer string) *TwoFactorService {
+	return &TwoFactorService{db, issuer}
+}
+
+type TotpEnrollment struct {
+	Secret string
+	Uri    string
+	// PNG encoded QR code of the URI
+	QrCode []byte
+}
+
+// Enroll creates a new TOTP secret for the user, which has to be confirmed
+// with a code before it is required for logins
+func (ts *TwoFactorService) Enroll(ctx context.Context, userId string) (*TotpEnrollment, error) {
+	var enrollment *TotpEnrollment
+	err := utils.Tx(ctx, ts.db, func(tx *sql.Tx) error {
+		user, err := operations.LockUserForTwoFactor(ctx, tx, userId)
+		if err != nil {
+			return err
+		}
+		if user.TotpEnabledAt.Valid {
+			return utils.ParameterError{Err: errors.New("two-factor authentication is already enabled")}
+		}
+		secret, err := operations.GenerateTotpSecret()
+		if err != nil {
+			return err
+		}
+		user.TotpSecret = null.StringFrom(secret)
+		user.TotpLastStep = 0
+		_, err = user.Update(ctx, tx, boil.Whitelist(models.UserColumns.TotpSecret, models.UserColumns.TotpLastStep))
+		if err != nil {
+			return err
+		}
+		uri := operations.TotpUri(ts.issuer, user.Email, secret)
+		qrCode, err := qrcode.Encode(uri, qrcode.Medium, 256)
+		if err != nil {
+			return err
+		}
+		enrollment = &TotpEnrollment{
+			Secret: secret,
+			Uri:    uri,
+			QrCode: qrCode,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return enrollment, nil
+}
+
+// Confirm enables two-factor authentication if the code matches the enrolled
+// secret and returns the recovery codes
+func (ts *TwoFactorService) Confirm(ctx context.Context, userId string, code string) ([]string, error) {
+	var recoveryCodes []string
+	err := utils.Tx(ctx, ts.db, func(tx *sql.Tx) error {
+		user, err := operations.LockUserForTwoFactor(ctx, tx, userId)
+		if err != nil {
+			return err
+		}
+		if user.TotpEnabledAt.Valid {
+			return utils.ParameterError{Err: errors.New("two-factor authentication is already enabled")}
+		}
+		if !user.TotpSecret.Valid {
+			return utils.ParameterError{Err: errors.New("two-factor authentication has not been enrolled")}
+		}
+		ok, err := operations.VerifySecondFactor(ctx, tx, user, code, time.Now())
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return utils.InvalidTwoFactorCodeError{}
+		}
+		user.TotpEnabledAt = null.TimeFrom(time.Now())
+		_, err = user.Update(ctx, tx, boil.Whitelist(models.UserColumns.TotpEnabledAt))
+		if err != nil {
+			return err
+		}
+		recoveryCodes, err = operations.ReplaceRecoveryCodes(ctx, tx, user.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recoveryCodes, nil
+}
+
+// Disable turns off two-factor authentication after checking the password
+func (ts *TwoFactorService) Disable(ctx context.Context, userId string, password string) error {
+	return utils.Tx(ctx, ts.db, func(tx *sql.Tx) error {
+		user, err := operations.AuthenticateUserByID(ctx, tx, userId, password)
+		if err != nil {
+			return utils.ParameterError{Err: errors.New("Incorrect password.")}
+		}
+		user.TotpSecret = null.String{}
+		user.TotpEnabledAt = null.Time{}
+		user.TotpLastStep = 0
+		_, err = user.Update(ctx, tx, boil.Whitelist(
+			models.UserColumns.TotpSecret,
+			models.UserColumns.TotpEnabledAt,
+			models.UserColumns.TotpLastStep,
+		))
+		if err != nil {
+			return err
+		}
+		return operations.DeleteRecoveryCodes(ctx, tx, user.ID)
+	})
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes after checking the
+// password, the previous codes can not be used anymore
+func (ts *TwoFactorService) RegenerateRecoveryCodes(ctx context.Context, userId string, password string) ([]string, error) {
+	var recoveryCodes []string
+	err := utils.Tx(ctx, ts.db, func(tx *sql.Tx) error {
+		user, err := operations.AuthenticateUserByID(ctx, tx, userId, password)
+		if err != nil {
+			return utils.ParameterError{Err: errors.New("Incorrect password.")}
+		}
+		if !user.TotpEnabledAt.Valid {
+			return utils.ParameterError{Err: errors.New("two-factor authentication is not enabled")}
+		}
+		recoveryCodes, err = operations.ReplaceRecoveryCodes(ctx, tx, user.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recoveryCodes, nil
+}
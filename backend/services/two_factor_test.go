@@ -0,0 +1,84 @@
+package services_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwoFactorLogin(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	authService := services.NewAuthService(db, privateKey, publicKey, time.Hour, 24*time.Hour, "", false)
+	userService := services.NewUserService(db, false, "", 60, nil)
+	twoFactorService := services.NewTwoFactorService(db, "StashsphereTest")
+	client := operations.SessionClient{UserAgent: "Firefox", IPAddress: "192.0.2.1"}
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+
+	enrollment, err := twoFactorService.Enroll(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Contains(t, enrollment.Uri, enrollment.Secret)
+	assert.NotEmpty(t, enrollment.QrCode)
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(enrollment.Secret)
+	assert.NoError(t, err)
+	step := time.Now().Unix() / 30
+
+	// logins do not require a code before the enrollment is confirmed
+	_, _, _, _, _, err = authService.AuthorizeUser(context.Background(), alice.Email, aliceParams.Password, client)
+	assert.NoError(t, err)
+
+	_, err = twoFactorService.Confirm(context.Background(), alice.ID, "000000x")
+	assert.ErrorIs(t, err, utils.InvalidTwoFactorCodeError{})
+	recoveryCodes, err := twoFactorService.Confirm(context.Background(), alice.ID, operations.TotpCode(key, step))
+	assert.NoError(t, err)
+	assert.Len(t, recoveryCodes, operations.RecoveryCodeCount)
+
+	_, _, _, _, _, err = authService.AuthorizeUser(context.Background(), alice.Email, aliceParams.Password, client)
+	var twoFactorRequired utils.TwoFactorRequiredError
+	assert.ErrorAs(t, err, &twoFactorRequired)
+	challengeToken := twoFactorRequired.ChallengeToken
+
+	// the code used for the confirmation can not be used again
+	_, _, _, _, _, err = authService.CompleteTwoFactorLogin(context.Background(), challengeToken, operations.TotpCode(key, step), client)
+	assert.ErrorIs(t, err, utils.InvalidTwoFactorCodeError{})
+	_, _, _, _, _, err = authService.CompleteTwoFactorLogin(context.Background(), "invalid", operations.TotpCode(key, step+1), client)
+	assert.ErrorIs(t, err, utils.NotAuthenticatedError{})
+	user, accessToken, _, _, _, err := authService.CompleteTwoFactorLogin(context.Background(), challengeToken, operations.TotpCode(key, step+1), client)
+	assert.NoError(t, err)
+	assert.Equal(t, alice.ID, user.ID)
+	assert.NotEmpty(t, accessToken)
+
+	// recovery codes work once
+	_, _, _, _, _, err = authService.CompleteTwoFactorLogin(context.Background(), challengeToken, recoveryCodes[0], client)
+	assert.NoError(t, err)
+	_, _, _, _, _, err = authService.CompleteTwoFactorLogin(context.Background(), challengeToken, recoveryCodes[0], client)
+	assert.ErrorIs(t, err, utils.InvalidTwoFactorCodeError{})
+
+	err = twoFactorService.Disable(context.Background(), alice.ID, "wrong password")
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+	err = twoFactorService.Disable(context.Background(), alice.ID, aliceParams.Password)
+	assert.NoError(t, err)
+	_, _, _, _, _, err = authService.AuthorizeUser(context.Background(), alice.Email, aliceParams.Password, client)
+	assert.NoError(t, err)
+	_, _, _, _, _, err = authService.CompleteTwoFactorLogin(context.Background(), challengeToken, recoveryCodes[1], client)
+	assert.ErrorIs(t, err, utils.NotAuthenticatedError{})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/aarondl/null/v8"
@@ -16,6 +17,9 @@ import (
 	"github.com/stashsphere/backend/utils"
 )
 
+// time to use the link of a password reset email
+const passwordResetTokenLifetime = time.Hour
+
 type UserService struct {
 	db                  *sql.DB
 	inviteCode          string
@@ -39,11 +43,15 @@ type CreateUserParams struct {
 	Email                     string
 	Password                  string
 	InviteCode                string
+	// language of the emails sent to the user, defaults to English
+	Locale                    string
 	SendEmailVerification     bool
 }
 
 func (us *UserService) CreateUser(ctx context.Context, params CreateUserParams) (*models.User, error) {
-	if us.inviteRequired && params.InviteCode != us.inviteCode {
+	// the code of the configuration is accepted without consuming an invite
+	useInvite := params.InviteCode != "" && params.InviteCode != us.inviteCode
+	if us.inviteRequired && params.InviteCode == "" {
 		return nil, utils.WrongInviteCodeError{}
 	}
 
@@ -57,18 +65,68 @@ func (us *UserService) CreateUser(ctx context.Context, params CreateUserParams)
 		return nil, err
 	}
 
+	locale := utils.DefaultLocale
+	if params.Locale != "" {
+		if !utils.IsSupportedLocale(params.Locale) {
+			return nil, utils.ParameterError{Err: fmt.Errorf("unsupported locale %s", params.Locale)}
+		}
+		locale = params.Locale
+	}
+
 	user := models.User{
 		ID:           userID,
 		Name:         params.Name,
 		Email:        params.Email,
 		PasswordHash: string(passwordHash),
+		Locale:       locale,
 	}
 
-	err = user.Insert(ctx, us.db, boil.Infer())
+	var friendRequest *models.FriendRequest
+	err = utils.Tx(ctx, us.db, func(tx *sql.Tx) error {
+		var invite *models.Invite
+		if useInvite {
+			invite, err = operations.ConsumeInvite(ctx, tx, params.InviteCode, params.Email, time.Now())
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+			if err != nil {
+				// instances without invites accept registrations with stale or
+				// mistyped codes, they are just not connected to the inviter
+				if us.inviteRequired {
+					return utils.WrongInviteCodeError{}
+				}
+			} else {
+				user.InviteID = null.StringFrom(invite.ID)
+			}
+		}
+		err := user.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+		// users get to know the person who invited them
+		if invite != nil && invite.OwnerID.Valid {
+			friendRequest, err = operations.CreateFriendRequest(ctx, tx, invite.OwnerID.String, user.ID)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	if friendRequest != nil {
+		err = us.notificationService.CreateFriendRequest(ctx, CreateFriendRequestNotificationParams{
+			ReceiverId: friendRequest.ReceiverID,
+			SenderId:   friendRequest.SenderID,
+			RequestId:  friendRequest.ID,
+		})
+		if err != nil {
+			log.Error().Err(err).Str("userId", user.ID).Msg("Failed to notify about the friend request of the invite")
+		}
+	}
+
 	if params.SendEmailVerification {
 		if err := us.RequestEmailVerification(ctx, user.ID); err != nil {
 			log.Error().Err(err).Str("userId", user.ID).Msg("Failed to send verification email on registration")
@@ -88,6 +146,8 @@ type UpdateUserParams struct {
 	FullName    string
 	Information string
 	ImageId     *string
+	// nil keeps the current locale
+	Locale *string
 }
 
 func (us *UserService) UpdateUser(ctx context.Context, params UpdateUserParams) (*models.User, error) {
@@ -97,6 +157,12 @@ func (us *UserService) UpdateUser(ctx context.Context, params UpdateUserParams)
 			return err
 		}
 		user.Name = params.Name
+		if params.Locale != nil {
+			if !utils.IsSupportedLocale(*params.Locale) {
+				return utils.ParameterError{Err: fmt.Errorf("unsupported locale %s", *params.Locale)}
+			}
+			user.Locale = *params.Locale
+		}
 		_, err = user.Update(ctx, tx, boil.Infer())
 		if err != nil {
 			return err
@@ -155,6 +221,8 @@ type UpdatePasswordParams struct {
 	UserId      string
 	OldPassword string
 	NewPassword string
+	// session which stays logged in, all other sessions are revoked
+	SessionId string
 }
 
 func (us *UserService) UpdatePassword(ctx context.Context, params UpdatePasswordParams) error {
@@ -169,11 +237,71 @@ func (us *UserService) UpdatePassword(ctx context.Context, params UpdatePassword
 		}
 		user.PasswordHash = string(passwordHash)
 		_, err = user.Update(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+		_, err = operations.DeleteSessionsForUser(ctx, tx, user.ID, params.SessionId)
 		return err
 	})
 	return err
 }
 
+// RequestPasswordReset sends a link to reset the password to the email
+// address. Unknown addresses are ignored, so the response does not reveal
+// which addresses have an account.
+func (us *UserService) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := operations.FindUserByEmail(ctx, us.db, email)
+	if err != nil {
+		if errors.As(err, &utils.NotFoundError{}) {
+			return nil
+		}
+		return err
+	}
+
+	validUntil := time.Now().Add(passwordResetTokenLifetime)
+	token, err := operations.CreatePasswordResetToken(ctx, us.db, user.ID, validUntil)
+	if err != nil {
+		return err
+	}
+
+	return us.notificationService.PasswordReset(ctx, PasswordResetParams{
+		UserName:   user.Name,
+		UserEmail:  user.Email,
+		UserLocale: user.Locale,
+		Token:      token,
+	})
+}
+
+// ResetPassword sets a new password for the user the reset token was sent to
+// and logs out all sessions of the user
+func (us *UserService) ResetPassword(ctx context.Context, token string, newPassword string) error {
+	return utils.Tx(ctx, us.db, func(tx *sql.Tx) error {
+		userId, err := operations.ConsumePasswordResetToken(ctx, tx, token)
+		if err != nil {
+			return err
+		}
+		passwordHash, err := operations.HashPassword(newPassword)
+		if err != nil {
+			return err
+		}
+		user, err := operations.FindUserByID(ctx, tx, userId)
+		if err != nil {
+			return err
+		}
+		user.PasswordHash = string(passwordHash)
+		_, err = user.Update(ctx, tx, boil.Whitelist(models.UserColumns.PasswordHash))
+		if err != nil {
+			return err
+		}
+		_, err = operations.DeleteSessionsForUser(ctx, tx, user.ID, "")
+		if err != nil {
+			return err
+		}
+		_, err = operations.DeleteApiTokensForUser(ctx, tx, user.ID)
+		return err
+	})
+}
+
 func (us *UserService) GetAllUsers(ctx context.Context) (models.UserSlice, error) {
 	users, err := models.Users(qm.Load(models.UserRels.Profile),
 		qm.Load(qm.Rels(models.UserRels.Profile, models.ProfileRels.Image)),
@@ -201,16 +329,32 @@ func (us *UserService) ScheduleDeletion(ctx context.Context, userId string, pass
 
 	purgeAt := time.Now().UTC().Add(time.Duration(us.gracePeriodMinutes) * time.Minute)
 
-	user, err := operations.ScheduleUserDeletion(ctx, us.db, userId, purgeAt)
+	// the account can only be used to cancel the deletion after logging in
+	// again
+	var user *models.User
+	err = utils.Tx(ctx, us.db, func(tx *sql.Tx) error {
+		var err error
+		user, err = operations.ScheduleUserDeletion(ctx, tx, userId, purgeAt)
+		if err != nil {
+			return err
+		}
+		_, err = operations.DeleteSessionsForUser(ctx, tx, userId, "")
+		if err != nil {
+			return err
+		}
+		_, err = operations.DeleteApiTokensForUser(ctx, tx, userId)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	err = us.notificationService.AccountDeletionScheduled(ctx, AccountDeletionScheduledParams{
-		UserId:    userId,
-		UserName:  user.Name,
-		UserEmail: user.Email,
-		PurgeAt:   purgeAt,
+		UserId:     userId,
+		UserName:   user.Name,
+		UserEmail:  user.Email,
+		UserLocale: user.Locale,
+		PurgeAt:    purgeAt,
 	})
 	if err != nil {
 		return nil, err
@@ -251,9 +395,10 @@ func (us *UserService) RequestEmailVerification(ctx context.Context, userId stri
 	}
 
 	return us.notificationService.EmailVerification(ctx, EmailVerificationParams{
-		UserName:  user.Name,
-		UserEmail: user.Email,
-		DigitCode: code,
+		UserName:   user.Name,
+		UserEmail:  user.Email,
+		UserLocale: user.Locale,
+		DigitCode:  code,
 	})
 }
 
@@ -263,6 +408,107 @@ func (us *UserService) VerifyEmail(ctx context.Context, userId string, email str
 	})
 }
 
+// time to enter the code sent to a new email address
+const emailChangeCodeLifetime = 30 * time.Minute
+
+// RequestEmailChange sends a verification code to the new email address after
+// checking the password. The address is switched by ConfirmEmailChange.
+func (us *UserService) RequestEmailChange(ctx context.Context, userId string, password string, newEmail string) error {
+	var user *models.User
+	var code string
+	err := utils.Tx(ctx, us.db, func(tx *sql.Tx) error {
+		var err error
+		user, err = operations.AuthenticateUserByID(ctx, tx, userId, password)
+		if err != nil {
+			return utils.ParameterError{Err: errors.New("Incorrect password.")}
+		}
+		if newEmail == user.Email {
+			return utils.ParameterError{Err: errors.New("new email address is the current one")}
+		}
+		_, err = operations.FindUserByEmail(ctx, tx, newEmail)
+		if err == nil {
+			return utils.ParameterError{Err: errors.New("email address is already in use")}
+		}
+		if !errors.As(err, &utils.NotFoundError{}) {
+			return err
+		}
+		// codes of a previously requested address are not valid anymore
+		err = operations.DeleteEmailVerificationsExcept(ctx, tx, user.ID, user.Email)
+		if err != nil {
+			return err
+		}
+		code, err = operations.CreateVerificationCode(ctx, tx, user.ID, newEmail, time.Now().Add(emailChangeCodeLifetime))
+		if err != nil {
+			return err
+		}
+		user.PendingEmail = null.StringFrom(newEmail)
+		_, err = user.Update(ctx, tx, boil.Whitelist(models.UserColumns.PendingEmail))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return us.notificationService.EmailChangeVerification(ctx, EmailChangeVerificationParams{
+		UserName:   user.Name,
+		NewEmail:   newEmail,
+		UserLocale: user.Locale,
+		DigitCode:  code,
+	})
+}
+
+// ConfirmEmailChange switches to the pending email address if the code matches
+// and notifies the previous address
+func (us *UserService) ConfirmEmailChange(ctx context.Context, userId string, code string) (*models.User, error) {
+	var oldEmail string
+	var user *models.User
+	err := utils.Tx(ctx, us.db, func(tx *sql.Tx) error {
+		var err error
+		user, err = operations.FindUserByID(ctx, tx, userId)
+		if err != nil {
+			return err
+		}
+		if !user.PendingEmail.Valid {
+			return utils.ParameterError{Err: errors.New("no email change has been requested")}
+		}
+		newEmail := user.PendingEmail.String
+		err = operations.VerifyCode(ctx, tx, user.ID, newEmail, code)
+		if err != nil {
+			return err
+		}
+		_, err = operations.FindUserByEmail(ctx, tx, newEmail)
+		if err == nil {
+			return utils.ParameterError{Err: errors.New("email address is already in use")}
+		}
+		if !errors.As(err, &utils.NotFoundError{}) {
+			return err
+		}
+		oldEmail = user.Email
+		user.Email = newEmail
+		user.PendingEmail = null.String{}
+		_, err = user.Update(ctx, tx, boil.Whitelist(models.UserColumns.Email, models.UserColumns.PendingEmail))
+		if err != nil {
+			return err
+		}
+		return operations.DeleteEmailVerificationsExcept(ctx, tx, user.ID, newEmail)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = us.notificationService.EmailChanged(ctx, EmailChangedParams{
+		UserName:   user.Name,
+		OldEmail:   oldEmail,
+		NewEmail:   user.Email,
+		UserLocale: user.Locale,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("userId", user.ID).Msg("Failed to notify the previous email address of the change")
+	}
+
+	return us.FindUserByID(ctx, userId)
+}
+
 func (us *UserService) GetEmailVerificationStatus(ctx context.Context, userId string) (*models.EmailVerification, error) {
 	return operations.GetEmailVerificationStatus(ctx, us.db, userId)
 }
@@ -2,9 +2,13 @@ package services_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/models"
 	"github.com/stashsphere/backend/operations"
 	"github.com/stashsphere/backend/services"
 	testcommon "github.com/stashsphere/backend/test_common"
@@ -106,10 +110,25 @@ func TestScheduleDeletion(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, testUser)
 
+	_, err = operations.CreateSession(context.Background(), db, "session", testUser.ID, "refresh", operations.SessionClient{UserAgent: "Firefox"}, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	_, _, err = operations.CreateApiToken(context.Background(), db, "token", operations.CreateApiTokenParams{
+		OwnerId: testUser.ID,
+		Name:    "script",
+		Scopes:  []string{"read"},
+	})
+	assert.NoError(t, err)
+
 	updatedUser, err := userService.ScheduleDeletion(context.Background(), testUser.ID, testUserParams.Password)
 	assert.NoError(t, err)
 	assert.NotNil(t, updatedUser)
 	assert.True(t, updatedUser.PurgeAt.Valid, "PurgeAt should be set")
+	sessions, err := operations.GetSessionsForUser(context.Background(), db, testUser.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+	apiTokens, err := models.APITokens(models.APITokenWhere.OwnerID.EQ(testUser.ID)).Count(context.Background(), db)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), apiTokens)
 
 	assert.Len(t, emailService.Mails, 1)
 	assert.Equal(t, testUserParams.Email, emailService.Mails[0].To)
@@ -188,3 +207,134 @@ func TestCancelDeletionNotScheduled(t *testing.T) {
 	assert.Error(t, err)
 	assert.IsType(t, utils.NotFoundError{}, err)
 }
+
+func TestPasswordReset(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	userService := services.NewUserService(db, false, "", 60, notificationService)
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	authService := services.NewAuthService(db, privateKey, publicKey, time.Hour, 24*time.Hour, "", false)
+	client := operations.SessionClient{UserAgent: "Firefox", IPAddress: "192.0.2.1"}
+
+	testUserParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	testUser, err := userService.CreateUser(context.Background(), *testUserParams)
+	assert.NoError(t, err)
+	_, _, _, _, _, err = authService.AuthorizeUser(context.Background(), testUser.Email, testUserParams.Password, client)
+	assert.NoError(t, err)
+	_, _, err = operations.CreateApiToken(context.Background(), db, "token", operations.CreateApiTokenParams{
+		OwnerId: testUser.ID,
+		Name:    "script",
+		Scopes:  []string{"read"},
+	})
+	assert.NoError(t, err)
+
+	// unknown addresses are not revealed
+	err = userService.RequestPasswordReset(context.Background(), "nobody@example.com")
+	assert.NoError(t, err)
+	assert.Empty(t, emailService.Mails)
+
+	err = userService.RequestPasswordReset(context.Background(), testUser.Email)
+	assert.NoError(t, err)
+	assert.Len(t, emailService.Mails, 1)
+	assert.Equal(t, testUser.Email, emailService.Mails[0].To)
+	match := regexp.MustCompile(`/user/reset-password#(\S+)`).FindStringSubmatch(emailService.Mails[0].Body)
+	assert.Len(t, match, 2)
+	token := match[1]
+
+	err = userService.ResetPassword(context.Background(), "invalid", "newpassword")
+	assert.ErrorIs(t, err, utils.InvalidPasswordResetTokenError{})
+	err = userService.ResetPassword(context.Background(), token, "newpassword")
+	assert.NoError(t, err)
+
+	// the token can only be used once
+	err = userService.ResetPassword(context.Background(), token, "otherpassword")
+	assert.ErrorIs(t, err, utils.InvalidPasswordResetTokenError{})
+
+	sessions, err := authService.GetSessionsForUser(context.Background(), testUser.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+	apiTokens, err := models.APITokens(models.APITokenWhere.OwnerID.EQ(testUser.ID)).Count(context.Background(), db)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), apiTokens)
+	_, err = operations.AuthenticateUserByID(context.Background(), db, testUser.ID, "newpassword")
+	assert.NoError(t, err)
+
+	expiredToken, err := operations.CreatePasswordResetToken(context.Background(), db, testUser.ID, time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	err = userService.ResetPassword(context.Background(), expiredToken, "otherpassword")
+	assert.ErrorIs(t, err, utils.PasswordResetTokenExpiredError{})
+}
+
+func TestEmailChange(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	userService := services.NewUserService(db, false, "", 60, notificationService)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+	oldEmail := alice.Email
+	newEmail := "new-" + alice.Email
+
+	err = userService.RequestEmailChange(context.Background(), alice.ID, "wrong password", newEmail)
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+	err = userService.RequestEmailChange(context.Background(), alice.ID, aliceParams.Password, bob.Email)
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+	_, err = userService.ConfirmEmailChange(context.Background(), alice.ID, "12345678")
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+
+	err = userService.RequestEmailChange(context.Background(), alice.ID, aliceParams.Password, newEmail)
+	assert.NoError(t, err)
+	assert.Len(t, emailService.Mails, 1)
+	assert.Equal(t, newEmail, emailService.Mails[0].To)
+	match := regexp.MustCompile(`/user/change-email#(\d{8})`).FindStringSubmatch(emailService.Mails[0].Body)
+	assert.Len(t, match, 2)
+	code := match[1]
+
+	// the address is only switched after the confirmation
+	user, err := userService.FindUserByID(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, oldEmail, user.Email)
+	assert.Equal(t, newEmail, user.PendingEmail.String)
+
+	_, err = userService.ConfirmEmailChange(context.Background(), alice.ID, "00000000")
+	assert.ErrorIs(t, err, utils.InvalidVerificationCodeError{})
+	user, err = userService.ConfirmEmailChange(context.Background(), alice.ID, code)
+	assert.NoError(t, err)
+	assert.Equal(t, newEmail, user.Email)
+	assert.False(t, user.PendingEmail.Valid)
+
+	// the previous address is notified
+	assert.Len(t, emailService.Mails, 2)
+	assert.Equal(t, oldEmail, emailService.Mails[1].To)
+	assert.Contains(t, emailService.Mails[1].Body, newEmail)
+
+	verification, err := userService.GetEmailVerificationStatus(context.Background(), alice.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, newEmail, verification.Email)
+	assert.True(t, verification.VerifiedAt.Valid)
+}
@@ -0,0 +1,364 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/types"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/utils"
+)
+
+const (
+	// deliveries sent per ProcessDue call, the remaining ones are picked up by
+	// the next call
+	webhookBatchSize = 100
+	webhookTimeout   = 10 * time.Second
+)
+
+var (
+	errWebhookPrivateAddress = errors.New("webhook url must not point to a private address")
+	errWebhookRequestFailed  = errors.New("webhook request failed")
+)
+
+// WebhookService manages the webhooks of users and sends their deliveries
+type WebhookService struct {
+	db     *sql.DB
+	client *http.Client
+	// allow webhook urls in the local network, which is only safe if the
+	// users of the instance are trusted
+	allowPrivateNetworks bool
+}
+
+func NewWebhookService(db *sql.DB, allowPrivateNetworks bool) *WebhookService {
+	dialer := &net.Dialer{Timeout: webhookTimeout}
+	if !allowPrivateNetworks {
+		// checked for the address actually connected to, so host names can not
+		// resolve to another address after the url was checked
+		dialer.Control = func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || !isPublicIP(ip) {
+				return errWebhookPrivateAddress
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	client := &http.Client{
+		Timeout:   webhookTimeout,
+		Transport: transport,
+		// redirects count as failed deliveries instead of sending the payload
+		// to another url
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return &WebhookService{db, client, allowPrivateNetworks}
+}
+
+// carrier-grade NAT space (RFC 6598), not covered by net.IP.IsPrivate
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// isPublicIP reports whether the address is reachable in the internet, which
+// excludes the host itself, the local network and cloud metadata services
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() && !ip.IsUnspecified() && !sharedAddressSpace.Contains(ip)
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func (ws *WebhookService) checkWebhookUrl(ctx context.Context, rawUrl string) error {
+	parsed, err := url.Parse(rawUrl)
+	if err != nil {
+		return utils.ParameterError{Err: err}
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return utils.ParameterError{Err: errors.New("webhook url must use http or https")}
+	}
+	if parsed.Hostname() == "" {
+		return utils.ParameterError{Err: errors.New("webhook url must contain a host")}
+	}
+	if ws.allowPrivateNetworks {
+		return nil
+	}
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, parsed.Hostname())
+	if err != nil {
+		return utils.ParameterError{Err: fmt.Errorf("webhook host can not be resolved: %w", err)}
+	}
+	for _, address := range addresses {
+		if !isPublicIP(address.IP) {
+			return utils.ParameterError{Err: errWebhookPrivateAddress}
+		}
+	}
+	return nil
+}
+
+func checkWebhookEventTypes(eventTypes []string) error {
+	if len(eventTypes) == 0 {
+		return utils.ParameterError{Err: errors.New("webhook must subscribe to at least one event type")}
+	}
+	for _, eventType := range eventTypes {
+		if !operations.IsWebhookEventType(eventType) {
+			return utils.ParameterError{Err: fmt.Errorf("unknown webhook event type %s", eventType)}
+		}
+	}
+	return nil
+}
+
+type CreateWebhookParams struct {
+	OwnerId    string
+	Url        string
+	EventTypes []string
+	// a random secret is generated if none is given
+	Secret *string
+}
+
+func (ws *WebhookService) CreateWebhook(ctx context.Context, params CreateWebhookParams) (*models.Webhook, error) {
+	err := ws.checkWebhookUrl(ctx, params.Url)
+	if err != nil {
+		return nil, err
+	}
+	err = checkWebhookEventTypes(params.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	var secret string
+	if params.Secret != nil {
+		secret = *params.Secret
+	} else {
+		secret, err = generateWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+	webhookId, err := gonanoid.New()
+	if err != nil {
+		return nil, err
+	}
+	webhook := models.Webhook{
+		ID:         webhookId,
+		OwnerID:    params.OwnerId,
+		URL:        params.Url,
+		Secret:     secret,
+		EventTypes: types.StringArray(params.EventTypes),
+		Active:     true,
+	}
+	err = webhook.Insert(ctx, ws.db, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+func getWebhookChecked(ctx context.Context, exec boil.ContextExecutor, webhookId string, userId string) (*models.Webhook, error) {
+	webhook, err := models.FindWebhook(ctx, exec, webhookId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, utils.NotFoundError{EntityName: "Webhook"}
+		}
+		return nil, err
+	}
+	if webhook.OwnerID != userId {
+		return nil, utils.EntityDoesNotBelongToUserError{}
+	}
+	return webhook, nil
+}
+
+func (ws *WebhookService) GetWebhook(ctx context.Context, webhookId string, userId string) (*models.Webhook, error) {
+	return getWebhookChecked(ctx, ws.db, webhookId, userId)
+}
+
+func (ws *WebhookService) GetWebhooksForUser(ctx context.Context, userId string) (models.WebhookSlice, error) {
+	return models.Webhooks(
+		models.WebhookWhere.OwnerID.EQ(userId),
+		qm.OrderBy(models.WebhookColumns.CreatedAt+" ASC"),
+	).All(ctx, ws.db)
+}
+
+// UpdateWebhookParams changes only the fields which are set
+type UpdateWebhookParams struct {
+	Url        *string
+	EventTypes []string
+	Active     *bool
+	Secret     *string
+}
+
+func (ws *WebhookService) UpdateWebhook(ctx context.Context, webhookId string, userId string, params UpdateWebhookParams) (*models.Webhook, error) {
+	webhook, err := getWebhookChecked(ctx, ws.db, webhookId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if params.Url != nil {
+		err = ws.checkWebhookUrl(ctx, *params.Url)
+		if err != nil {
+			return nil, err
+		}
+		webhook.URL = *params.Url
+	}
+	if params.EventTypes != nil {
+		err = checkWebhookEventTypes(params.EventTypes)
+		if err != nil {
+			return nil, err
+		}
+		webhook.EventTypes = types.StringArray(params.EventTypes)
+	}
+	if params.Active != nil {
+		webhook.Active = *params.Active
+	}
+	if params.Secret != nil {
+		webhook.Secret = *params.Secret
+	}
+	_, err = webhook.Update(ctx, ws.db, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (ws *WebhookService) DeleteWebhook(ctx context.Context, webhookId string, userId string) error {
+	webhook, err := getWebhookChecked(ctx, ws.db, webhookId, userId)
+	if err != nil {
+		return err
+	}
+	_, err = webhook.Delete(ctx, ws.db)
+	return err
+}
+
+// PingWebhook queues a ping delivery to check that the endpoint is reachable,
+// regardless of the subscribed event types and whether the webhook is active
+func (ws *WebhookService) PingWebhook(ctx context.Context, webhookId string, userId string) (*models.WebhookDelivery, error) {
+	webhook, err := getWebhookChecked(ctx, ws.db, webhookId, userId)
+	if err != nil {
+		return nil, err
+	}
+	return operations.CreateWebhookDelivery(ctx, ws.db, webhook.ID, operations.WebhookEventPing, struct {
+		WebhookId string `json:"webhookId"`
+	}{webhook.ID})
+}
+
+type GetWebhookDeliveriesParams struct {
+	WebhookId string
+	UserId    string
+	PerPage   uint64
+	Page      uint64
+}
+
+// GetWebhookDeliveries returns the deliveries of the webhook, newest first
+func (ws *WebhookService) GetWebhookDeliveries(ctx context.Context, params GetWebhookDeliveriesParams) (uint64, uint64, models.WebhookDeliverySlice, error) {
+	_, err := getWebhookChecked(ctx, ws.db, params.WebhookId, params.UserId)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	count, err := models.WebhookDeliveries(models.WebhookDeliveryWhere.WebhookID.EQ(params.WebhookId)).Count(ctx, ws.db)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	deliveries, err := models.WebhookDeliveries(
+		models.WebhookDeliveryWhere.WebhookID.EQ(params.WebhookId),
+		qm.OrderBy(models.WebhookDeliveryColumns.CreatedAt+" DESC"),
+		qm.Offset(int(params.PerPage*params.Page)),
+		qm.Limit(int(params.PerPage)),
+	).All(ctx, ws.db)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	totalPages := uint64(math.Ceil(float64(count) / float64(params.PerPage)))
+	return uint64(count), totalPages, deliveries, nil
+}
+
+// send posts the signed payload to the webhook url. The status code is nil if
+// no response was received.
+func (ws *WebhookService) send(ctx context.Context, delivery *models.WebhookDelivery) (*int, error) {
+	webhook := delivery.R.Webhook
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "StashSphere-Webhook")
+	req.Header.Set(operations.WebhookEventHeader, delivery.EventType)
+	req.Header.Set(operations.WebhookDeliveryHeader, delivery.ID)
+	req.Header.Set(operations.WebhookSignatureHeader, operations.SignWebhookPayload(webhook.Secret, time.Now(), body))
+	res, err := ws.client.Do(req)
+	if err != nil {
+		// the error is shown to the owner of the webhook, so it must not reveal
+		// anything about the network of the instance
+		log.Info().Err(err).Str("deliveryId", delivery.ID).Msg("Webhook request failed")
+		if errors.Is(err, errWebhookPrivateAddress) {
+			return nil, errWebhookPrivateAddress
+		}
+		return nil, errWebhookRequestFailed
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return &res.StatusCode, fmt.Errorf("unexpected status %d", res.StatusCode)
+	}
+	return &res.StatusCode, nil
+}
+
+// ProcessDue sends the deliveries which are due and returns the number of
+// successful and failed deliveries
+func (ws *WebhookService) ProcessDue(ctx context.Context) (int, int, error) {
+	delivered, failed := 0, 0
+	for i := 0; i < webhookBatchSize; i++ {
+		var delivery *models.WebhookDelivery
+		err := utils.Tx(ctx, ws.db, func(tx *sql.Tx) error {
+			var err error
+			delivery, err = operations.ClaimNextDueWebhookDelivery(ctx, tx)
+			return err
+		})
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				break
+			}
+			return delivered, failed, err
+		}
+		// the receiver may be slow, so no transaction is held while sending
+		responseCode, sendErr := ws.send(ctx, delivery)
+		err = utils.Tx(ctx, ws.db, func(tx *sql.Tx) error {
+			if sendErr != nil {
+				log.Warn().Err(sendErr).Str("deliveryId", delivery.ID).Int("attempts", delivery.Attempts+1).Msg("Failed to deliver webhook")
+				failed++
+				return operations.MarkWebhookDeliveryFailed(ctx, tx, delivery, responseCode, sendErr)
+			}
+			delivered++
+			return operations.MarkWebhookDeliveryDelivered(ctx, tx, delivery, *responseCode)
+		})
+		if err != nil {
+			return delivered, failed, err
+		}
+	}
+	return delivered, failed, nil
+}
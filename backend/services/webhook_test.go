@@ -0,0 +1,335 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/stashsphere/backend/factories"
+	"github.com/stashsphere/backend/models"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/services"
+	testcommon "github.com/stashsphere/backend/test_common"
+	"github.com/stashsphere/backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+type webhookReceiver struct {
+	mu       sync.Mutex
+	status   int
+	received []receivedWebhook
+}
+
+func (wr *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+	wr.received = append(wr.received, receivedWebhook{r.Header.Clone(), body})
+	w.WriteHeader(wr.status)
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+	is, err := services.NewTmpImageService(db)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		os.Remove(is.StorePath())
+	})
+
+	receiver := &webhookReceiver{status: http.StatusOK}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	thingService := services.NewThingService(db, is, notificationService)
+	webhookService := services.NewWebhookService(db, true)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+	bobParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	bob, err := userService.CreateUser(context.Background(), *bobParams)
+	assert.NoError(t, err)
+
+	_, err = webhookService.CreateWebhook(context.Background(), services.CreateWebhookParams{
+		OwnerId:    alice.ID,
+		Url:        "ftp://example.com",
+		EventTypes: []string{operations.WebhookEventThingCreated},
+	})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+	_, err = webhookService.CreateWebhook(context.Background(), services.CreateWebhookParams{
+		OwnerId:    alice.ID,
+		Url:        server.URL,
+		EventTypes: []string{"thing.stolen"},
+	})
+	assert.ErrorAs(t, err, &utils.ParameterError{})
+
+	webhook, err := webhookService.CreateWebhook(context.Background(), services.CreateWebhookParams{
+		OwnerId:    alice.ID,
+		Url:        server.URL,
+		EventTypes: []string{operations.WebhookEventThingCreated, operations.WebhookEventThingDeleted},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, webhook.Secret, 64)
+
+	_, err = webhookService.GetWebhook(context.Background(), webhook.ID, bob.ID)
+	assert.ErrorIs(t, err, utils.EntityDoesNotBelongToUserError{})
+
+	thingParams := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+	thingParams.OwnerId = alice.ID
+	thing, err := thingService.CreateThing(context.Background(), *thingParams)
+	assert.NoError(t, err)
+	// not subscribed to updates
+	_, err = thingService.EditThing(context.Background(), thing.ID, alice.ID, services.UpdateThingParams{
+		Name:         "renamed",
+		SharingState: "private",
+	})
+	assert.NoError(t, err)
+	// things of other users are not reported
+	otherParams := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+	otherParams.OwnerId = bob.ID
+	_, err = thingService.CreateThing(context.Background(), *otherParams)
+	assert.NoError(t, err)
+
+	delivered, failed, err := webhookService.ProcessDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, 0, failed)
+	assert.Len(t, receiver.received, 1)
+
+	request := receiver.received[0]
+	assert.Equal(t, operations.WebhookEventThingCreated, request.header.Get(operations.WebhookEventHeader))
+	signature := request.header.Get(operations.WebhookSignatureHeader)
+	timestamp, err := strconv.ParseInt(strings.TrimPrefix(strings.Split(signature, ",")[0], "t="), 10, 64)
+	assert.NoError(t, err)
+	assert.Equal(t, operations.SignWebhookPayload(webhook.Secret, time.Unix(timestamp, 0), request.body), signature)
+	var payload struct {
+		DeliveryId string                      `json:"deliveryId"`
+		Event      string                      `json:"event"`
+		Data       operations.WebhookThingData `json:"data"`
+	}
+	err = json.Unmarshal(request.body, &payload)
+	assert.NoError(t, err)
+	assert.Equal(t, request.header.Get(operations.WebhookDeliveryHeader), payload.DeliveryId)
+	assert.Equal(t, thing.ID, payload.Data.ThingId)
+	assert.Equal(t, alice.ID, payload.Data.OwnerId)
+
+	// failed deliveries keep the response code and are retried
+	receiver.status = http.StatusServiceUnavailable
+	err = thingService.DeleteThing(context.Background(), thing.ID, alice.ID)
+	assert.NoError(t, err)
+	delivered, failed, err = webhookService.ProcessDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Equal(t, 1, failed)
+
+	_, _, deliveries, err := webhookService.GetWebhookDeliveries(context.Background(), services.GetWebhookDeliveriesParams{
+		WebhookId: webhook.ID,
+		UserId:    alice.ID,
+		PerPage:   10,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, deliveries, 2)
+	retried := deliveries[0]
+	assert.Equal(t, operations.WebhookEventThingDeleted, retried.EventType)
+	assert.Equal(t, models.WebhookDeliveryStatePending, retried.State)
+	assert.Equal(t, http.StatusServiceUnavailable, retried.ResponseCode.Int)
+	assert.Equal(t, "unexpected status 503", retried.LastError.String)
+	assert.Equal(t, 1, retried.Attempts)
+	assert.Equal(t, models.WebhookDeliveryStateDelivered, deliveries[1].State)
+	assert.Equal(t, http.StatusOK, deliveries[1].ResponseCode.Int)
+
+	receiver.status = http.StatusNoContent
+	_, err = db.Exec("UPDATE webhook_deliveries SET next_attempt_at = next_attempt_at - interval '1 hour'")
+	assert.NoError(t, err)
+	delivered, _, err = webhookService.ProcessDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	err = retried.Reload(context.Background(), db)
+	assert.NoError(t, err)
+	assert.Equal(t, models.WebhookDeliveryStateDelivered, retried.State)
+	assert.Equal(t, http.StatusNoContent, retried.ResponseCode.Int)
+	assert.Equal(t, 2, retried.Attempts)
+
+	// inactive webhooks receive no deliveries
+	active := false
+	_, err = webhookService.UpdateWebhook(context.Background(), webhook.ID, alice.ID, services.UpdateWebhookParams{Active: &active})
+	assert.NoError(t, err)
+	_, err = thingService.CreateThing(context.Background(), *thingParams)
+	assert.NoError(t, err)
+	count, err := models.WebhookDeliveries().Count(context.Background(), db)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// deliveries queued before the webhook was deactivated are held back
+	active = true
+	_, err = webhookService.UpdateWebhook(context.Background(), webhook.ID, alice.ID, services.UpdateWebhookParams{Active: &active})
+	assert.NoError(t, err)
+	_, err = thingService.CreateThing(context.Background(), *thingParams)
+	assert.NoError(t, err)
+	active = false
+	_, err = webhookService.UpdateWebhook(context.Background(), webhook.ID, alice.ID, services.UpdateWebhookParams{Active: &active})
+	assert.NoError(t, err)
+	delivered, failed, err = webhookService.ProcessDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Equal(t, 0, failed)
+	active = true
+	_, err = webhookService.UpdateWebhook(context.Background(), webhook.ID, alice.ID, services.UpdateWebhookParams{Active: &active})
+	assert.NoError(t, err)
+	delivered, _, err = webhookService.ProcessDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+}
+
+func TestWebhookPrivateAddresses(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+
+	receiver := &webhookReceiver{status: http.StatusOK}
+	server := httptest.NewServer(receiver)
+	t.Cleanup(server.Close)
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	webhookService := services.NewWebhookService(db, false)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+
+	for _, url := range []string{server.URL, "http://localhost/", "http://10.0.0.1/", "http://100.64.0.1/", "http://169.254.169.254/latest/meta-data", "http://[::1]:8080/"} {
+		_, err = webhookService.CreateWebhook(context.Background(), services.CreateWebhookParams{
+			OwnerId:    alice.ID,
+			Url:        url,
+			EventTypes: []string{operations.WebhookEventThingCreated},
+		})
+		assert.ErrorAs(t, err, &utils.ParameterError{}, url)
+	}
+
+	// addresses are checked again when connecting, as host names may resolve
+	// differently later
+	webhook := models.Webhook{
+		ID:         "private",
+		OwnerID:    alice.ID,
+		URL:        server.URL,
+		Secret:     "secret",
+		EventTypes: types.StringArray{operations.WebhookEventThingCreated},
+		Active:     true,
+	}
+	err = webhook.Insert(context.Background(), db, boil.Infer())
+	assert.NoError(t, err)
+	delivery, err := webhookService.PingWebhook(context.Background(), webhook.ID, alice.ID)
+	assert.NoError(t, err)
+	delivered, failed, err := webhookService.ProcessDue(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	assert.Equal(t, 1, failed)
+	assert.Empty(t, receiver.received)
+	err = delivery.Reload(context.Background(), db)
+	assert.NoError(t, err)
+	assert.False(t, delivery.ResponseCode.Valid)
+	assert.Equal(t, "webhook url must not point to a private address", delivery.LastError.String)
+}
+
+func TestWebhookThingUpdates(t *testing.T) {
+	db, tearDownFunc, err := testcommon.CreateTestSchema()
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	t.Cleanup(tearDownFunc)
+	is, err := services.NewTmpImageService(db)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		os.Remove(is.StorePath())
+	})
+
+	userService := services.NewUserService(db, false, "", 60, nil)
+	emailService := services.TestEmailService{}
+	notificationService := services.NewNotificationService(db, services.NotificationData{
+		FrontendUrl:  "https://example.com",
+		InstanceName: "StashsphereTest",
+	}, &emailService)
+	thingService := services.NewThingService(db, is, notificationService)
+	locationService := services.NewLocationService(db)
+	webhookService := services.NewWebhookService(db, true)
+
+	aliceParams := factories.UserFactory.MustCreate().(*services.CreateUserParams)
+	alice, err := userService.CreateUser(context.Background(), *aliceParams)
+	assert.NoError(t, err)
+	_, err = webhookService.CreateWebhook(context.Background(), services.CreateWebhookParams{
+		OwnerId:    alice.ID,
+		Url:        "https://example.com/webhook",
+		EventTypes: []string{operations.WebhookEventThingUpdated},
+	})
+	assert.NoError(t, err)
+	updates := func() int64 {
+		count, err := models.WebhookDeliveries(
+			models.WebhookDeliveryWhere.EventType.EQ(operations.WebhookEventThingUpdated),
+		).Count(context.Background(), db)
+		assert.NoError(t, err)
+		return count
+	}
+
+	thingParams := factories.ThingFactory.MustCreate().(*services.CreateThingParams)
+	thingParams.OwnerId = alice.ID
+	thingParams.Quantity = 5
+	thing, err := thingService.CreateThing(context.Background(), *thingParams)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), updates())
+
+	_, err = thingService.ChangeQuantity(context.Background(), services.ChangeQuantityParams{
+		ThingId: thing.ID,
+		UserId:  alice.ID,
+		Delta:   -1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), updates())
+
+	box, err := locationService.CreateLocation(context.Background(), services.CreateLocationParams{
+		Name:    "Box",
+		OwnerId: alice.ID,
+	})
+	assert.NoError(t, err)
+	err = locationService.MoveThings(context.Background(), services.MoveThingsParams{
+		UserId:     alice.ID,
+		ThingIds:   []string{thing.ID},
+		LocationId: &box.ID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), updates())
+
+	// deleting the location moves the thing out of it
+	err = locationService.DeleteLocation(context.Background(), box.ID, alice.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), updates())
+}
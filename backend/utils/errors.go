@@ -22,6 +22,16 @@ const (
 	ErrFriendShipExists            = "friend-ship-exists"
 	ErrInvalidVerificationCode     = "invalid-verification-code"
 	ErrVerificationCodeExpired     = "verification-code-expired"
+	ErrBorrowRequestNotPending     = "borrow-request-not-pending"
+	ErrThingAlreadyLent            = "thing-already-lent"
+	ErrLoanNotActive               = "loan-not-active"
+	ErrInsufficientScope           = "insufficient-scope"
+	ErrTwoFactorRequired           = "two-factor-required"
+	ErrInvalidTwoFactorCode        = "invalid-two-factor-code"
+	ErrInvalidPasswordResetToken   = "invalid-password-reset-token"
+	ErrPasswordResetTokenExpired   = "password-reset-token-expired"
+	ErrPasswordLoginDisabled       = "password-login-disabled"
+	ErrOidcLinkRequired            = "oidc-link-required"
 )
 
 type StashsphereError interface {
@@ -128,3 +138,72 @@ type VerificationCodeExpiredError struct{}
 
 func (r VerificationCodeExpiredError) ErrorType() string { return ErrVerificationCodeExpired }
 func (r VerificationCodeExpiredError) Error() string     { return "Verification code has expired" }
+
+type BorrowRequestNotPendingError struct{}
+
+func (r BorrowRequestNotPendingError) ErrorType() string { return ErrBorrowRequestNotPending }
+func (r BorrowRequestNotPendingError) Error() string     { return "Borrow request is not pending" }
+
+type ThingAlreadyLentError struct{}
+
+func (r ThingAlreadyLentError) ErrorType() string { return ErrThingAlreadyLent }
+func (r ThingAlreadyLentError) Error() string     { return "Thing is already lent" }
+
+type LoanNotActiveError struct{}
+
+func (r LoanNotActiveError) ErrorType() string { return ErrLoanNotActive }
+func (r LoanNotActiveError) Error() string     { return "Loan is not active" }
+
+// InsufficientScopeError is returned if a personal access token lacks the scope
+// for a request. The scope is empty if the request is not allowed with tokens.
+type InsufficientScopeError struct {
+	Scope string
+}
+
+func (r InsufficientScopeError) ErrorType() string { return ErrInsufficientScope }
+func (r InsufficientScopeError) Error() string {
+	if r.Scope == "" {
+		return "Not allowed with an API token"
+	}
+	return fmt.Sprintf("API token lacks the %s scope", r.Scope)
+}
+
+// TwoFactorRequiredError is returned by a password login of a user with
+// two-factor authentication, the login is completed with the challenge token
+// and a code
+type TwoFactorRequiredError struct {
+	ChallengeToken string
+}
+
+func (r TwoFactorRequiredError) ErrorType() string { return ErrTwoFactorRequired }
+func (r TwoFactorRequiredError) Error() string     { return "Two-factor authentication required" }
+
+type InvalidTwoFactorCodeError struct{}
+
+func (r InvalidTwoFactorCodeError) ErrorType() string { return ErrInvalidTwoFactorCode }
+func (r InvalidTwoFactorCodeError) Error() string     { return "Invalid two-factor code" }
+
+type InvalidPasswordResetTokenError struct{}
+
+func (r InvalidPasswordResetTokenError) ErrorType() string { return ErrInvalidPasswordResetToken }
+func (r InvalidPasswordResetTokenError) Error() string     { return "Invalid password reset token" }
+
+type PasswordResetTokenExpiredError struct{}
+
+func (r PasswordResetTokenExpiredError) ErrorType() string { return ErrPasswordResetTokenExpired }
+func (r PasswordResetTokenExpiredError) Error() string     { return "Password reset token has expired" }
+
+// PasswordLoginDisabledError is returned by password logins, registrations and
+// password resets if the instance only allows single sign-on and passkeys
+type PasswordLoginDisabledError struct{}
+
+func (r PasswordLoginDisabledError) ErrorType() string { return ErrPasswordLoginDisabled }
+func (r PasswordLoginDisabledError) Error() string     { return "Password login is disabled" }
+
+// OidcLinkRequiredError is returned by single sign-on logins matching an
+// account by its email address which can not be linked automatically. The
+// user has to log in and link the account at the provider explicitly.
+type OidcLinkRequiredError struct{}
+
+func (r OidcLinkRequiredError) ErrorType() string { return ErrOidcLinkRequired }
+func (r OidcLinkRequiredError) Error() string     { return "Account has to be linked to single sign-on" }
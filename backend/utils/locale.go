@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const DefaultLocale = "en"
+
+// SupportedLocales lists the languages emails and validation messages are
+// available in
+var SupportedLocales = []string{DefaultLocale, "de", "fr"}
+
+func IsSupportedLocale(locale string) bool {
+	return Contains(SupportedLocales, locale)
+}
+
+// PreferredLocale returns the supported locale ranked highest in an
+// Accept-Language header, regions are ignored. It falls back to the default
+// locale.
+func PreferredLocale(acceptLanguage string) string {
+	type weightedLocale struct {
+		locale string
+		weight float64
+	}
+	locales := []weightedLocale{}
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		weight := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		language, _, _ := strings.Cut(tag, "-")
+		language = strings.ToLower(language)
+		if weight > 0 && IsSupportedLocale(language) {
+			locales = append(locales, weightedLocale{language, weight})
+		}
+	}
+	if len(locales) == 0 {
+		return DefaultLocale
+	}
+	sort.SliceStable(locales, func(i, j int) bool {
+		return locales[i].weight > locales[j].weight
+	})
+	return locales[0].locale
+}
@@ -0,0 +1,63 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/services"
+)
+
+// DigestWorker sends the summary emails of notifications on the digest channel
+type DigestWorker struct {
+	notificationService *services.NotificationService
+	pollInterval        time.Duration
+	stopCh              chan struct{}
+}
+
+func NewDigestWorker(notificationService *services.NotificationService, pollInterval time.Duration) *DigestWorker {
+	return &DigestWorker{
+		notificationService: notificationService,
+		pollInterval:        pollInterval,
+		stopCh:              make(chan struct{}),
+	}
+}
+
+func (dw *DigestWorker) Start() {
+	go dw.run()
+}
+
+func (dw *DigestWorker) Stop() {
+	close(dw.stopCh)
+}
+
+func (dw *DigestWorker) run() {
+	ticker := time.NewTicker(dw.pollInterval)
+	defer ticker.Stop()
+
+	log.Info().Msgf("Digest worker started, polling every %s", dw.pollInterval)
+
+	// Run immediately on start
+	dw.sendDigests()
+
+	for {
+		select {
+		case <-ticker.C:
+			dw.sendDigests()
+		case <-dw.stopCh:
+			log.Info().Msg("Digest worker stopped")
+			return
+		}
+	}
+}
+
+func (dw *DigestWorker) sendDigests() {
+	count, err := dw.notificationService.SendDueDigests(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to send digests")
+		return
+	}
+	if count > 0 {
+		log.Info().Int("count", count).Msg("Sent notification digests")
+	}
+}
@@ -0,0 +1,62 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/services"
+)
+
+type LoanWorker struct {
+	lendingService *services.LendingService
+	pollInterval   time.Duration
+	stopCh         chan struct{}
+}
+
+func NewLoanWorker(lendingService *services.LendingService, pollInterval time.Duration) *LoanWorker {
+	return &LoanWorker{
+		lendingService: lendingService,
+		pollInterval:   pollInterval,
+		stopCh:         make(chan struct{}),
+	}
+}
+
+func (lw *LoanWorker) Start() {
+	go lw.run()
+}
+
+func (lw *LoanWorker) Stop() {
+	close(lw.stopCh)
+}
+
+func (lw *LoanWorker) run() {
+	ticker := time.NewTicker(lw.pollInterval)
+	defer ticker.Stop()
+
+	log.Info().Msgf("Loan worker started, polling every %s", lw.pollInterval)
+
+	// Run immediately on start
+	lw.processOverdueLoans()
+
+	for {
+		select {
+		case <-ticker.C:
+			lw.processOverdueLoans()
+		case <-lw.stopCh:
+			log.Info().Msg("Loan worker stopped")
+			return
+		}
+	}
+}
+
+func (lw *LoanWorker) processOverdueLoans() {
+	count, err := lw.lendingService.MarkOverdueLoans(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to mark overdue loans")
+		return
+	}
+	if count > 0 {
+		log.Info().Int("count", count).Msg("Marked loans as overdue")
+	}
+}
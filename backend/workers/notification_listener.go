@@ -0,0 +1,81 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/operations"
+	"github.com/stashsphere/backend/services"
+)
+
+// NotificationListener listens for notification events published by any
+// server instance and hands them to the notification streams of this instance.
+type NotificationListener struct {
+	streamService *services.NotificationStreamService
+	listener      *pq.Listener
+	stopCh        chan struct{}
+}
+
+func NewNotificationListener(dbOptions string, streamService *services.NotificationStreamService) *NotificationListener {
+	listener := pq.NewListener(dbOptions, 1*time.Second, 1*time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Error().Err(err).Msg("Notification listener connection error")
+		}
+	})
+	return &NotificationListener{
+		streamService: streamService,
+		listener:      listener,
+		stopCh:        make(chan struct{}),
+	}
+}
+
+func (nl *NotificationListener) Start() error {
+	err := nl.listener.Listen(operations.NotificationEventsChannel)
+	if err != nil {
+		return err
+	}
+	go nl.run()
+	return nil
+}
+
+func (nl *NotificationListener) Stop() {
+	close(nl.stopCh)
+	if err := nl.listener.Close(); err != nil {
+		log.Error().Err(err).Msg("Failed to close notification listener")
+	}
+}
+
+func (nl *NotificationListener) run() {
+	// check the connection regularly, a broken connection is only noticed
+	// when pinging or when the next notification is expected
+	ticker := time.NewTicker(1 * time.Minute)
+	defer ticker.Stop()
+
+	log.Info().Msgf("Notification listener started on channel %s", operations.NotificationEventsChannel)
+
+	for {
+		select {
+		case notification, ok := <-nl.listener.Notify:
+			if !ok {
+				return
+			}
+			// nil is sent after the connection was reestablished, events sent
+			// in between are lost
+			if notification == nil {
+				nl.streamService.Resync()
+				continue
+			}
+			err := nl.streamService.Dispatch(context.Background(), notification.Extra)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to dispatch notification event")
+			}
+		case <-ticker.C:
+			go nl.listener.Ping()
+		case <-nl.stopCh:
+			log.Info().Msg("Notification listener stopped")
+			return
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/services"
+)
+
+// OutboxWorker retries the delivery of emails which could not be sent right
+// away
+type OutboxWorker struct {
+	outboxService *services.EmailOutboxService
+	pollInterval  time.Duration
+	stopCh        chan struct{}
+}
+
+func NewOutboxWorker(outboxService *services.EmailOutboxService, pollInterval time.Duration) *OutboxWorker {
+	return &OutboxWorker{
+		outboxService: outboxService,
+		pollInterval:  pollInterval,
+		stopCh:        make(chan struct{}),
+	}
+}
+
+func (ow *OutboxWorker) Start() {
+	go ow.run()
+}
+
+func (ow *OutboxWorker) Stop() {
+	close(ow.stopCh)
+}
+
+func (ow *OutboxWorker) run() {
+	ticker := time.NewTicker(ow.pollInterval)
+	defer ticker.Stop()
+
+	log.Info().Msgf("Outbox worker started, polling every %s", ow.pollInterval)
+
+	// Run immediately on start
+	ow.processOutbox()
+
+	for {
+		select {
+		case <-ticker.C:
+			ow.processOutbox()
+		case <-ow.stopCh:
+			log.Info().Msg("Outbox worker stopped")
+			return
+		}
+	}
+}
+
+func (ow *OutboxWorker) processOutbox() {
+	sent, failed, err := ow.outboxService.ProcessDue(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to process outbox")
+	}
+	if sent > 0 || failed > 0 {
+		log.Info().Int("sent", sent).Int("failed", failed).Msg("Processed outbox")
+	}
+}
@@ -82,6 +82,46 @@ func (pw *PurgeWorker) processPendingPurges() {
 		log.Info().Str("userId", user.ID).Msg("User account purged successfully")
 	}
 
+	// Purge emails sent more than a week ago from the outbox
+	purgedEmails, err := operations.PurgeSentOutboxEmails(ctx, pw.db, time.Now().Add(-7*24*time.Hour))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to purge sent outbox emails")
+	} else if purgedEmails > 0 {
+		log.Info().Int64("count", purgedEmails).Msg("Purged sent outbox emails")
+	}
+
+	// Purge finished webhook deliveries older than 30 days
+	purgedDeliveries, err := operations.PurgeWebhookDeliveries(ctx, pw.db, time.Now().Add(-30*24*time.Hour))
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to purge webhook deliveries")
+	} else if purgedDeliveries > 0 {
+		log.Info().Int64("count", purgedDeliveries).Msg("Purged webhook deliveries")
+	}
+
+	// Purge expired sessions
+	purgedSessions, err := operations.PurgeExpiredSessions(ctx, pw.db, time.Now())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to purge expired sessions")
+	} else if purgedSessions > 0 {
+		log.Info().Int64("count", purgedSessions).Msg("Purged expired sessions")
+	}
+
+	// Purge passkey registrations and logins which have not been finished
+	purgedCeremonies, err := operations.PurgeExpiredWebauthnCeremonies(ctx, pw.db, time.Now())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to purge expired webauthn ceremonies")
+	} else if purgedCeremonies > 0 {
+		log.Info().Int64("count", purgedCeremonies).Msg("Purged expired webauthn ceremonies")
+	}
+
+	// Purge single sign-on logins which have not returned from the provider
+	purgedOidcLogins, err := operations.PurgeExpiredOidcLogins(ctx, pw.db, time.Now())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to purge expired OpenID Connect logins")
+	} else if purgedOidcLogins > 0 {
+		log.Info().Int64("count", purgedOidcLogins).Msg("Purged expired OpenID Connect logins")
+	}
+
 	// Purge expired verification codes (expired > 24 hours ago)
 	purgedCodes, err := operations.PurgeExpiredVerificationCodes(ctx, pw.db)
 	if err != nil {
@@ -89,4 +129,12 @@ func (pw *PurgeWorker) processPendingPurges() {
 	} else if purgedCodes > 0 {
 		log.Info().Int64("count", purgedCodes).Msg("Purged expired verification codes")
 	}
+
+	// Purge expired password reset tokens (expired > 24 hours ago)
+	purgedResetTokens, err := operations.PurgeExpiredPasswordResetTokens(ctx, pw.db)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to purge expired password reset tokens")
+	} else if purgedResetTokens > 0 {
+		log.Info().Int64("count", purgedResetTokens).Msg("Purged expired password reset tokens")
+	}
 }
@@ -0,0 +1,62 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stashsphere/backend/services"
+)
+
+// WebhookWorker sends pending webhook deliveries and retries failed ones
+type WebhookWorker struct {
+	webhookService *services.WebhookService
+	pollInterval   time.Duration
+	stopCh         chan struct{}
+}
+
+func NewWebhookWorker(webhookService *services.WebhookService, pollInterval time.Duration) *WebhookWorker {
+	return &WebhookWorker{
+		webhookService: webhookService,
+		pollInterval:   pollInterval,
+		stopCh:         make(chan struct{}),
+	}
+}
+
+func (ww *WebhookWorker) Start() {
+	go ww.run()
+}
+
+func (ww *WebhookWorker) Stop() {
+	close(ww.stopCh)
+}
+
+func (ww *WebhookWorker) run() {
+	ticker := time.NewTicker(ww.pollInterval)
+	defer ticker.Stop()
+
+	log.Info().Msgf("Webhook worker started, polling every %s", ww.pollInterval)
+
+	// Run immediately on start
+	ww.processDeliveries()
+
+	for {
+		select {
+		case <-ticker.C:
+			ww.processDeliveries()
+		case <-ww.stopCh:
+			log.Info().Msg("Webhook worker stopped")
+			return
+		}
+	}
+}
+
+func (ww *WebhookWorker) processDeliveries() {
+	delivered, failed, err := ww.webhookService.ProcessDue(context.Background())
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to process webhook deliveries")
+	}
+	if delivered > 0 || failed > 0 {
+		log.Info().Int("delivered", delivered).Int("failed", failed).Msg("Processed webhook deliveries")
+	}
+}
@@ -17,6 +17,7 @@ import { RequireAuth } from './routes/private';
 import { Lists } from './routes/lists/list';
 import { CreateList } from './routes/lists/create';
 import { ShowList } from './routes/lists/show';
+import { ShowLocation } from './routes/locations/show';
 import { Fragment, useCallback, useEffect, useMemo, useState } from 'react';
 import { useNavigate } from 'react-router';
 import { Search } from './routes/search';
@@ -35,6 +36,7 @@ import { ShowUser } from './routes/users/show';
 import { UserLayout } from './components/user_layout';
 import { Account } from './routes/profile/account';
 import { VerifyEmail } from './routes/user/verify-email';
+import { ResetPassword } from './routes/user/reset-password';
 import { jwtDecode } from 'jwt-decode';
 import { refreshTokens } from './api/auth';
 import { ShowCart } from './routes/cart';
@@ -161,6 +163,7 @@ export const App = () => {
                     <Route path="/user/login" element={<Login />} />
                     <Route path="/user/logout" element={<Logout />} />
                     <Route path="/user/register" element={<Register />} />
+                    <Route path="/user/reset-password" element={<ResetPassword />} />
                     <Route
                       path="/user"
                       element={
@@ -257,6 +260,14 @@ export const App = () => {
                         </RequireAuth>
                       }
                     />
+                    <Route
+                      path="/locations/:locationId"
+                      element={
+                        <RequireAuth>
+                          <ShowLocation />
+                        </RequireAuth>
+                      }
+                    />
 
                     <Route
                       path="/friends"
@@ -29,3 +29,29 @@ export const logout = async (axios: Axios) => {
   await axios.delete('/user/logout');
   return null;
 };
+
+export const requestPasswordReset = async (axios: Axios, email: string) => {
+  await axios.post(
+    '/user/password-reset/request',
+    { email },
+    {
+      headers: {
+        'Content-Type': 'application/json',
+      },
+    }
+  );
+  return null;
+};
+
+export const confirmPasswordReset = async (axios: Axios, token: string, newPassword: string) => {
+  await axios.post(
+    '/user/password-reset/confirm',
+    { token, newPassword },
+    {
+      headers: {
+        'Content-Type': 'application/json',
+      },
+    }
+  );
+  return null;
+};
@@ -0,0 +1,17 @@
+import { Axios } from 'axios';
+import { LocationTree } from './resources';
+
+export const getLocationTree = async (axios: Axios, id: string) => {
+  const response = await axios.get(`/locations/${id}/tree`, {
+    headers: {
+      'Content-Type': 'application/json',
+    },
+  });
+
+  if (response.status != 200) {
+    throw `Got error ${response}`;
+  }
+
+  const tree = response.data as LocationTree;
+  return tree;
+};
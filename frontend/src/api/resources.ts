@@ -129,6 +129,28 @@ export interface PagedLists extends Paged {
   lists: List[];
 }
 
+export interface ReducedLocation {
+  id: string;
+  name: string;
+}
+
+export interface Location {
+  id: string;
+  name: string;
+  description: string;
+  createdAt: Date;
+  owner: User;
+  parentId: string | null;
+  path: ReducedLocation[];
+  sharingState: SharingState | null;
+  actions: ThingActions;
+}
+
+export interface LocationTree extends Location {
+  children: LocationTree[];
+  things: ReducedThing[];
+}
+
 export interface SearchResult {
   things: Thing[];
   lists: List[];
@@ -0,0 +1,82 @@
+import { useParams } from 'react-router';
+import { useContext, useEffect, useState } from 'react';
+import { AxiosContext } from '../../context/axios';
+import { LocationTree } from '../../api/resources';
+import { getLocationTree } from '../../api/locations';
+import { Headline } from '../../components/shared';
+import { UserNameAndUserId } from '../../components/shared/user';
+
+const LocationContents = ({ tree }: { tree: LocationTree }) => {
+  return (
+    <ul className="ml-4 list-disc text-display">
+      {tree.things.map((thing) => (
+        <li key={thing.id}>
+          <a className="text-accent" href={`/things/${thing.id}`}>
+            {thing.name}
+          </a>
+        </li>
+      ))}
+      {tree.children.map((child) => (
+        <li key={child.id}>
+          <a className="text-primary" href={`/locations/${child.id}`}>
+            {child.name}
+          </a>
+          <LocationContents tree={child} />
+        </li>
+      ))}
+    </ul>
+  );
+};
+
+export const ShowLocation = () => {
+  const [tree, setTree] = useState<null | LocationTree>(null);
+  const axiosInstance = useContext(AxiosContext);
+  const { locationId } = useParams();
+
+  useEffect(() => {
+    if (!axiosInstance) {
+      return;
+    }
+    if (!locationId) {
+      return;
+    }
+    getLocationTree(axiosInstance, locationId).then(setTree);
+  }, [locationId, axiosInstance]);
+
+  if (locationId === undefined) {
+    return <p>invalid id</p>;
+  } else if (tree === null) {
+    return <h1>Loading</h1>;
+  }
+  return (
+    <>
+      <div className="flex flex-row gap-1 text-display">
+        {tree.path.slice(0, -1).map((location) => (
+          <span key={location.id}>
+            <a href={`/locations/${location.id}`}>{location.name}</a> /
+          </span>
+        ))}
+      </div>
+      <div className="flex flex-row justify-between mb-4">
+        <h1 className="text-2xl text-accent">{tree.name}</h1>
+      </div>
+      {tree.description && <p className="text-display mb-4">{tree.description}</p>}
+      <div>
+        <Headline type="h2">Owner</Headline>
+        <UserNameAndUserId
+          userId={tree.owner.id}
+          imageBorderColor="border-display"
+          textColor="text-display"
+        />
+      </div>
+      <div className="mt-4">
+        <Headline type="h2">Contents</Headline>
+        {tree.things.length === 0 && tree.children.length === 0 ? (
+          <p className="text-display">This location is empty.</p>
+        ) : (
+          <LocationContents tree={tree} />
+        )}
+      </div>
+    </>
+  );
+};
@@ -67,9 +67,14 @@ export const Login = () => {
           <PrimaryButton type="submit">Login</PrimaryButton>
           {error && <p className="text-danger-400">{error}</p>}
         </form>
-        <a href="/user/register" className="underline text-secondary">
-          Register an account
-        </a>
+        <div className="flex flex-col">
+          <a href="/user/register" className="underline text-secondary">
+            Register an account
+          </a>
+          <a href="/user/reset-password" className="underline text-secondary">
+            Forgot your password?
+          </a>
+        </div>
       </div>
     </div>
   );
@@ -1,7 +1,7 @@
 import { FormEvent, useContext, useEffect, useState } from 'react';
 import { PrimaryButton, PasswordInput, usePasswordValidation } from '../components/shared';
 import { AxiosContext } from '../context/axios';
-import { useNavigate } from 'react-router';
+import { useNavigate, useSearchParams } from 'react-router';
 import { InstanceInfo } from '../api/resources';
 import { getInstanceInfo } from '../api/info';
 
@@ -12,7 +12,10 @@ export const Register = () => {
   const [email, setEmail] = useState('');
   const [password, setPassword] = useState('');
   const [passwordConfirm, setPasswordConfirm] = useState('');
-  const [inviteCode, setInviteCode] = useState('');
+  const [searchParams] = useSearchParams();
+  // invite links carry the code as query parameter
+  const invitedWith = searchParams.get('invite') ?? '';
+  const [inviteCode, setInviteCode] = useState(invitedWith);
   const navigate = useNavigate();
 
   const [error, setError] = useState<string | undefined>(undefined);
@@ -57,6 +60,7 @@ export const Register = () => {
   };
 
   const inviteRequired = instanceInfo === null ? true : instanceInfo.inviteRequired;
+  const showInviteCode = inviteRequired || invitedWith !== '';
 
   return (
     <div className="flex items-center justify-center">
@@ -98,7 +102,7 @@ export const Register = () => {
             confirmLabel="Password (confirm)"
             minLength={8}
           />
-          {inviteRequired ? (
+          {showInviteCode ? (
             <div className="mb-4">
               <label htmlFor="invite_code" className="block text-primary text-sm font-medium">
                 Invite Code
@@ -0,0 +1,135 @@
+import { FormEvent, useContext, useEffect, useState } from 'react';
+import { useNavigate } from 'react-router';
+import { AxiosContext } from '../../context/axios';
+import { PasswordInput, PrimaryButton, usePasswordValidation } from '../../components/shared';
+import { confirmPasswordReset, requestPasswordReset } from '../../api/auth';
+
+const RequestResetForm = () => {
+  const axiosInstance = useContext(AxiosContext);
+  const [email, setEmail] = useState('');
+  const [sent, setSent] = useState(false);
+  const [error, setError] = useState<string | undefined>(undefined);
+
+  const onSubmit = async (event: FormEvent<HTMLFormElement>) => {
+    event.preventDefault();
+    if (axiosInstance === null) {
+      return;
+    }
+    try {
+      await requestPasswordReset(axiosInstance, email);
+      setError(undefined);
+      setSent(true);
+    } catch {
+      setError('Could not request a password reset.');
+    }
+  };
+
+  if (sent) {
+    return (
+      <p className="text-display">
+        If an account with this address exists, an email with a link to reset the password is on
+        its way.
+      </p>
+    );
+  }
+  return (
+    <form onSubmit={onSubmit}>
+      <div className="mb-4">
+        <label htmlFor="email" className="block text-primary text-sm font-medium">
+          E-Mail
+        </label>
+        <input
+          type="text"
+          id="email"
+          name="email"
+          value={email}
+          onChange={(e) => setEmail(e.target.value)}
+          className="mt-1 p-2 w-full border border-secondary rounded-sm text-display"
+        />
+      </div>
+      <PrimaryButton type="submit" disabled={email.length < 1}>
+        Send reset link
+      </PrimaryButton>
+      {error && <p className="text-danger-400">{error}</p>}
+    </form>
+  );
+};
+
+const NewPasswordForm = ({ token }: { token: string }) => {
+  const navigate = useNavigate();
+  const axiosInstance = useContext(AxiosContext);
+  const [password, setPassword] = useState('');
+  const [passwordConfirm, setPasswordConfirm] = useState('');
+  const [success, setSuccess] = useState(false);
+  const [error, setError] = useState<string | undefined>(undefined);
+  const { isValid: isPasswordValid } = usePasswordValidation(password, passwordConfirm, 8);
+
+  const onSubmit = async (event: FormEvent<HTMLFormElement>) => {
+    event.preventDefault();
+    if (axiosInstance === null) {
+      return;
+    }
+    try {
+      await confirmPasswordReset(axiosInstance, token, password);
+      setError(undefined);
+      setSuccess(true);
+      setTimeout(() => {
+        navigate('/user/login');
+      }, 2000);
+    } catch {
+      setError('Invalid or expired reset link. Please request a new one.');
+    }
+  };
+
+  if (success) {
+    return <p className="text-success">Password changed! Redirecting to the login...</p>;
+  }
+  return (
+    <form onSubmit={onSubmit}>
+      <PasswordInput
+        password={password}
+        confirmPassword={passwordConfirm}
+        onPasswordChange={setPassword}
+        onConfirmPasswordChange={setPasswordConfirm}
+        passwordLabel="New Password"
+        confirmLabel="New Password (confirm)"
+        minLength={8}
+      />
+      <PrimaryButton type="submit" disabled={!isPasswordValid}>
+        Set password
+      </PrimaryButton>
+      {error && (
+        <p className="text-danger-400">
+          {error}{' '}
+          <a href="/user/reset-password" className="underline">
+            Request a new link
+          </a>
+        </p>
+      )}
+    </form>
+  );
+};
+
+export const ResetPassword = () => {
+  const [token, setToken] = useState('');
+
+  // the token is sent in the URL fragment, which is not sent to servers
+  useEffect(() => {
+    const hash = window.location.hash;
+    if (hash.startsWith('#')) {
+      setToken(hash.substring(1));
+    }
+  }, []);
+
+  return (
+    <div className="flex items-center justify-center">
+      <div className="flex-none bg-white p-8 rounded-sm shadow-md w-96">
+        <h2 className="text-primary text-2xl font-semibold mb-4">Reset Password</h2>
+        {token ? <NewPasswordForm token={token} /> : <RequestResetForm />}
+        <a href="/user/login" className="underline text-secondary">
+          Back to login
+        </a>
+      </div>
+    </div>
+  );
+};